@@ -23,6 +23,20 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
+		GetRateLimitsCmd(),
+		GetRateLimitCmd(),
+		GetPairedBalancesCmd(),
+		GetPredictERC20AddressCmd(),
+		GetPausesCmd(),
+		GetPauseCmd(),
+		GetConversionFeeCmd(),
+		GetTokenPairStatsCmd(),
+		GetMintCapsCmd(),
+		GetMintCapCmd(),
+		GetSafetyPolicyCmd(),
+		GetWrappedEVMDenomCmd(),
+		GetTokenPairHealthCmd(),
+		GetTokenPairTombstonesCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -97,6 +111,491 @@ func GetTokenPairCmd() *cobra.Command {
 	return cmd
 }
 
+// GetPredictERC20AddressCmd queries the address of the ERC20 contract deployed
+// on the registration of a Cosmos coin
+func GetPredictERC20AddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "predict-erc20-address [denom]",
+		Short: "Get the address of the ERC20 contract deployed on the registration of a Cosmos coin",
+		Long:  "Get the address of the ERC20 contract deployed on the registration of a Cosmos coin, derived from its base denomination",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPredictERC20AddressRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.PredictERC20Address(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRateLimitsCmd queries the conversion rate limits of the token pairs
+func GetRateLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits",
+		Short: "Gets the conversion rate limits of the token pairs",
+		Long:  "Gets the conversion rate limits of the token pairs and their remaining capacity",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RateLimits(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate-limits")
+	return cmd
+}
+
+// GetRateLimitCmd queries the conversion rate limit of a token pair
+func GetRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limit [token]",
+		Short: "Get the conversion rate limit of a token pair",
+		Long:  "Get the conversion rate limit of a token pair and its remaining capacity",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.RateLimit(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetPausesCmd queries the active pauses of the module and of the token pairs
+func GetPausesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pauses",
+		Short: "Gets the active pauses of the module and of the token pairs",
+		Long:  "Gets the active pauses set by the guardian on the whole module and on the token pairs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPausesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Pauses(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pauses")
+	return cmd
+}
+
+// GetPauseCmd queries the active pause of a token pair
+func GetPauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [token]",
+		Short: "Get the active pause of a token pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPauseRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.Pause(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetConversionFeeCmd queries the conversion fee of a token pair
+func GetConversionFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conversion-fee [token] [amount]",
+		Short: "Get the conversion fee of a token pair and optionally the fee charged on the conversion of an amount",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConversionFeeRequest{
+				Token: args[0],
+			}
+
+			if len(args) == 2 {
+				req.Amount = args[1]
+			}
+
+			res, err := queryClient.ConversionFee(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTokenPairStatsCmd queries the conversion statistics of a token pair
+func GetTokenPairStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-pair-stats [token]",
+		Short: "Get the cumulative conversion statistics of a token pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTokenPairStatsRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.TokenPairStats(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetMintCapsCmd queries the mint caps of the token pairs
+func GetMintCapsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-caps",
+		Short: "Gets the mint caps of the token pairs",
+		Long:  "Gets the mint caps of the token pairs owned by external ERC20 contracts and their utilization",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMintCapsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.MintCaps(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mint-caps")
+	return cmd
+}
+
+// GetMintCapCmd queries the mint cap of a token pair
+func GetMintCapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-cap [token]",
+		Short: "Get the mint cap of a token pair",
+		Long:  "Get the mint cap of a token pair owned by an external ERC20 contract and its utilization",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryMintCapRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.MintCap(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSafetyPolicyCmd queries the safety policy of a token pair
+func GetSafetyPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "safety-policy [token]",
+		Short: "Get the safety policy of a token pair",
+		Long:  "Get the rules that the logs of the EVM calls made by the conversions of a token pair must satisfy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySafetyPolicyRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.SafetyPolicy(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetWrappedEVMDenomCmd queries the token pair of the wrapped EVM denomination
+func GetWrappedEVMDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wrapped-evm-denom",
+		Short: "Get the token pair of the wrapped EVM denomination",
+		Long:  "Get the token pair of the EVM denomination, whose WEVMOS contract supports native deposits and withdrawals",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryWrappedEVMDenomRequest{}
+
+			res, err := queryClient.WrappedEVMDenom(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTokenPairHealthCmd queries the health of the token pairs
+func GetTokenPairHealthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-pair-health",
+		Short: "Gets the health of the token pairs",
+		Long:  "Gets the health of the token pairs, checking the code, total supply and decimals of their ERC20 contract and that their escrow backs their supply",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTokenPairHealthRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.TokenPairHealth(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token-pair-health")
+	return cmd
+}
+
+// GetTokenPairTombstonesCmd queries the tombstones of the removed token pairs
+func GetTokenPairTombstonesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-pair-tombstones",
+		Short: "Gets the tombstones of the removed token pairs",
+		Long:  "Gets the token pairs removed because their ERC20 contract no longer has code and the deregistered native coin token pairs, with the escrow stranded by each of them",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTokenPairTombstonesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.TokenPairTombstones(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token-pair-tombstones")
+	return cmd
+}
+
+// GetPairedBalancesCmd queries the balances of an account for each token pair
+func GetPairedBalancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances [address]",
+		Short: "Gets the balances of an account for each token pair",
+		Long:  "Gets the Cosmos coin and ERC20 token balances of an account, in bech32 or hex format, for each registered token pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPairedBalancesRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PairedBalances(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "balances")
+	return cmd
+}
+
 // GetHubParamsCmd queries hub info
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -10,16 +12,31 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	ethermint "github.com/tharsis/ethermint/types"
 
 	"github.com/tharsis/evmos/x/erc20/types"
 )
 
+const (
+	flagUseGlobalFee     = "use-global-fee"
+	flagAllowedEvents    = "allowed-events"
+	flagAllowedEmitters  = "allowed-emitters"
+	flagMaxLogs          = "max-logs"
+	flagUseDefaultPolicy = "use-default-policy"
+	flagMetadata         = "metadata"
+	flagEpochIdentifier  = "epoch-identifier"
+)
+
 // NewTxCmd returns a root CLI command handler for certain modules/erc20 transaction commands.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -33,6 +50,15 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewConvertCoinCmd(),
 		NewConvertERC20Cmd(),
+		NewTransferERC20Cmd(),
+		NewConvertCoinsCmd(),
+		NewConvertERC20SCmd(),
+		NewConvertERC20WithPermitCmd(),
+		NewConvertERC20FromCmd(),
+		NewRegisterERC20Cmd(),
+		NewPausePairCmd(),
+		NewPauseModuleCmd(),
+		NewGrantConvertCmd(),
 	)
 	return txCmd
 }
@@ -79,72 +105,1273 @@ func NewConvertCoinCmd() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
 		},
 	}
-
-	flags.AddTxFlagsToCmd(cmd)
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertERC20Cmd returns a CLI command handler for converting ERC20s
+func NewConvertERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-erc20 [contract-address] [amount] [receiver]",
+		Short: "Convert an ERC20 token to Cosmos coin",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+
+			receiver := cliCtx.GetFromAddress()
+			if len(args) == 3 {
+				receiver, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgConvertERC20{
+				ContractAddress: contract,
+				Amount:          amount,
+				Receiver:        receiver.String(),
+				Sender:          from.Hex(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterERC20Cmd returns a CLI command handler for registering an ERC20
+// token without a governance proposal
+func NewRegisterERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-erc20 [contract-address]",
+		Short: "Register an ERC20 token, locking the registration deposit until the challenge period ends",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			msg := types.NewMsgRegisterERC20(common.HexToAddress(contract), cliCtx.GetFromAddress())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewPausePairCmd returns a CLI command handler for pausing the conversions of
+// a token pair as the guardian
+func NewPausePairCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-pair [token] [duration] [reason]",
+		Short: "Pause the conversions of a token pair as the guardian",
+		Long:  "Pause the conversions of a token pair, identified by its ERC20 address or Cosmos coin denomination, for the given duration (eg: 24h). Only the guardian can pause a token pair.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid duration %s: %w", args[1], err)
+			}
+
+			msg := types.NewMsgPausePair(args[0], args[2], duration, cliCtx.GetFromAddress())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewPauseModuleCmd returns a CLI command handler for pausing the conversions
+// of all the token pairs as the guardian
+func NewPauseModuleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-module [duration] [reason]",
+		Short: "Pause the conversions of all the token pairs as the guardian",
+		Long:  "Pause the conversions of all the token pairs for the given duration (eg: 24h). Only the guardian can pause the module.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid duration %s: %w", args[0], err)
+			}
+
+			msg := types.NewMsgPauseModule(args[1], duration, cliCtx.GetFromAddress())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewGrantConvertCmd returns a CLI command handler for granting an authz
+// ConvertAuthorization, as the authz CLI can't create authorizations of other
+// modules
+func NewGrantConvertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-convert [grantee] [coin|erc20] [spend-limit]",
+		Short: "Grant an authorization to convert Cosmos coins or ERC20 tokens on behalf of the --from account",
+		Long: `Grant an authz authorization to convert Cosmos coins (MsgConvertCoin) or ERC20 tokens (MsgConvertERC20) on behalf of the --from account.
+The spend limit is a list of coins for coin conversions and a comma separated list of contract-address:amount entries for ERC20 conversions.
+The spend limit is decremented on every conversion executed by the grantee.`,
+		Example: fmt.Sprintf(`$ %s tx %s grant-convert evmos1... coin 1000aevmos --allowed-receivers=0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd --from=<key_or_address>
+$ %s tx %s grant-convert evmos1... erc20 0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd:100 --from=<key_or_address>`,
+			version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			receivers, err := cmd.Flags().GetStringSlice(flagAllowedReceivers)
+			if err != nil {
+				return err
+			}
+
+			var authorization *types.ConvertAuthorization
+			switch args[1] {
+			case "coin":
+				spendLimit, err := sdk.ParseCoinsNormalized(args[2])
+				if err != nil {
+					return err
+				}
+				authorization = types.NewConvertCoinAuthorization(spendLimit, receivers)
+			case "erc20":
+				tokenSpendLimit, err := ParseERC20Amounts(args[2])
+				if err != nil {
+					return err
+				}
+				authorization = types.NewConvertERC20Authorization(tokenSpendLimit, receivers)
+			default:
+				return fmt.Errorf("invalid conversion type %s, expected coin or erc20", args[1])
+			}
+
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetInt64(flagExpiration)
+			if err != nil {
+				return err
+			}
+
+			msg, err := authz.NewMsgGrant(cliCtx.GetFromAddress(), grantee, authorization, time.Unix(exp, 0))
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagAllowedReceivers, []string{}, "addresses allowed to receive the converted tokens (any receiver if empty)")
+	cmd.Flags().Int64(flagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "unix timestamp of the grant expiration, one year by default")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertERC20WithPermitCmd returns a CLI command handler for relaying the
+// conversion of ERC20s authorized with an EIP-2612 permit
+func NewConvertERC20WithPermitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-erc20-with-permit [contract-address] [amount] [owner] [deadline] [signature]",
+		Short: "Convert an ERC20 token to Cosmos coin on behalf of its owner, using an EIP-2612 permit signature",
+		Long: `Convert an ERC20 token to Cosmos coin on behalf of its owner, using an EIP-2612 permit signature.
+The owner signs a permit for the erc20 module account to spend the amount until the deadline (unix timestamp in seconds).
+The hex encoded signature is relayed by the --from account, which pays the fees, and the coins are sent to the owner.`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			owner := args[2]
+			if err := ethermint.ValidateAddress(owner); err != nil {
+				return fmt.Errorf("invalid owner hex address %w", err)
+			}
+
+			deadline, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid deadline %s: %w", args[3], err)
+			}
+
+			signature, err := hexutil.Decode(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid signature %s: %w", args[4], err)
+			}
+
+			msg := &types.MsgConvertERC20WithPermit{
+				ContractAddress: contract,
+				Amount:          amount,
+				Owner:           owner,
+				Deadline:        deadline,
+				Signature:       signature,
+				Relayer:         cliCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertERC20FromCmd returns a CLI command handler for relaying the
+// conversion of ERC20s authorized with an allowance to the erc20 module account
+func NewConvertERC20FromCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-erc20-from [contract-address] [amount] [owner]",
+		Short: "Convert an ERC20 token to Cosmos coin on behalf of its owner, using the allowance of the owner to the erc20 module account",
+		Long: `Convert an ERC20 token to Cosmos coin on behalf of its owner, using the allowance of the owner to the erc20 module account.
+The owner, e.g. a multisig contract, approves the erc20 module account to spend at least the amount on the ERC20 contract.
+The conversion is relayed by the --from account, which pays the fees, and the coins are sent to the owner.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			owner := args[2]
+			if err := ethermint.ValidateAddress(owner); err != nil {
+				return fmt.Errorf("invalid owner hex address %w", err)
+			}
+
+			msg := &types.MsgConvertERC20From{
+				ContractAddress: contract,
+				Amount:          amount,
+				Owner:           owner,
+				Relayer:         cliCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertCoinsCmd returns a CLI command handler for converting multiple
+// cosmos coins
+func NewConvertCoinsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "convert-coins [coins] [receiver_hex]",
+		Short:   "Convert multiple Cosmos coins to ERC20 in a single transaction",
+		Example: fmt.Sprintf("$ %s tx %s convert-coins 100ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2,100erc20/0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd --from=<key_or_address>", version.AppName, types.ModuleName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			var receiver string
+			sender := cliCtx.GetFromAddress()
+
+			if len(args) == 2 {
+				receiver = args[1]
+				if err := ethermint.ValidateAddress(receiver); err != nil {
+					return fmt.Errorf("invalid receiver hex address %w", err)
+				}
+			} else {
+				receiver = common.BytesToAddress(sender).Hex()
+			}
+
+			msg := &types.MsgConvertCoins{
+				Coins:    coins,
+				Receiver: receiver,
+				Sender:   sender.String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertERC20SCmd returns a CLI command handler for converting multiple
+// ERC20s
+func NewConvertERC20SCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "convert-erc20s [contract-address:amount,...] [receiver]",
+		Short:   "Convert multiple ERC20 tokens to Cosmos coins in a single transaction",
+		Example: fmt.Sprintf("$ %s tx %s convert-erc20s 0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd:100,0xdAC17F958D2ee523a2206206994597C13D831ec7:50 --from=<key_or_address>", version.AppName, types.ModuleName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokens, err := ParseERC20Amounts(args[0])
+			if err != nil {
+				return err
+			}
+
+			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+
+			receiver := cliCtx.GetFromAddress()
+			if len(args) == 2 {
+				receiver, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgConvertERC20S{
+				Tokens:   tokens,
+				Receiver: receiver.String(),
+				Sender:   from.Hex(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	flagPacketTimeoutHeight    = "packet-timeout-height"
+	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagBehaviour              = "behaviour"
+	flagScalingExponent        = "scaling-exponent"
+	flagGasLimit               = "erc20-gas-limit"
+	flagAllowedReceivers       = "allowed-receivers"
+	flagExpiration             = "expiration"
+)
+
+// NewTransferERC20Cmd returns a CLI command handler for transferring ERC20s
+// to a counterparty chain through ICS20
+func NewTransferERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-erc20 [contract-address] [amount] [src-port] [src-channel] [receiver]",
+		Short: "Convert an ERC20 token to Cosmos coin and transfer it through IBC",
+		Long: `Convert an ERC20 token to its Cosmos coin representation and transfer the coins to a counterparty chain through an ICS20 transfer.
+If the packet times out or is acknowledged with an error, the refund is converted back to ERC20 tokens.
+The timeout height is absolute on the destination chain and the timeout timestamp is relative to the local clock.`,
+		Example: fmt.Sprintf("$ %s tx %s transfer-erc20 0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd 1000 transfer channel-0 cosmos1... --from=<key_or_address>", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			if timeoutTimestamp != 0 {
+				timeoutTimestamp += uint64(time.Now().UnixNano())
+			}
+
+			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+
+			msg := &types.MsgTransferERC20{
+				ContractAddress:  contract,
+				Amount:           amount,
+				SourcePort:       args[2],
+				SourceChannel:    args[3],
+				Sender:           from.Hex(),
+				Receiver:         args[4],
+				TimeoutHeight:    timeoutHeight,
+				TimeoutTimestamp: timeoutTimestamp,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "Packet timeout block height on the destination chain. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, ibctransfertypes.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds relative to the local clock. The timeout is disabled when set to 0.")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterCoinProposalCmd implements the command to submit a community-pool-spend proposal
+func NewRegisterCoinProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-coin [metadata]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a register coin proposal",
+		Long: `Submit a proposal to register a Cosmos coin to the erc20 along with an initial deposit.
+Upon passing, the
+The proposal details must be supplied via a JSON file.`,
+		Example: fmt.Sprintf(`$ %s tx gov submit-proposal register-coin <path/to/metadata.json> --from=<key_or_address>
+
+Where metadata.json contains (example):
+
+{
+  "description": "staking, gas and governance token of the Evmos testnets"
+  "denom_units": [
+		{
+			"denom": "aevmos",
+			"exponent": 0,
+			"aliases": ["atto evmos"]
+		},
+		{
+			"denom": "evmos",
+			"exponent": 18
+		}
+	],
+	"base": "aevmos",
+	"display: "evmos",
+	"name": "Evmos",
+	"symbol": "EVMOS"
+}`, version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := ParseMetadata(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			scalingExponent, err := cmd.Flags().GetUint32(flagScalingExponent)
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewRegisterCoinProposal(title, description, metadata, scalingExponent, gasLimit)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	cmd.Flags().Uint32(flagScalingExponent, 0, "decimal scaling exponent of the token pair: 1 base unit of the Cosmos coin maps to 10^exponent ERC20 token units")
+	cmd.Flags().Uint64(flagGasLimit, 0, "gas limit of the EVM calls to the ERC20 contract performed by the module (0 uses the EVM gas cap)")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewRegisterERC20ProposalCmd implements the command to submit a community-pool-spend proposal
+func NewRegisterERC20ProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-erc20 [erc20-address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to register an ERC20 token",
+		Long:    "Submit a proposal to register an ERC20 token to the erc20 along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-erc20 <path/to/proposal.json> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			behaviourStr, err := cmd.Flags().GetString(flagBehaviour)
+			if err != nil {
+				return err
+			}
+
+			behaviour, err := types.ParseTokenBehaviour(behaviourStr)
+			if err != nil {
+				return err
+			}
+
+			scalingExponent, err := cmd.Flags().GetUint32(flagScalingExponent)
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+			if err != nil {
+				return err
+			}
+
+			metadataFile, err := cmd.Flags().GetString(flagMetadata)
+			if err != nil {
+				return err
+			}
+
+			var metadata *banktypes.Metadata
+			if metadataFile != "" {
+				override, err := ParseMetadata(clientCtx.Codec, metadataFile)
+				if err != nil {
+					return err
+				}
+				metadata = &override
+			}
+
+			erc20Addr := args[0]
+			from := clientCtx.GetFromAddress()
+			content := types.NewRegisterERC20Proposal(title, description, erc20Addr, behaviour, scalingExponent, gasLimit, metadata)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	cmd.Flags().String(flagBehaviour, "standard", "transfer behaviour of the ERC20 token (standard|safe-transfer|fee-on-transfer)")
+	cmd.Flags().Uint32(flagScalingExponent, 0, "decimal scaling exponent of the token pair: 1 base unit of the Cosmos coin maps to 10^exponent ERC20 token units")
+	cmd.Flags().Uint64(flagGasLimit, 0, "gas limit of the EVM calls to the ERC20 contract performed by the module (0 uses the EVM gas cap)")
+	cmd.Flags().String(flagMetadata, "", "path to a JSON file with the coin metadata overriding the one derived from the ERC20 name and symbol")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewToggleTokenRelayProposalCmd implements the command to submit a community-pool-spend proposal
+func NewToggleTokenRelayProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "toggle-token-relay [token]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a toggle token relay proposal",
+		Long:    "Submit a proposal to toggle the relaying of a token pair along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal toggle-token-relay <denom_or_contract> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			token := args[0]
+			content := types.NewToggleTokenRelayProposal(title, description, token)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewUpdateTokenPairERC20ProposalCmd implements the command to submit a community-pool-spend proposal
+func NewUpdateTokenPairERC20ProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-token-pair-erc20 [erc20_address] [new_erc20_address]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a update token pair ERC20 proposal",
+		Long:    `Submit a proposal to update the ERC20 address of a token pair along with an initial deposit.`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-token-pair-erc20 <path/to/proposal.json> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			erc20Addr := args[0]
+			newERC20Addr := args[1]
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateTokenPairERC20Proposal(title, description, erc20Addr, newERC20Addr)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewDeregisterTokenPairProposalCmd implements the command to submit a deregister token pair proposal
+func NewDeregisterTokenPairProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister-token-pair [token] [holders-file]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Submit a deregister token pair proposal",
+		Long: `Submit a proposal to remove a token pair and settle its escrow along with an initial deposit.
+The escrowed ERC20 tokens of a registered ERC20 are refunded to the holders of its Cosmos coin listed in the optional
+holders file, e.g. from an off-chain snapshot of the coin balances. Module accounts and blocked addresses are skipped.`,
+		Example: fmt.Sprintf(`$ %s tx gov submit-proposal deregister-token-pair <denom_or_contract> holders.json --from=<key_or_address>
+
+Where holders.json contains (example):
+
+["0x5Cc6A4e7Fa04a0A3cA1c68a6Aa5b9a2e7EaA5E9a", "evmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnv8v8n9"]`, version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			var holders []string
+			if len(args) == 2 {
+				holders, err = ParseDeregistrationHolders(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			from := clientCtx.GetFromAddress()
+			token := args[0]
+			content := types.NewDeregisterTokenPairProposal(title, description, token, holders)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewVetoERC20RegistrationProposalCmd implements the command to submit a veto
+// ERC20 registration proposal
+func NewVetoERC20RegistrationProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "veto-erc20-registration [erc20-address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a veto ERC20 registration proposal",
+		Long:    "Submit a proposal to reject a pending ERC20 registration and burn its registration deposit along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal veto-erc20-registration <contract_address> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			erc20Addr := args[0]
+			content := types.NewVetoERC20RegistrationProposal(title, description, erc20Addr)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewExtendPauseProposalCmd implements the command to submit an extend pause
+// proposal
+func NewExtendPauseProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "extend-pause [duration] [token]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Submit a proposal to extend or lift an active pause",
+		Long: `Submit a proposal to set the expiry of an active pause set by the guardian to the execution of the proposal plus the
+given duration (eg: 72h), along with an initial deposit. A zero duration lifts the pause. If no token is given, the pause of
+the whole module is extended.`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal extend-pause 72h <denom_or_contract> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid duration %s: %w", args[0], err)
+			}
+
+			token := ""
+			if len(args) == 2 {
+				token = args[1]
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewExtendPauseProposal(title, description, token, duration)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewUpdateRateLimitProposalCmd implements the command to submit an
+// update-rate-limit proposal
+func NewUpdateRateLimitProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-rate-limit [token] [max-per-conversion] [inflow-cap] [outflow-cap] [window]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit a proposal to update the conversion rate limit of a token pair",
+		Long: `Submit a proposal to update the conversion rate limit of a token pair along with an initial deposit.
+The inflow and outflow caps are enforced over a rolling window of the given number of blocks, or of epochs if the
+--epoch-identifier flag is set. A zero value disables the corresponding limit and setting all the limits to zero removes
+the rate limit of the token pair.`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-rate-limit <denom_or_contract> 1000 10000 10000 100 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			token := args[0]
+
+			maxPerConversion, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max per conversion %s", args[1])
+			}
+
+			inflowCap, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid inflow cap %s", args[2])
+			}
+
+			outflowCap, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid outflow cap %s", args[3])
+			}
+
+			window, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid window %s: %w", args[4], err)
+			}
+
+			epochIdentifier, err := cmd.Flags().GetString(flagEpochIdentifier)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateRateLimitProposal(title, description, token, maxPerConversion, inflowCap, outflowCap, window, epochIdentifier)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(flagEpochIdentifier, "", "identifier of the epochs the window is measured in, e.g. day (measured in blocks if empty)")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
 	return cmd
 }
 
-// NewConvertERC20Cmd returns a CLI command handler for converting ERC20s
-func NewConvertERC20Cmd() *cobra.Command {
+// NewUpgradeERC20ImplementationProposalCmd implements the command to submit an
+// upgrade-erc20-implementation proposal
+func NewUpgradeERC20ImplementationProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "convert-erc20 [contract-address] [amount] [receiver]",
-		Short: "Convert an ERC20 token to Cosmos coin",
-		Args:  cobra.RangeArgs(2, 3),
+		Use:   "upgrade-erc20-implementation [implementation] [erc20-addresses]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to upgrade the implementation of the ERC20 proxies of registered Cosmos coins",
+		Long: `Submit a proposal to upgrade the implementation of the ERC20 proxies of registered Cosmos coins along with an initial deposit.
+If no ERC20 address is given, all the proxies are upgraded and the implementation is used for the coins registered afterwards.`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal upgrade-erc20-implementation ERC20MinterBurnerDecimals --from=<key_or_address>", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cliCtx, err := client.GetClientTxContext(cmd)
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			contract := args[0]
-			if err := ethermint.ValidateAddress(contract); err != nil {
-				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
 			}
 
-			amount, ok := sdk.NewIntFromString(args[1])
-			if !ok {
-				return fmt.Errorf("invalid amount %s", args[1])
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
 			}
 
-			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
 
-			receiver := cliCtx.GetFromAddress()
-			if len(args) == 3 {
-				receiver, err = sdk.AccAddressFromBech32(args[2])
-				if err != nil {
-					return err
-				}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
 			}
 
-			msg := &types.MsgConvertERC20{
-				ContractAddress: contract,
-				Amount:          amount,
-				Receiver:        receiver.String(),
-				Sender:          from.Hex(),
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpgradeERC20ImplementationProposal(title, description, args[0], args[1:]...)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 
-			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
 	return cmd
 }
 
-// NewRegisterCoinProposalCmd implements the command to submit a community-pool-spend proposal
-func NewRegisterCoinProposalCmd() *cobra.Command {
+// NewUpdateCoinMetadataProposalCmd implements the command to submit an
+// update-coin-metadata proposal
+func NewUpdateCoinMetadataProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "register-coin [metadata]",
+		Use:   "update-coin-metadata [metadata]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Submit a register coin proposal",
-		Long: `Submit a proposal to register a Cosmos coin to the erc20 along with an initial deposit.
-Upon passing, the
-The proposal details must be supplied via a JSON file.`,
-		Example: fmt.Sprintf(`$ %s tx gov submit-proposal register-coin <path/to/metadata.json> --from=<key_or_address>
+		Short: "Submit a proposal to update the metadata of a registered Cosmos coin",
+		Long: `Submit a proposal to update the metadata of the Cosmos coin of a registered token pair along with an initial deposit.
+The name and symbol of the ERC20 contract are updated too if it is owned by the module.
+The metadata must be supplied via a JSON file.`,
+		Example: fmt.Sprintf(`$ %s tx gov submit-proposal update-coin-metadata <path/to/metadata.json> --from=<key_or_address>
 
 Where metadata.json contains (example):
 
@@ -199,8 +1426,102 @@ Where metadata.json contains (example):
 			}
 
 			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateCoinMetadataProposal(title, description, metadata)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewUpdateConversionFeeProposalCmd implements the command to submit a update-conversion-fee proposal
+func NewUpdateConversionFeeProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-conversion-fee [token] [rate] [min-fee]",
+		Args:  cobra.RangeArgs(1, 3),
+		Short: "Submit a proposal to update the conversion fee of a token pair",
+		Long: `Submit a proposal to update the conversion fee of a token pair along with an initial deposit.
+The fee charged on a conversion is the given rate of the converted amount, with a minimum of min-fee in the denom of
+the token pair. The global conversion fee of the module parameters applies again to the token pair if the
+--use-global-fee flag is set, in which case the rate and min-fee arguments are omitted.`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-conversion-fee <denom_or_contract> 0.001 100 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			useGlobalFee, err := cmd.Flags().GetBool(flagUseGlobalFee)
+			if err != nil {
+				return err
+			}
+
+			token := args[0]
+			rate := sdk.ZeroDec()
+			minFee := sdk.ZeroInt()
+
+			switch {
+			case useGlobalFee && len(args) != 1:
+				return fmt.Errorf("rate and min-fee can't be set with the --%s flag", flagUseGlobalFee)
+			case !useGlobalFee && len(args) != 3:
+				return fmt.Errorf("rate and min-fee are required without the --%s flag", flagUseGlobalFee)
+			case !useGlobalFee:
+				rate, err = sdk.NewDecFromStr(args[1])
+				if err != nil {
+					return fmt.Errorf("invalid rate %s: %w", args[1], err)
+				}
+
+				var ok bool
+				minFee, ok = sdk.NewIntFromString(args[2])
+				if !ok {
+					return fmt.Errorf("invalid min fee %s", args[2])
+				}
+			}
 
-			content := types.NewRegisterCoinProposal(title, description, metadata)
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateConversionFeeProposal(title, description, token, rate, minFee, useGlobalFee)
 
 			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
@@ -218,6 +1539,7 @@ Where metadata.json contains (example):
 	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	cmd.Flags().Bool(flagUseGlobalFee, false, "apply the global conversion fee of the module parameters to the token pair")
 	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
 		panic(err)
 	}
@@ -230,14 +1552,16 @@ Where metadata.json contains (example):
 	return cmd
 }
 
-// NewRegisterERC20ProposalCmd implements the command to submit a community-pool-spend proposal
-func NewRegisterERC20ProposalCmd() *cobra.Command {
+// NewUpdateMintCapProposalCmd implements the command to submit a update-mint-cap proposal
+func NewUpdateMintCapProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "register-erc20 [erc20-address]",
-		Args:    cobra.ExactArgs(1),
-		Short:   "Submit a proposal to register an ERC20 token",
-		Long:    "Submit a proposal to register an ERC20 token to the erc20 along with an initial deposit.",
-		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-erc20 <path/to/proposal.json> --from=<key_or_address>", version.AppName),
+		Use:   "update-mint-cap [token] [cap]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to update the mint cap of a token pair",
+		Long: `Submit a proposal to update the maximum supply of the Cosmos coin of a token pair owned by an external
+ERC20 contract along with an initial deposit. Conversions that mint coins beyond the cap are rejected. A zero cap
+removes the mint cap of the token pair.`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-mint-cap <denom_or_contract> 1000000000000000000000000 --from=<key_or_address>", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -264,9 +1588,14 @@ func NewRegisterERC20ProposalCmd() *cobra.Command {
 				return err
 			}
 
-			erc20Addr := args[0]
+			token := args[0]
+			mintCap, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid mint cap %s", args[1])
+			}
+
 			from := clientCtx.GetFromAddress()
-			content := types.NewRegisterERC20Proposal(title, description, erc20Addr)
+			content := types.NewUpdateMintCapProposal(title, description, token, mintCap)
 
 			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
@@ -296,14 +1625,20 @@ func NewRegisterERC20ProposalCmd() *cobra.Command {
 	return cmd
 }
 
-// NewToggleTokenRelayProposalCmd implements the command to submit a community-pool-spend proposal
-func NewToggleTokenRelayProposalCmd() *cobra.Command {
+// NewUpdateSafetyPolicyProposalCmd implements the command to submit a update-safety-policy proposal
+func NewUpdateSafetyPolicyProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "toggle-token-relay [token]",
-		Args:    cobra.ExactArgs(1),
-		Short:   "Submit a toggle token relay proposal",
-		Long:    "Submit a proposal to toggle the relaying of a token pair along with an initial deposit.",
-		Example: fmt.Sprintf("$ %s tx gov submit-proposal toggle-token-relay <denom_or_contract> --from=<key_or_address>", version.AppName),
+		Use:   "update-safety-policy [token]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the safety policy of a token pair",
+		Long: `Submit a proposal to update the rules that the logs of the EVM calls made by the conversions of a token
+pair must satisfy along with an initial deposit. The logs can be restricted to a set of event signatures and of
+emitting contracts, in addition to the ERC20 contract of the token pair, and to a maximum number of logs.
+Unexpected Approval events are always rejected.`,
+		Example: fmt.Sprintf(
+			"$ %s tx gov submit-proposal update-safety-policy <denom_or_contract> --allowed-events='Transfer(address,address,uint256),Approval(address,address,uint256)' --max-logs=2 --from=<key_or_address>",
+			version.AppName,
+		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -330,9 +1665,35 @@ func NewToggleTokenRelayProposalCmd() *cobra.Command {
 				return err
 			}
 
+			allowedEvents, err := cmd.Flags().GetStringSlice(flagAllowedEvents)
+			if err != nil {
+				return err
+			}
+
+			allowedEmitters, err := cmd.Flags().GetStringSlice(flagAllowedEmitters)
+			if err != nil {
+				return err
+			}
+
+			maxLogs, err := cmd.Flags().GetUint32(flagMaxLogs)
+			if err != nil {
+				return err
+			}
+
+			useDefaultPolicy, err := cmd.Flags().GetBool(flagUseDefaultPolicy)
+			if err != nil {
+				return err
+			}
+
+			if useDefaultPolicy && (len(allowedEvents) > 0 || len(allowedEmitters) > 0 || maxLogs > 0) {
+				return fmt.Errorf("the safety policy rules can't be set with the --%s flag", flagUseDefaultPolicy)
+			}
+
 			from := clientCtx.GetFromAddress()
-			token := args[0]
-			content := types.NewToggleTokenRelayProposal(title, description, token)
+			content := types.NewUpdateSafetyPolicyProposal(
+				title, description, args[0],
+				allowedEvents, allowedEmitters, maxLogs, useDefaultPolicy,
+			)
 
 			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
@@ -350,6 +1711,10 @@ func NewToggleTokenRelayProposalCmd() *cobra.Command {
 	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	cmd.Flags().StringSlice(flagAllowedEvents, []string{}, "comma separated canonical signatures of the events allowed in the logs, any event is allowed if empty")
+	cmd.Flags().StringSlice(flagAllowedEmitters, []string{}, "comma separated hex addresses of the contracts, other than the ERC20 contract, allowed to emit logs")
+	cmd.Flags().Uint32(flagMaxLogs, 0, "maximum number of logs emitted by an EVM call, unlimited if zero")
+	cmd.Flags().Bool(flagUseDefaultPolicy, false, "remove the safety policy of the token pair so that only unexpected Approval events are rejected")
 	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
 		panic(err)
 	}
@@ -362,14 +1727,25 @@ func NewToggleTokenRelayProposalCmd() *cobra.Command {
 	return cmd
 }
 
-// NewUpdateTokenPairERC20ProposalCmd implements the command to submit a community-pool-spend proposal
-func NewUpdateTokenPairERC20ProposalCmd() *cobra.Command {
+// NewRecoverTokenPairEscrowProposalCmd implements the command to submit a recover-token-pair-escrow proposal
+func NewRecoverTokenPairEscrowProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update-token-pair-erc20 [erc20_address] [new_erc20_address]",
-		Args:    cobra.ExactArgs(2),
-		Short:   "Submit a update token pair ERC20 proposal",
-		Long:    `Submit a proposal to update the ERC20 address of a token pair along with an initial deposit.`,
-		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-token-pair-erc20 <path/to/proposal.json> --from=<key_or_address>", version.AppName),
+		Use:   "recover-token-pair-escrow [erc20-address] [snapshot]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Submit a proposal to recover the escrow of a tombstoned token pair",
+		Long: `Submit a proposal to distribute the Cosmos coins stranded on the module account by a token pair tombstoned
+after its ERC20 contract was destroyed, along with an initial deposit. The escrow is distributed pro-rata to the
+balances of the snapshot JSON file, and the rounding remainder is sent to the community pool. Without a snapshot,
+the whole escrow is sent to the community pool.`,
+		Example: fmt.Sprintf(`$ %s tx gov submit-proposal recover-token-pair-escrow <contract_address> <path/to/snapshot.json> --from=<key_or_address>
+
+Where snapshot.json contains (example):
+
+[
+  {"address": "0x5Cc6A4e7Fa04a0A3cA1c68a6Aa5b9a2e7EaA5E9a", "amount": "700"},
+  {"address": "evmos1qqqsyqcyq5rqwzqfpg9scrgwpugpzysnv8v8n9", "amount": "300"}
+]`, version.AppName,
+		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -396,11 +1772,87 @@ func NewUpdateTokenPairERC20ProposalCmd() *cobra.Command {
 				return err
 			}
 
-			erc20Addr := args[0]
-			newERC20Addr := args[1]
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			var shares []types.EscrowRecoveryShare
+			if len(args) == 2 {
+				shares, err = ParseEscrowRecoveryShares(args[1])
+				if err != nil {
+					return err
+				}
+			}
 
 			from := clientCtx.GetFromAddress()
-			content := types.NewUpdateTokenPairERC20Proposal(title, description, erc20Addr, newERC20Addr)
+			content := types.NewRecoverTokenPairEscrowProposal(title, description, common.HexToAddress(contract), shares)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewRegisterWrappedEVMDenomProposalCmd implements the command to submit a register-wrapped-evm-denom proposal
+func NewRegisterWrappedEVMDenomProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-wrapped-evm-denom",
+		Args:    cobra.NoArgs,
+		Short:   "Submit a proposal to register the wrapped EVM denomination",
+		Long:    "Submit a proposal to deploy the WEVMOS contract and register it as the token pair of the EVM denomination, along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-wrapped-evm-denom --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewRegisterWrappedEVMDenomProposal(title, description)
+
 			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
 				return err
@@ -1,11 +1,19 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	ethermint "github.com/tharsis/ethermint/types"
+
+	"github.com/tharsis/evmos/x/erc20/types"
 )
 
 // ParseRegisterCoinProposal reads and parses a ParseRegisterCoinProposal from a file.
@@ -23,3 +31,66 @@ func ParseMetadata(cdc codec.JSONCodec, metadataFile string) (banktypes.Metadata
 
 	return metadata, nil
 }
+
+// ParseERC20Amounts parses a comma separated list of contract-address:amount
+// entries.
+func ParseERC20Amounts(entries string) ([]types.ERC20Amount, error) {
+	var tokens []types.ERC20Amount
+	for _, entry := range strings.Split(entries, ",") {
+		split := strings.Split(strings.TrimSpace(entry), ":")
+		if len(split) != 2 {
+			return nil, fmt.Errorf("invalid token entry %s, expected contract-address:amount", entry)
+		}
+
+		contract := split[0]
+		if err := ethermint.ValidateAddress(contract); err != nil {
+			return nil, fmt.Errorf("invalid ERC20 contract address %w", err)
+		}
+
+		amount, ok := sdk.NewIntFromString(split[1])
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %s", split[1])
+		}
+
+		tokens = append(tokens, types.ERC20Amount{
+			ContractAddress: contract,
+			Amount:          amount,
+		})
+	}
+
+	return tokens, nil
+}
+
+// ParseDeregistrationHolders reads and parses the holder addresses of a
+// DeregisterTokenPairProposal from a JSON file.
+func ParseDeregistrationHolders(holdersFile string) ([]string, error) {
+	var holders []string
+
+	contents, err := ioutil.ReadFile(filepath.Clean(holdersFile))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(contents, &holders); err != nil {
+		return nil, err
+	}
+
+	return holders, nil
+}
+
+// ParseEscrowRecoveryShares reads and parses the balance snapshot of a
+// RecoverTokenPairEscrowProposal from a JSON file.
+func ParseEscrowRecoveryShares(snapshotFile string) ([]types.EscrowRecoveryShare, error) {
+	var shares []types.EscrowRecoveryShare
+
+	contents, err := ioutil.ReadFile(filepath.Clean(snapshotFile))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(contents, &shares); err != nil {
+		return nil, err
+	}
+
+	return shares, nil
+}
@@ -8,8 +8,19 @@ import (
 )
 
 var (
-	RegisterCoinProposalHandler         = govclient.NewProposalHandler(cli.NewRegisterCoinProposalCmd, rest.RegisterCoinProposalRESTHandler)
-	RegisterERC20ProposalHandler        = govclient.NewProposalHandler(cli.NewRegisterERC20ProposalCmd, rest.RegisterERC20ProposalRESTHandler)
-	ToggleTokenRelayProposalHandler     = govclient.NewProposalHandler(cli.NewToggleTokenRelayProposalCmd, rest.ToggleTokenRelayRESTHandler)
-	UpdateTokenPairERC20ProposalHandler = govclient.NewProposalHandler(cli.NewUpdateTokenPairERC20ProposalCmd, rest.UpdateTokenPairERC20ProposalRESTHandler)
+	RegisterCoinProposalHandler               = govclient.NewProposalHandler(cli.NewRegisterCoinProposalCmd, rest.RegisterCoinProposalRESTHandler)
+	RegisterERC20ProposalHandler              = govclient.NewProposalHandler(cli.NewRegisterERC20ProposalCmd, rest.RegisterERC20ProposalRESTHandler)
+	ToggleTokenRelayProposalHandler           = govclient.NewProposalHandler(cli.NewToggleTokenRelayProposalCmd, rest.ToggleTokenRelayRESTHandler)
+	UpdateTokenPairERC20ProposalHandler       = govclient.NewProposalHandler(cli.NewUpdateTokenPairERC20ProposalCmd, rest.UpdateTokenPairERC20ProposalRESTHandler)
+	DeregisterTokenPairProposalHandler        = govclient.NewProposalHandler(cli.NewDeregisterTokenPairProposalCmd, rest.DeregisterTokenPairProposalRESTHandler)
+	UpdateRateLimitProposalHandler            = govclient.NewProposalHandler(cli.NewUpdateRateLimitProposalCmd, rest.UpdateRateLimitProposalRESTHandler)
+	UpgradeERC20ImplementationProposalHandler = govclient.NewProposalHandler(cli.NewUpgradeERC20ImplementationProposalCmd, rest.UpgradeERC20ImplementationProposalRESTHandler)
+	UpdateCoinMetadataProposalHandler         = govclient.NewProposalHandler(cli.NewUpdateCoinMetadataProposalCmd, rest.UpdateCoinMetadataProposalRESTHandler)
+	VetoERC20RegistrationProposalHandler      = govclient.NewProposalHandler(cli.NewVetoERC20RegistrationProposalCmd, rest.VetoERC20RegistrationProposalRESTHandler)
+	ExtendPauseProposalHandler                = govclient.NewProposalHandler(cli.NewExtendPauseProposalCmd, rest.ExtendPauseProposalRESTHandler)
+	UpdateConversionFeeProposalHandler        = govclient.NewProposalHandler(cli.NewUpdateConversionFeeProposalCmd, rest.UpdateConversionFeeProposalRESTHandler)
+	UpdateMintCapProposalHandler              = govclient.NewProposalHandler(cli.NewUpdateMintCapProposalCmd, rest.UpdateMintCapProposalRESTHandler)
+	UpdateSafetyPolicyProposalHandler         = govclient.NewProposalHandler(cli.NewUpdateSafetyPolicyProposalCmd, rest.UpdateSafetyPolicyProposalRESTHandler)
+	RecoverTokenPairEscrowProposalHandler     = govclient.NewProposalHandler(cli.NewRecoverTokenPairEscrowProposalCmd, rest.RecoverTokenPairEscrowProposalRESTHandler)
+	RegisterWrappedEVMDenomProposalHandler    = govclient.NewProposalHandler(cli.NewRegisterWrappedEVMDenomProposalCmd, rest.RegisterWrappedEVMDenomProposalRESTHandler)
 )
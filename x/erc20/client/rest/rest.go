@@ -2,6 +2,7 @@ package rest
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -10,26 +11,33 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/tharsis/evmos/x/erc20/types"
 )
 
 // RegisterCoinProposalRequest defines a request for a new register coin proposal.
 type RegisterCoinProposalRequest struct {
-	BaseReq     rest.BaseReq       `json:"base_req" yaml:"base_req"`
-	Title       string             `json:"title" yaml:"title"`
-	Description string             `json:"description" yaml:"description"`
-	Deposit     sdk.Coins          `json:"deposit" yaml:"deposit"`
-	Metadata    banktypes.Metadata `json:"metadata" yaml:"metadata"`
+	BaseReq         rest.BaseReq       `json:"base_req" yaml:"base_req"`
+	Title           string             `json:"title" yaml:"title"`
+	Description     string             `json:"description" yaml:"description"`
+	Deposit         sdk.Coins          `json:"deposit" yaml:"deposit"`
+	Metadata        banktypes.Metadata `json:"metadata" yaml:"metadata"`
+	ScalingExponent uint32             `json:"scaling_exponent" yaml:"scaling_exponent"`
+	GasLimit        uint64             `json:"gas_limit" yaml:"gas_limit"`
 }
 
 // RegisterERC20ProposalRequest defines a request for a new register ERC20 proposal.
 type RegisterERC20ProposalRequest struct {
-	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
-	Title        string       `json:"title" yaml:"title"`
-	Description  string       `json:"description" yaml:"description"`
-	Deposit      sdk.Coins    `json:"deposit" yaml:"deposit"`
-	ERC20Address string       `json:"erc20_address" yaml:"erc20_address"`
+	BaseReq         rest.BaseReq         `json:"base_req" yaml:"base_req"`
+	Title           string               `json:"title" yaml:"title"`
+	Description     string               `json:"description" yaml:"description"`
+	Deposit         sdk.Coins            `json:"deposit" yaml:"deposit"`
+	ERC20Address    string               `json:"erc20_address" yaml:"erc20_address"`
+	Behaviour       types.TokenBehaviour `json:"behaviour" yaml:"behaviour"`
+	ScalingExponent uint32               `json:"scaling_exponent" yaml:"scaling_exponent"`
+	GasLimit        uint64               `json:"gas_limit" yaml:"gas_limit"`
+	Metadata        *banktypes.Metadata  `json:"metadata" yaml:"metadata"`
 }
 
 // ToggleTokenRelayProposalRequest defines a request for a toggle token relay proposal.
@@ -51,6 +59,121 @@ type UpdateTokenPairERC20ProposalRequest struct {
 	NewERC20Address string       `json:"new_erc20_address" yaml:"new_erc20_address"`
 }
 
+// DeregisterTokenPairProposalRequest defines a request for a deregister token pair proposal.
+type DeregisterTokenPairProposalRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Token       string       `json:"token" yaml:"token"`
+	Holders     []string     `json:"holders" yaml:"holders"`
+}
+
+// VetoERC20RegistrationProposalRequest defines a request for a veto ERC20 registration proposal.
+type VetoERC20RegistrationProposalRequest struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title        string       `json:"title" yaml:"title"`
+	Description  string       `json:"description" yaml:"description"`
+	Deposit      sdk.Coins    `json:"deposit" yaml:"deposit"`
+	ERC20Address string       `json:"erc20_address" yaml:"erc20_address"`
+}
+
+// ExtendPauseProposalRequest defines a request for an extend pause proposal.
+type ExtendPauseProposalRequest struct {
+	BaseReq     rest.BaseReq  `json:"base_req" yaml:"base_req"`
+	Title       string        `json:"title" yaml:"title"`
+	Description string        `json:"description" yaml:"description"`
+	Deposit     sdk.Coins     `json:"deposit" yaml:"deposit"`
+	Token       string        `json:"token" yaml:"token"`
+	Duration    time.Duration `json:"duration" yaml:"duration"`
+}
+
+// UpdateRateLimitProposalRequest defines a request for an update rate limit proposal.
+type UpdateRateLimitProposalRequest struct {
+	BaseReq          rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title            string       `json:"title" yaml:"title"`
+	Description      string       `json:"description" yaml:"description"`
+	Deposit          sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Token            string       `json:"token" yaml:"token"`
+	MaxPerConversion sdk.Int      `json:"max_per_conversion" yaml:"max_per_conversion"`
+	InflowCap        sdk.Int      `json:"inflow_cap" yaml:"inflow_cap"`
+	OutflowCap       sdk.Int      `json:"outflow_cap" yaml:"outflow_cap"`
+	Window           uint64       `json:"window" yaml:"window"`
+	EpochIdentifier  string       `json:"epoch_identifier" yaml:"epoch_identifier"`
+}
+
+// UpdateConversionFeeProposalRequest defines a request for an update conversion fee proposal.
+type UpdateConversionFeeProposalRequest struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title        string       `json:"title" yaml:"title"`
+	Description  string       `json:"description" yaml:"description"`
+	Deposit      sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Token        string       `json:"token" yaml:"token"`
+	Rate         sdk.Dec      `json:"rate" yaml:"rate"`
+	MinFee       sdk.Int      `json:"min_fee" yaml:"min_fee"`
+	UseGlobalFee bool         `json:"use_global_fee" yaml:"use_global_fee"`
+}
+
+// UpdateMintCapProposalRequest defines a request for an update mint cap proposal.
+type UpdateMintCapProposalRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Token       string       `json:"token" yaml:"token"`
+	Cap         sdk.Int      `json:"cap" yaml:"cap"`
+}
+
+// UpdateSafetyPolicyProposalRequest defines a request for an update safety policy proposal.
+type UpdateSafetyPolicyProposalRequest struct {
+	BaseReq                rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title                  string       `json:"title" yaml:"title"`
+	Description            string       `json:"description" yaml:"description"`
+	Deposit                sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Token                  string       `json:"token" yaml:"token"`
+	AllowedEventSignatures []string     `json:"allowed_event_signatures" yaml:"allowed_event_signatures"`
+	AllowedEmitters        []string     `json:"allowed_emitters" yaml:"allowed_emitters"`
+	MaxLogs                uint32       `json:"max_logs" yaml:"max_logs"`
+	UseDefaultPolicy       bool         `json:"use_default_policy" yaml:"use_default_policy"`
+}
+
+// RecoverTokenPairEscrowProposalRequest defines a request for a recover token pair escrow proposal.
+type RecoverTokenPairEscrowProposalRequest struct {
+	BaseReq      rest.BaseReq                `json:"base_req" yaml:"base_req"`
+	Title        string                      `json:"title" yaml:"title"`
+	Description  string                      `json:"description" yaml:"description"`
+	Deposit      sdk.Coins                   `json:"deposit" yaml:"deposit"`
+	Erc20Address string                      `json:"erc20_address" yaml:"erc20_address"`
+	Shares       []types.EscrowRecoveryShare `json:"shares" yaml:"shares"`
+}
+
+// RegisterWrappedEVMDenomProposalRequest defines a request for a register wrapped EVM denom proposal.
+type RegisterWrappedEVMDenomProposalRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Deposit     sdk.Coins    `json:"deposit" yaml:"deposit"`
+}
+
+// UpgradeERC20ImplementationProposalRequest defines a request for an upgrade ERC20 implementation proposal.
+type UpgradeERC20ImplementationProposalRequest struct {
+	BaseReq        rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title          string       `json:"title" yaml:"title"`
+	Description    string       `json:"description" yaml:"description"`
+	Deposit        sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Implementation string       `json:"implementation" yaml:"implementation"`
+	ERC20Addresses []string     `json:"erc20_addresses" yaml:"erc20_addresses"`
+}
+
+// UpdateCoinMetadataProposalRequest defines a request for an update coin metadata proposal.
+type UpdateCoinMetadataProposalRequest struct {
+	BaseReq     rest.BaseReq       `json:"base_req" yaml:"base_req"`
+	Title       string             `json:"title" yaml:"title"`
+	Description string             `json:"description" yaml:"description"`
+	Deposit     sdk.Coins          `json:"deposit" yaml:"deposit"`
+	Metadata    banktypes.Metadata `json:"metadata" yaml:"metadata"`
+}
+
 func RegisterCoinProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
 	return govrest.ProposalRESTHandler{
 		SubRoute: types.ModuleName,
@@ -79,6 +202,83 @@ func UpdateTokenPairERC20ProposalRESTHandler(clientCtx client.Context) govrest.P
 	}
 }
 
+func DeregisterTokenPairProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newDeregisterTokenPairProposalHandler(clientCtx),
+	}
+}
+
+func VetoERC20RegistrationProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newVetoERC20RegistrationProposalHandler(clientCtx),
+	}
+}
+
+func ExtendPauseProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newExtendPauseProposalHandler(clientCtx),
+	}
+}
+
+func UpdateRateLimitProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newUpdateRateLimitProposalHandler(clientCtx),
+	}
+}
+
+func UpdateConversionFeeProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newUpdateConversionFeeProposalHandler(clientCtx),
+	}
+}
+
+func UpdateMintCapProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newUpdateMintCapProposalHandler(clientCtx),
+	}
+}
+
+func UpdateSafetyPolicyProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newUpdateSafetyPolicyProposalHandler(clientCtx),
+	}
+}
+
+func RecoverTokenPairEscrowProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newRecoverTokenPairEscrowProposalHandler(clientCtx),
+	}
+}
+
+func RegisterWrappedEVMDenomProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newRegisterWrappedEVMDenomProposalHandler(clientCtx),
+	}
+}
+
+func UpgradeERC20ImplementationProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newUpgradeERC20ImplementationProposalHandler(clientCtx),
+	}
+}
+
+func UpdateCoinMetadataProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: types.ModuleName,
+		Handler:  newUpdateCoinMetadataProposalHandler(clientCtx),
+	}
+}
+
 // nolint: dupl
 func newRegisterCoinProposalHandler(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -98,7 +298,7 @@ func newRegisterCoinProposalHandler(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		content := types.NewRegisterCoinProposal(req.Title, req.Description, req.Metadata)
+		content := types.NewRegisterCoinProposal(req.Title, req.Description, req.Metadata, req.ScalingExponent, req.GasLimit)
 		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
 		if rest.CheckBadRequestError(w, err) {
 			return
@@ -131,7 +331,7 @@ func newRegisterERC20ProposalHandler(clientCtx client.Context) http.HandlerFunc
 			return
 		}
 
-		content := types.NewRegisterERC20Proposal(req.Title, req.Description, req.ERC20Address)
+		content := types.NewRegisterERC20Proposal(req.Title, req.Description, req.ERC20Address, req.Behaviour, req.ScalingExponent, req.GasLimit, req.Metadata)
 		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
 		if rest.CheckBadRequestError(w, err) {
 			return
@@ -209,3 +409,376 @@ func newUpdateTokenPairERC20ProposalHandler(clientCtx client.Context) http.Handl
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+// nolint: dupl
+func newDeregisterTokenPairProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DeregisterTokenPairProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewDeregisterTokenPairProposal(req.Title, req.Description, req.Token, req.Holders)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// nolint: dupl
+func newVetoERC20RegistrationProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req VetoERC20RegistrationProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewVetoERC20RegistrationProposal(req.Title, req.Description, req.ERC20Address)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// nolint: dupl
+func newExtendPauseProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ExtendPauseProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewExtendPauseProposal(req.Title, req.Description, req.Token, req.Duration)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// nolint: dupl
+func newUpdateRateLimitProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateRateLimitProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewUpdateRateLimitProposal(
+			req.Title, req.Description, req.Token,
+			req.MaxPerConversion, req.InflowCap, req.OutflowCap, req.Window, req.EpochIdentifier,
+		)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// nolint: dupl
+func newUpdateConversionFeeProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateConversionFeeProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewUpdateConversionFeeProposal(
+			req.Title, req.Description, req.Token,
+			req.Rate, req.MinFee, req.UseGlobalFee,
+		)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// nolint: dupl
+func newUpdateMintCapProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateMintCapProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewUpdateMintCapProposal(req.Title, req.Description, req.Token, req.Cap)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// nolint: dupl
+func newUpdateSafetyPolicyProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateSafetyPolicyProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewUpdateSafetyPolicyProposal(
+			req.Title, req.Description, req.Token,
+			req.AllowedEventSignatures, req.AllowedEmitters, req.MaxLogs, req.UseDefaultPolicy,
+		)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// nolint: dupl
+func newRecoverTokenPairEscrowProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RecoverTokenPairEscrowProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		contract := common.HexToAddress(req.Erc20Address)
+		content := types.NewRecoverTokenPairEscrowProposal(req.Title, req.Description, contract, req.Shares)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// nolint: dupl
+func newRegisterWrappedEVMDenomProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RegisterWrappedEVMDenomProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewRegisterWrappedEVMDenomProposal(req.Title, req.Description)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// nolint: dupl
+func newUpgradeERC20ImplementationProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpgradeERC20ImplementationProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewUpgradeERC20ImplementationProposal(req.Title, req.Description, req.Implementation, req.ERC20Addresses...)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+// nolint: dupl
+func newUpdateCoinMetadataProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateCoinMetadataProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewUpdateCoinMetadataProposal(req.Title, req.Description, req.Metadata)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
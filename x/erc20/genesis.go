@@ -1,11 +1,15 @@
 package erc20
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/tharsis/evmos/x/erc20/keeper"
 	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
 )
 
 // InitGenesis import module genesis
@@ -28,12 +32,98 @@ func InitGenesis(
 		k.SetDenomMap(ctx, pair.Denom, id)
 		k.SetERC20Map(ctx, pair.GetERC20Contract(), id)
 	}
+
+	for _, rateLimit := range data.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	for _, volume := range data.ConversionVolumes {
+		k.SetPeriodConversionVolume(ctx, volume.GetERC20Contract(), volume.Period, volume.Volume)
+	}
+
+	for _, registration := range data.PendingRegistrations {
+		k.SetPendingRegistration(ctx, registration)
+	}
+
+	for _, pause := range data.Pauses {
+		k.SetPause(ctx, pause)
+	}
+
+	for _, conversionFee := range data.ConversionFees {
+		k.SetConversionFee(ctx, conversionFee)
+	}
+
+	for _, stats := range data.TokenPairStats {
+		k.SetTokenPairStats(ctx, stats)
+	}
+
+	for _, mintCap := range data.MintCaps {
+		k.SetMintCap(ctx, mintCap)
+	}
+
+	for _, policy := range data.SafetyPolicies {
+		k.SetSafetyPolicy(ctx, policy)
+	}
+
+	for _, tombstone := range data.TokenPairTombstones {
+		k.SetTokenPairTombstone(ctx, tombstone)
+	}
+
+	for _, contract := range data.DeregisteredErc20S {
+		k.SetERC20Deregistered(ctx, common.HexToAddress(contract))
+	}
+
+	for _, transfer := range data.Erc20Transfers {
+		k.SetERC20Transfer(ctx, transfer.PortId, transfer.ChannelId, transfer.Sequence, transfer.GetERC20Contract())
+	}
+
+	if data.WrappedEvmDenomContract != "" {
+		k.SetWrappedEVMDenomContract(ctx, common.HexToAddress(data.WrappedEvmDenomContract))
+	}
+
+	if data.RegisterWrappedEvmDenom {
+		if _, err := k.RegisterWrappedEVMDenom(ctx); err != nil {
+			panic(fmt.Errorf("failed to register the wrapped evm denom: %w", err))
+		}
+	}
+
+	if data.Erc20Implementation != "" {
+		if _, found := contracts.GetERC20Implementation(data.Erc20Implementation); !found {
+			panic(fmt.Errorf("unknown erc20 implementation %s", data.Erc20Implementation))
+		}
+		k.SetERC20Implementation(ctx, data.Erc20Implementation)
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	var wrappedEVMDenomContract string
+	if contract, found := k.GetWrappedEVMDenomContract(ctx); found {
+		wrappedEVMDenomContract = contract.Hex()
+	}
+
+	deregisteredERC20s := []string{}
+	for _, contract := range k.GetAllDeregisteredERC20s(ctx) {
+		deregisteredERC20s = append(deregisteredERC20s, contract.Hex())
+	}
+
 	return &types.GenesisState{
 		Params:     k.GetParams(ctx),
 		TokenPairs: k.GetAllTokenPairs(ctx),
+		RateLimits: k.GetAllRateLimits(ctx),
+
+		Erc20Implementation:  k.GetERC20Implementation(ctx),
+		PendingRegistrations: k.GetAllPendingRegistrations(ctx),
+		Pauses:               k.GetAllPauses(ctx),
+		ConversionFees:       k.GetAllConversionFees(ctx),
+		TokenPairStats:       k.GetAllTokenPairStats(ctx),
+		MintCaps:             k.GetAllMintCaps(ctx),
+		SafetyPolicies:       k.GetAllSafetyPolicies(ctx),
+		TokenPairTombstones:  k.GetAllTokenPairTombstones(ctx),
+		DeregisteredErc20S:   deregisteredERC20s,
+		Erc20Transfers:       k.GetAllERC20Transfers(ctx),
+		ConversionVolumes:    k.GetAllPeriodConversionVolumes(ctx),
+
+		WrappedEvmDenomContract: wrappedEVMDenomContract,
 	}
 }
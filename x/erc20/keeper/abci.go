@@ -0,0 +1,14 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker activates the token pairs registered through MsgRegisterERC20
+// whose challenge period is over, removes the expired pauses and tombstones the
+// token pairs whose ERC20 contract was destroyed
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.ActivateRegistrations(ctx)
+	k.PruneExpiredPauses(ctx)
+	k.TombstoneSelfDestructedPairs(ctx)
+}
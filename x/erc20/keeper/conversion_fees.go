@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// GetAllConversionFees returns all the conversion fees of the token pairs
+// stored
+func (k Keeper) GetAllConversionFees(ctx sdk.Context) []types.ConversionFee {
+	conversionFees := []types.ConversionFee{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixConversionFee)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var conversionFee types.ConversionFee
+		k.cdc.MustUnmarshal(iterator.Value(), &conversionFee)
+
+		conversionFees = append(conversionFees, conversionFee)
+	}
+
+	return conversionFees
+}
+
+// GetPairConversionFee returns the conversion fee of the token pair with the
+// given ERC20 contract, if it overrides the global conversion fee
+func (k Keeper) GetPairConversionFee(ctx sdk.Context, contract common.Address) (types.ConversionFee, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionFee)
+	var conversionFee types.ConversionFee
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.ConversionFee{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &conversionFee)
+	return conversionFee, true
+}
+
+// SetConversionFee stores the conversion fee of a token pair
+func (k Keeper) SetConversionFee(ctx sdk.Context, conversionFee types.ConversionFee) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionFee)
+	bz := k.cdc.MustMarshal(&conversionFee)
+	store.Set(conversionFee.GetERC20Contract().Bytes(), bz)
+}
+
+// DeleteConversionFee removes the conversion fee of the token pair with the
+// given ERC20 contract, so that the global conversion fee applies
+func (k Keeper) DeleteConversionFee(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionFee)
+	store.Delete(contract.Bytes())
+}
+
+// GetConversionFee returns the conversion fee that applies to the token pair,
+// which is the global conversion fee of the module parameters unless the pair
+// overrides it. The returned boolean is true if the pair overrides the global
+// conversion fee.
+func (k Keeper) GetConversionFee(ctx sdk.Context, pair types.TokenPair) (types.ConversionFee, bool) {
+	if conversionFee, found := k.GetPairConversionFee(ctx, pair.GetERC20Contract()); found {
+		return conversionFee, true
+	}
+
+	conversionFee := k.GetParams(ctx).GetConversionFee(pair.Denom)
+	conversionFee.Erc20Address = pair.Erc20Address
+	return conversionFee, false
+}
+
+// ComputeConversionFee returns the fee charged on the conversion of the given
+// amount of the token pair. It fails if the amount doesn't cover the fee.
+func (k Keeper) ComputeConversionFee(ctx sdk.Context, pair types.TokenPair, amount sdk.Int) (sdk.Int, error) {
+	conversionFee, _ := k.GetConversionFee(ctx, pair)
+	if conversionFee.IsZero() {
+		return sdk.ZeroInt(), nil
+	}
+
+	fee := conversionFee.Compute(amount)
+	if fee.GTE(amount) {
+		return sdk.Int{}, sdkerrors.Wrapf(
+			types.ErrInsufficientConversion,
+			"amount %s%s, fee %s%s", amount, pair.Denom, fee, pair.Denom,
+		)
+	}
+
+	return fee, nil
+}
+
+// collectConversionFee routes the fee coins held by the module account to the
+// destination set on the module parameters. The fees of native ERC20 pairs
+// are never burned, as the escrowed ERC20 tokens backing them can't be burned
+// through an arbitrary ERC20 contract and the escrow must keep matching the
+// coin supply. They fund the community pool instead.
+func (k Keeper) collectConversionFee(ctx sdk.Context, pair types.TokenPair, fee sdk.Int) error {
+	if !fee.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.Coins{{Denom: pair.Denom, Amount: fee}}
+
+	destination := k.GetParams(ctx).ConversionFeeDestination
+	if destination == types.FEE_DESTINATION_BURN && pair.IsNativeERC20() {
+		destination = types.FEE_DESTINATION_COMMUNITY_POOL
+	}
+
+	switch destination {
+	case types.FEE_DESTINATION_COMMUNITY_POOL:
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		if err := k.distrKeeper.FundCommunityPool(ctx, coins, moduleAddr); err != nil {
+			return sdkerrors.Wrap(err, "failed to fund community pool with conversion fee")
+		}
+	case types.FEE_DESTINATION_FEE_COLLECTOR:
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins); err != nil {
+			return sdkerrors.Wrap(err, "failed to send conversion fee to fee collector")
+		}
+	case types.FEE_DESTINATION_BURN:
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return sdkerrors.Wrap(err, "failed to burn conversion fee")
+		}
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid conversion fee destination %s", destination)
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/evmos/x/erc20/keeper"
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// setConversionFee sets the global conversion fee of the module parameters
+func (suite *KeeperTestSuite) setConversionFee(rate sdk.Dec, minFees sdk.Coins, destination types.FeeDestination) {
+	params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+	params.ConversionFeeRate = rate
+	params.MinConversionFees = minFees
+	params.ConversionFeeDestination = destination
+	suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+}
+
+// collectedFees returns the amount of coins of the denom held by the
+// destination of the conversion fees
+func (suite *KeeperTestSuite) collectedFees(destination types.FeeDestination, denom string) sdk.Int {
+	switch destination {
+	case types.FEE_DESTINATION_COMMUNITY_POOL:
+		return suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom).TruncateInt()
+	case types.FEE_DESTINATION_FEE_COLLECTOR:
+		feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		return suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom).Amount
+	default:
+		return sdk.ZeroInt()
+	}
+}
+
+func (suite *KeeperTestSuite) TestConversionFeeNativeCoin() {
+	destinations := []types.FeeDestination{
+		types.FEE_DESTINATION_COMMUNITY_POOL,
+		types.FEE_DESTINATION_FEE_COLLECTOR,
+		types.FEE_DESTINATION_BURN,
+	}
+
+	for _, destination := range destinations {
+		suite.Run(fmt.Sprintf("Case %s", destination), func() {
+			_, pair := suite.setupRegisterCoin()
+			suite.setConversionFee(sdk.NewDecWithPrec(1, 2), sdk.Coins{}, destination)
+			sender := sdk.AccAddress(suite.address.Bytes())
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, pair.Denom).Amount
+
+			// 1% of 1000 coins
+			suite.Require().NoError(suite.convertCoin(pair, 1000))
+			balance := suite.BalanceOf(pair.GetERC20Contract(), suite.address)
+			suite.Require().Equal(big.NewInt(990), balance)
+
+			// 1% of 990 tokens, rounded up
+			msg := types.NewMsgConvertERC20(sdk.NewInt(990), sender, pair.GetERC20Contract(), suite.address)
+			_, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(980), suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom).Amount)
+
+			if destination == types.FEE_DESTINATION_BURN {
+				suite.Require().Equal(supply.AddRaw(980), suite.app.BankKeeper.GetSupply(suite.ctx, pair.Denom).Amount)
+			} else {
+				suite.Require().Equal(sdk.NewInt(20), suite.collectedFees(destination, pair.Denom))
+			}
+
+			msg2, broken := keeper.NativeCoinEscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+			suite.Require().False(broken, msg2)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConversionFeeNativeERC20() {
+	destinations := []types.FeeDestination{
+		types.FEE_DESTINATION_COMMUNITY_POOL,
+		types.FEE_DESTINATION_FEE_COLLECTOR,
+		types.FEE_DESTINATION_BURN,
+	}
+
+	for _, destination := range destinations {
+		suite.Run(fmt.Sprintf("Case %s", destination), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+			suite.Require().NotNil(contractAddr)
+			suite.Commit()
+
+			denom := types.CreateDenom(contractAddr.String())
+			suite.setConversionFee(sdk.ZeroDec(), sdk.NewCoins(sdk.NewInt64Coin(denom, 10)), destination)
+			sender := sdk.AccAddress(suite.address.Bytes())
+			suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(1000))
+			suite.Commit()
+
+			msg := types.NewMsgConvertERC20(sdk.NewInt(1000), sender, contractAddr, suite.address)
+			_, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(990), suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom).Amount)
+
+			msg2 := types.NewMsgConvertCoin(sdk.NewCoin(denom, sdk.NewInt(990)), suite.address, sender)
+			_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg2)
+			suite.Require().NoError(err)
+
+			// the fees of native ERC20 pairs fund the community pool instead
+			// of being burned, so that they stay backed by the escrow
+			if destination == types.FEE_DESTINATION_BURN {
+				destination = types.FEE_DESTINATION_COMMUNITY_POOL
+			}
+			suite.Require().Equal(sdk.NewInt(20), suite.collectedFees(destination, denom))
+			suite.Require().Equal(sdk.NewInt(20), suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount)
+
+			suite.Commit()
+			suite.Require().Equal(big.NewInt(980), suite.BalanceOf(contractAddr, suite.address))
+			suite.Require().Equal(big.NewInt(20), suite.BalanceOf(contractAddr, types.ModuleAddress))
+
+			msg3, broken := keeper.NativeERC20EscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+			suite.Require().False(broken, msg3)
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestConversionFeeInsufficientAmount() {
+	_, pair := suite.setupRegisterCoin()
+
+	// the minimum fee of other denominations doesn't apply to the pair
+	suite.setConversionFee(sdk.ZeroDec(), sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)), types.FEE_DESTINATION_COMMUNITY_POOL)
+	suite.Require().NoError(suite.convertCoin(pair, 100))
+	suite.Require().Equal(big.NewInt(100), suite.BalanceOf(pair.GetERC20Contract(), suite.address))
+
+	suite.setConversionFee(sdk.ZeroDec(), sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 100)), types.FEE_DESTINATION_COMMUNITY_POOL)
+
+	err := suite.convertCoin(pair, 100)
+	suite.Require().ErrorIs(err, types.ErrInsufficientConversion)
+
+	suite.Require().NoError(suite.convertCoin(pair, 101))
+	suite.Require().Equal(big.NewInt(101), suite.BalanceOf(pair.GetERC20Contract(), suite.address))
+}
+
+func (suite *KeeperTestSuite) TestUpdateConversionFee() {
+	_, pair := suite.setupRegisterCoin()
+	suite.setConversionFee(sdk.NewDecWithPrec(1, 2), sdk.Coins{}, types.FEE_DESTINATION_COMMUNITY_POOL)
+
+	_, err := suite.app.Erc20Keeper.UpdateConversionFee(suite.ctx, "unregistered", sdk.ZeroDec(), sdk.ZeroInt(), false)
+	suite.Require().Error(err)
+
+	// the pair fee overrides the global fee
+	conversionFee, err := suite.app.Erc20Keeper.UpdateConversionFee(suite.ctx, pair.Denom, sdk.ZeroDec(), sdk.NewInt(5), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pair.Erc20Address, conversionFee.Erc20Address)
+
+	res, pairFee := suite.app.Erc20Keeper.GetConversionFee(suite.ctx, *pair)
+	suite.Require().True(pairFee)
+	suite.Require().Equal(conversionFee, res)
+
+	suite.Require().NoError(suite.convertCoin(pair, 1000))
+	suite.Require().Equal(big.NewInt(995), suite.BalanceOf(pair.GetERC20Contract(), suite.address))
+
+	// a zero pair fee disables the global fee for the pair
+	_, err = suite.app.Erc20Keeper.UpdateConversionFee(suite.ctx, pair.Erc20Address, sdk.ZeroDec(), sdk.ZeroInt(), false)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.convertCoin(pair, 1000))
+	suite.Require().Equal(big.NewInt(1995), suite.BalanceOf(pair.GetERC20Contract(), suite.address))
+
+	// the global fee applies again
+	conversionFee, err = suite.app.Erc20Keeper.UpdateConversionFee(suite.ctx, pair.Denom, sdk.Dec{}, sdk.Int{}, true)
+	suite.Require().NoError(err)
+	suite.Require().True(sdk.NewDecWithPrec(1, 2).Equal(conversionFee.Rate))
+
+	_, found := suite.app.Erc20Keeper.GetPairConversionFee(suite.ctx, pair.GetERC20Contract())
+	suite.Require().False(found)
+	suite.Require().Empty(suite.app.Erc20Keeper.GetAllConversionFees(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestConversionFeeDeregisterTokenPair() {
+	_, pair := suite.setupRegisterCoin()
+	conversionFee := types.NewConversionFee(pair.GetERC20Contract(), sdk.NewDecWithPrec(1, 2), sdk.ZeroInt())
+	suite.app.Erc20Keeper.SetConversionFee(suite.ctx, conversionFee)
+
+	_, _, err := suite.app.Erc20Keeper.DeregisterTokenPair(suite.ctx, pair.Denom, nil)
+	suite.Require().NoError(err)
+
+	_, found := suite.app.Erc20Keeper.GetPairConversionFee(suite.ctx, common.HexToAddress(pair.Erc20Address))
+	suite.Require().False(found)
+}
@@ -1,62 +1,104 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
+	"unicode/utf8"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/holiman/uint256"
 	"github.com/tharsis/ethermint/server/config"
+	"github.com/tharsis/ethermint/x/evm/statedb"
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 
 	"github.com/tharsis/evmos/x/erc20/types"
 	"github.com/tharsis/evmos/x/erc20/types/contracts"
 )
 
-// QueryERC20 returns the data of a deployed ERC20 contract
+// QueryERC20 returns the data of a deployed ERC20 contract. The name and
+// symbol of the tokens that return them as bytes32, such as MKR, are supported.
 func (k Keeper) QueryERC20(ctx sdk.Context, contract common.Address) (types.ERC20Data, error) {
-	var (
-		nameRes    types.ERC20StringResponse
-		symbolRes  types.ERC20StringResponse
-		decimalRes types.ERC20Uint8Response
-	)
-
-	erc20 := contracts.ERC20BurnableContract.ABI
-
-	// Name
-	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "name")
+	name, err := k.queryERC20String(ctx, contract, "name")
 	if err != nil {
 		return types.ERC20Data{}, err
 	}
 
-	if err := erc20.UnpackIntoInterface(&nameRes, "name", res.Ret); err != nil {
-		return types.ERC20Data{}, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to unpack name: %s", err.Error())
+	symbol, err := k.queryERC20String(ctx, contract, "symbol")
+	if err != nil {
+		return types.ERC20Data{}, err
 	}
 
-	// Symbol
-	res, err = k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "symbol")
+	decimals, err := k.QueryERC20Decimals(ctx, contract)
 	if err != nil {
 		return types.ERC20Data{}, err
 	}
 
-	if err := erc20.UnpackIntoInterface(&symbolRes, "symbol", res.Ret); err != nil {
-		return types.ERC20Data{}, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to unpack symbol: %s", err.Error())
-	}
+	return types.NewERC20Data(name, symbol, decimals), nil
+}
 
-	// Decimals
-	res, err = k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "decimals")
+// QueryERC20Decimals returns the decimals of a deployed ERC20 contract
+func (k Keeper) QueryERC20Decimals(ctx sdk.Context, contract common.Address) (uint8, error) {
+	var decimalRes types.ERC20Uint8Response
+
+	erc20 := contracts.ERC20BurnableContract.ABI
+
+	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "decimals")
 	if err != nil {
-		return types.ERC20Data{}, err
+		return 0, err
 	}
 
 	if err := erc20.UnpackIntoInterface(&decimalRes, "decimals", res.Ret); err != nil {
-		return types.ERC20Data{}, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to unpack decimals: %s", err.Error())
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to unpack decimals: %s", err.Error())
+	}
+
+	return decimalRes.Value, nil
+}
+
+// queryERC20String returns the string returned by the given method of a
+// deployed ERC20 contract, i.e name or symbol. If the result isn't a string,
+// it falls back to the bytes32 variant of the method, whose value is padded
+// with zeros on the right.
+func (k Keeper) queryERC20String(ctx sdk.Context, contract common.Address, method string) (string, error) {
+	var (
+		stringRes  types.ERC20StringResponse
+		bytes32Res types.ERC20Bytes32Response
+	)
+
+	erc20 := contracts.ERC20BurnableContract.ABI
+
+	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, method)
+	if err != nil {
+		return "", err
+	}
+
+	err = erc20.UnpackIntoInterface(&stringRes, method, res.Ret)
+	if err == nil {
+		return stringRes.Value, nil
+	}
+
+	// a string is encoded with its offset and length, so a single word can
+	// only be a bytes32
+	if len(res.Ret) != common.HashLength {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to unpack %s: %s", method, err.Error())
 	}
 
-	return types.NewERC20Data(nameRes.Value, symbolRes.Value, decimalRes.Value), nil
+	erc20Bytes32 := contracts.ERC20Bytes32Contract.ABI
+	if err := erc20Bytes32.UnpackIntoInterface(&bytes32Res, method, res.Ret); err != nil {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to unpack %s as bytes32: %s", method, err.Error())
+	}
+
+	value := string(bytes.TrimRight(bytes32Res.Value[:], "\x00"))
+	if !utf8.ValidString(value) {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "%s is not a valid UTF-8 string: %x", method, bytes32Res.Value)
+	}
+
+	return value, nil
 }
 
 // CallEVM performs a smart contract method call using  given args
@@ -76,35 +118,136 @@ func (k Keeper) CallEVM(ctx sdk.Context, abi abi.ABI, from, contract common.Addr
 	return resp, nil
 }
 
-// CallEVMWithPayload performs a smart contract method call using contract data
+// CallEVMWithPayload performs a smart contract method call using contract data.
+// The gas used by the call is consumed from the gas meter of the context.
 func (k Keeper) CallEVMWithPayload(ctx sdk.Context, from common.Address, contract *common.Address, transferData []byte) (*evmtypes.MsgEthereumTxResponse, error) {
-	nonce, err := k.accountKeeper.GetSequence(ctx, from.Bytes())
+	// NOTE: the EVM gas already prices the state accessed by the call, so the
+	// call runs on an infinite gas meter to not charge the KV gas on top of it
+	evmCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	nonce, err := k.accountKeeper.GetSequence(evmCtx, from.Bytes())
 	if err != nil {
 		return nil, err
 	}
 
+	gasLimit := k.evmCallGasLimit(evmCtx, ctx.GasMeter(), contract)
+
 	msg := ethtypes.NewMessage(
 		from,
 		contract,
 		nonce,
-		big.NewInt(0),        // amount
-		config.DefaultGasCap, // gasLimit
-		big.NewInt(0),        // gasFeeCap
-		big.NewInt(0),        // gasTipCap
-		big.NewInt(0),        // gasPrice
+		big.NewInt(0), // amount
+		gasLimit,      // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
 		transferData,
 		ethtypes.AccessList{}, // AccessList
 		true,                  // checkNonce
 	)
 
-	res, err := k.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), true)
+	res, err := k.evmKeeper.ApplyMessage(evmCtx, msg, evmtypes.NewNoOpTracer(), true)
 	if err != nil {
 		return nil, err
 	}
 
+	// charge the gas used by the call, including failed calls, to the Cosmos tx
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "erc20 evm call")
+
 	if res.Failed() {
 		return nil, sdkerrors.Wrap(evmtypes.ErrVMExecution, res.VmError)
 	}
 
 	return res, nil
 }
+
+// evmCallGasLimit returns the gas limit of a call to the given contract: the gas
+// limit of its token pair if set, otherwise the EVM gas cap. The gas limit is
+// bounded by the gas remaining on the given gas meter, if any.
+func (k Keeper) evmCallGasLimit(ctx sdk.Context, meter sdk.GasMeter, contract *common.Address) uint64 {
+	gasLimit := uint64(config.DefaultGasCap)
+	if contract != nil {
+		id := k.GetERC20Map(ctx, *contract)
+		if pair, found := k.GetTokenPair(ctx, id); found && pair.GasLimit != 0 && pair.GasLimit < gasLimit {
+			gasLimit = pair.GasLimit
+		}
+	}
+
+	// an infinite gas meter has no limit
+	if meter.Limit() == 0 {
+		return gasLimit
+	}
+
+	remaining := uint64(0)
+	if meter.Limit() > meter.GasConsumed() {
+		remaining = meter.Limit() - meter.GasConsumed()
+	}
+
+	if remaining < gasLimit {
+		return remaining
+	}
+	return gasLimit
+}
+
+// CallEVMCreate2 deploys a contract from the given account with the CREATE2
+// opcode semantics, so that the contract address only depends on the deployer,
+// the salt and the creation code.
+func (k Keeper) CallEVMCreate2(ctx sdk.Context, from common.Address, salt common.Hash, data []byte) (common.Address, error) {
+	// NOTE: the EVM gas already prices the state accessed by the deployment,
+	// so it runs on an infinite gas meter to not charge the KV gas on top of it
+	evmCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	cfg, err := k.evmKeeper.EVMConfig(evmCtx)
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrap(err, "failed to load evm config")
+	}
+
+	if !cfg.Params.EnableCreate {
+		return common.Address{}, sdkerrors.Wrap(evmtypes.ErrCreateDisabled, "failed to create new contract")
+	}
+
+	nonce, err := k.accountKeeper.GetSequence(evmCtx, from.Bytes())
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	gasLimit := k.evmCallGasLimit(evmCtx, ctx.GasMeter(), nil)
+
+	msg := ethtypes.NewMessage(
+		from,
+		nil,
+		nonce,
+		big.NewInt(0), // amount
+		gasLimit,      // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		true,                  // checkNonce
+	)
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	stateDB := statedb.New(evmCtx, k.evmKeeper, txConfig)
+	evm := k.evmKeeper.NewEVM(evmCtx, msg, cfg, evmtypes.NewNoOpTracer(), stateDB)
+
+	_, contractAddr, leftoverGas, err := evm.Create2(
+		vm.AccountRef(from),
+		data,
+		msg.Gas(),
+		big.NewInt(0),
+		new(uint256.Int).SetBytes(salt.Bytes()),
+	)
+
+	ctx.GasMeter().ConsumeGas(msg.Gas()-leftoverGas, "erc20 evm create2")
+
+	if err != nil {
+		return common.Address{}, sdkerrors.Wrap(evmtypes.ErrVMExecution, err.Error())
+	}
+
+	if err := stateDB.Commit(); err != nil {
+		return common.Address{}, sdkerrors.Wrap(err, "failed to commit stateDB")
+	}
+
+	return contractAddr, nil
+}
@@ -30,7 +30,9 @@ func (k Keeper) Hooks() Hooks {
 // TODO: Make sure that if ConvertERC20 is called, that the Hook doesnt trigger
 // if it does, delete minting from ConvertErc20
 
-// PostTxProcessing implements EvmHooks.PostTxProcessing
+// PostTxProcessing implements EvmHooks.PostTxProcessing. The conversion
+// safety rules are not checked on the logs of the receipt (see
+// checkConversionSafety).
 func (h Hooks) PostTxProcessing(
 	ctx sdk.Context,
 	from common.Address,
@@ -104,18 +106,65 @@ func (h Hooks) PostTxProcessing(
 			continue
 		}
 
+		// check that the guardian hasn't paused the module or the pair
+		if err := h.k.CheckNotPaused(ctx, pair); err != nil {
+			return err
+		}
+
+		// process the conversion on a cached context so that the state
+		// transitions of a log are only persisted if all of them succeed
+		cacheCtx, writeCache := ctx.CacheContext()
+
 		// check that the event is Burn from the ERC20Burnable interface
 		// NOTE: assume that if they are burning the token that has been registered as a pair, they want to mint a Cosmos coin
 
-		// create the corresponding sdk.Coin that is paired with ERC20
-		coins := sdk.Coins{{Denom: pair.Denom, Amount: sdk.NewIntFromBigInt(tokens)}}
+		// create the corresponding sdk.Coin that is paired with ERC20, scaled down
+		// with the pair scaling. Fail the transaction if the transferred tokens
+		// don't cover a base unit of the coin.
+		amount, remainder, err := tokensToCoins(pair, sdk.NewIntFromBigInt(tokens))
+		if err != nil {
+			return err
+		}
+		coins := sdk.Coins{{Denom: pair.Denom, Amount: amount}}
+
+		// Only need last 20 bytes from log.topics
+		from := common.BytesToAddress(log.Topics[1].Bytes())
+		recipient := sdk.AccAddress(from.Bytes())
+
+		// fail the transaction if the conversion exceeds the pair rate limit
+		if err := h.k.ConsumeRateLimit(cacheCtx, pair, coins[0].Amount, types.ConversionOutflow); err != nil {
+			return err
+		}
+
+		// fail the transaction if minting the coins of an external ERC20
+		// exceeds the pair mint cap
+		if pair.IsNativeERC20() {
+			if err := h.k.CheckMintCap(cacheCtx, pair, coins[0].Amount); err != nil {
+				return err
+			}
+		}
+
+		// fail the transaction if the transferred tokens don't cover the
+		// conversion fee
+		fee, err := h.k.ComputeConversionFee(cacheCtx, pair, coins[0].Amount)
+		if err != nil {
+			return err
+		}
+
+		// refund the tokens that can't be converted with the pair scaling
+		if err := h.k.refundTokenRemainder(cacheCtx, pair, from, remainder); err != nil {
+			return err
+		}
 
 		// Mint the coin only if ERC20 is external
 		switch pair.ContractOwner {
 		case types.OWNER_MODULE:
-			_, err = h.k.CallEVM(ctx, erc20, types.ModuleAddress, contractAddr, "burn", tokens)
+			_, err = h.k.CallEVM(cacheCtx, erc20, types.ModuleAddress, contractAddr, "burn", sdk.NewIntFromBigInt(tokens).Sub(remainder).BigInt())
+			if err == nil {
+				err = h.k.unescrowWrappedEVMDenom(cacheCtx, pair, coins[0].Amount)
+			}
 		case types.OWNER_EXTERNAL:
-			err = h.k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+			err = h.k.bankKeeper.MintCoins(cacheCtx, types.ModuleName, coins)
 		default:
 			err = types.ErrUndefinedOwner
 		}
@@ -128,12 +177,14 @@ func (h Hooks) PostTxProcessing(
 			continue
 		}
 
-		// Only need last 20 bytes from log.topics
-		from := common.BytesToAddress(log.Topics[1].Bytes())
-		recipient := sdk.AccAddress(from.Bytes())
+		// collect the conversion fee from the minted or escrowed coins
+		if err := h.k.collectConversionFee(cacheCtx, pair, fee); err != nil {
+			return err
+		}
+		coins = sdk.Coins{{Denom: pair.Denom, Amount: coins[0].Amount.Sub(fee)}}
 
 		// transfer the tokens from ModuleAccount to sender address
-		if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, recipient, coins); err != nil {
 			h.k.Logger(ctx).Debug(
 				"failed to process EVM hook for ER20 -> coin conversion",
 				"tx-hash", receipt.TxHash.Hex(), "log-idx", i,
@@ -141,6 +192,12 @@ func (h Hooks) PostTxProcessing(
 			)
 			continue
 		}
+
+		h.k.recordConversion(cacheCtx, pair, amount, types.ConversionOutflow, true)
+
+		writeCache()
+		// NOTE: the cached context has its own event manager
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 	}
 
 	return nil
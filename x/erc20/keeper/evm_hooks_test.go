@@ -29,7 +29,7 @@ func (suite *KeeperTestSuite) TestEvmHooksRegisterERC20() {
 		{
 			"correct execution",
 			func(contractAddr common.Address) {
-				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOUR_STANDARD, 0, 0, nil)
 				suite.Require().NoError(err)
 
 				// Mint 10 tokens to suite.address (owner)
@@ -56,7 +56,7 @@ func (suite *KeeperTestSuite) TestEvmHooksRegisterERC20() {
 		{
 			"wrong event",
 			func(contractAddr common.Address) {
-				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOUR_STANDARD, 0, 0, nil)
 				suite.Require().NoError(err)
 
 				// Mint 10 tokens to suite.address (owner)
@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/tharsis/ethermint/tests"
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
@@ -15,16 +18,25 @@ func (suite *KeeperTestSuite) TestQueryERC20() {
 		name     string
 		malleate func()
 		res      bool
+		expRes   types.ERC20Data
 	}{
 		{
 			"erc20 not deployed",
 			func() { contract = common.Address{} },
 			false,
+			types.ERC20Data{},
 		},
 		{
 			"ok",
 			func() { contract = suite.DeployContract("coin", "token", erc20Decimals) },
 			true,
+			types.ERC20Data{Name: "coin", Symbol: "token", Decimals: erc20Decimals},
+		},
+		{
+			"ok - bytes32 name and symbol",
+			func() { contract = suite.DeployContractQuirky(contracts.ERC20Bytes32Contract) },
+			true,
+			types.ERC20Data{Name: "Maker", Symbol: "MKR", Decimals: 18},
 		},
 	}
 	for _, tc := range testCases {
@@ -35,10 +47,7 @@ func (suite *KeeperTestSuite) TestQueryERC20() {
 		res, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, contract)
 		if tc.res {
 			suite.Require().NoError(err)
-			suite.Require().Equal(
-				types.ERC20Data{Name: "coin", Symbol: "token", Decimals: erc20Decimals},
-				res,
-			)
+			suite.Require().Equal(tc.expRes, res)
 		} else {
 			suite.Require().Error(err)
 		}
@@ -78,3 +87,97 @@ func (suite *KeeperTestSuite) TestCallEVM() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestCallEVMGas() {
+	testCases := []struct {
+		name     string
+		gasLimit uint64
+		meter    sdk.GasMeter
+		expPass  bool
+	}{
+		{
+			"pass - gas consumed from the gas meter",
+			0,
+			sdk.NewGasMeter(10_000_000),
+			true,
+		},
+		{
+			"pass - gas limit of the token pair",
+			100_000,
+			sdk.NewGasMeter(10_000_000),
+			true,
+		},
+		{
+			"fail - call exceeds the gas limit of the token pair",
+			22_000,
+			sdk.NewGasMeter(10_000_000),
+			false,
+		},
+		{
+			"fail - call exceeds the remaining gas",
+			0,
+			sdk.NewGasMeter(22_000),
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			contract := suite.DeployContract("coin", "token", erc20Decimals)
+			suite.Commit()
+
+			_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract, types.TOKEN_BEHAVIOUR_STANDARD, 0, tc.gasLimit, nil)
+			suite.Require().NoError(err)
+
+			ctx := suite.ctx.WithGasMeter(tc.meter)
+			res, err := suite.app.Erc20Keeper.CallEVM(ctx, erc20, types.ModuleAddress, contract, "balanceOf", tests.GenerateAddress())
+			if tc.expPass {
+				suite.Require().NoError(err)
+				// only the EVM gas is charged, without the KV gas of the call
+				suite.Require().Equal(res.GasUsed, ctx.GasMeter().GasConsumed())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20Gas() {
+	testCases := []struct {
+		name     string
+		gasLimit uint64
+		expPass  bool
+	}{
+		{"pass - without gas limit", 0, true},
+		{"pass - gas limit of the token pair", 200_000, true},
+		{"fail - transfer exceeds the gas limit of the token pair", 30_000, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			contract := suite.DeployContract("coin", "token", erc20Decimals)
+			suite.Commit()
+
+			_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract, types.TOKEN_BEHAVIOUR_STANDARD, 0, tc.gasLimit, nil)
+			suite.Require().NoError(err)
+
+			suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+
+			ctx := suite.ctx.WithGasMeter(sdk.NewGasMeter(10_000_000))
+			msg := types.NewMsgConvertERC20(sdk.NewInt(100), sdk.AccAddress(suite.address.Bytes()), contract, suite.address)
+			_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			// the gas of the EVM calls is charged to the Cosmos tx
+			suite.Require().Greater(ctx.GasMeter().GasConsumed(), uint64(21_000))
+		})
+	}
+}
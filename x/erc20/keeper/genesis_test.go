@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/tharsis/evmos/x/erc20"
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestExportImportGenesis() {
+	suite.SetupTest()
+	contract := tests.GenerateAddress()
+	_, pair := suite.setupRegisterCoin()
+
+	// the volumes converted within the window still count against the caps
+	// after a chain restart
+	rateLimit, err := suite.app.Erc20Keeper.UpdateRateLimit(suite.ctx, pair.Denom, sdk.ZeroInt(), sdk.NewInt(100), sdk.ZeroInt(), 10, "")
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, *pair, sdk.NewInt(60), types.ConversionInflow))
+
+	// in-flight transfers are refunded on the acknowledgement or timeout of
+	// their packet after a chain restart
+	suite.app.Erc20Keeper.SetERC20Transfer(suite.ctx, transfertypes.PortID, "channel-0", 1, contract)
+	suite.app.Erc20Keeper.SetERC20Transfer(suite.ctx, transfertypes.PortID, "channel-10", 256, contract)
+
+	genesis := erc20.ExportGenesis(suite.ctx, suite.app.Erc20Keeper)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.Erc20Transfers, 2)
+	suite.Require().Len(genesis.ConversionVolumes, 1)
+
+	suite.SetupTest()
+	erc20.InitGenesis(suite.ctx, suite.app.Erc20Keeper, suite.app.AccountKeeper, *genesis)
+
+	addr, found := suite.app.Erc20Keeper.GetERC20Transfer(suite.ctx, transfertypes.PortID, "channel-10", 256)
+	suite.Require().True(found)
+	suite.Require().Equal(contract, addr)
+
+	err = suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, *pair, sdk.NewInt(60), types.ConversionInflow)
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+	suite.Require().Equal(int64(60), suite.app.Erc20Keeper.GetConversionVolume(suite.ctx, rateLimit).Inflow.Int64())
+	suite.Require().Equal(genesis, erc20.ExportGenesis(suite.ctx, suite.app.Erc20Keeper))
+}
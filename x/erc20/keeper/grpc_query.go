@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -9,9 +10,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
 	ethermint "github.com/tharsis/ethermint/types"
 
 	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -77,9 +80,526 @@ func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (
 	return &types.QueryTokenPairResponse{TokenPair: pair}, nil
 }
 
+// RateLimits returns the conversion rate limits of the token pairs and their
+// remaining capacity
+func (k Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var capacities []types.RateLimitCapacity
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+		capacities = append(capacities, k.GetRateLimitCapacity(ctx, rateLimit))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryRateLimitsResponse{
+		RateLimits: capacities,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RateLimit returns the conversion rate limit of a given token pair and its
+// remaining capacity
+func (k Keeper) RateLimit(c context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(req.Token); err != nil {
+		if err := sdk.ValidateDenom(req.Token); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for token %s, should be either hex ('0x...') cosmos denom", req.Token,
+			)
+		}
+	}
+
+	id := k.GetTokenPairID(ctx, req.Token)
+
+	if len(id) == 0 {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, pair.GetERC20Contract())
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "rate limit for token pair with token '%s'", req.Token)
+	}
+
+	return &types.QueryRateLimitResponse{RateLimit: k.GetRateLimitCapacity(ctx, rateLimit)}, nil
+}
+
+// MintCaps returns the mint caps of the token pairs and their utilization
+func (k Keeper) MintCaps(c context.Context, req *types.QueryMintCapsRequest) (*types.QueryMintCapsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var utilizations []types.MintCapUtilization
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintCap)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var mintCap types.MintCap
+		if err := k.cdc.Unmarshal(value, &mintCap); err != nil {
+			return err
+		}
+
+		id := k.GetERC20Map(ctx, mintCap.GetERC20Contract())
+		pair, found := k.GetTokenPair(ctx, id)
+		if !found {
+			return fmt.Errorf("token pair with token '%s' not found", mintCap.Erc20Address)
+		}
+
+		utilizations = append(utilizations, k.GetMintCapUtilization(ctx, mintCap, pair.Denom))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryMintCapsResponse{
+		MintCaps:   utilizations,
+		Pagination: pageRes,
+	}, nil
+}
+
+// MintCap returns the mint cap of a given token pair and its utilization
+func (k Keeper) MintCap(c context.Context, req *types.QueryMintCapRequest) (*types.QueryMintCapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(req.Token); err != nil {
+		if err := sdk.ValidateDenom(req.Token); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for token %s, should be either hex ('0x...') cosmos denom", req.Token,
+			)
+		}
+	}
+
+	id := k.GetTokenPairID(ctx, req.Token)
+
+	if len(id) == 0 {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	mintCap, found := k.GetMintCap(ctx, pair.GetERC20Contract())
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "mint cap for token pair with token '%s'", req.Token)
+	}
+
+	return &types.QueryMintCapResponse{MintCap: k.GetMintCapUtilization(ctx, mintCap, pair.Denom)}, nil
+}
+
+// SafetyPolicy returns the safety policy that applies to the conversions of a
+// given token pair
+func (k Keeper) SafetyPolicy(c context.Context, req *types.QuerySafetyPolicyRequest) (*types.QuerySafetyPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(req.Token); err != nil {
+		if err := sdk.ValidateDenom(req.Token); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for token %s, should be either hex ('0x...') cosmos denom", req.Token,
+			)
+		}
+	}
+
+	id := k.GetTokenPairID(ctx, req.Token)
+
+	if len(id) == 0 {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	policy, pairPolicy := k.GetPairSafetyPolicy(ctx, pair)
+	return &types.QuerySafetyPolicyResponse{SafetyPolicy: policy, PairPolicy: pairPolicy}, nil
+}
+
+// WrappedEVMDenom returns the token pair of the wrapped EVM denomination
+func (k Keeper) WrappedEVMDenom(c context.Context, _ *types.QueryWrappedEVMDenomRequest) (*types.QueryWrappedEVMDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contract, found := k.GetWrappedEVMDenomContract(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "wrapped EVM denom not registered")
+	}
+
+	pair, found := k.GetTokenPair(ctx, k.GetERC20Map(ctx, contract))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", contract)
+	}
+
+	return &types.QueryWrappedEVMDenomResponse{TokenPair: pair}, nil
+}
+
+// TokenPairHealth checks the ERC20 contract code, total supply, escrow and
+// decimals of the registered token pairs
+func (k Keeper) TokenPairHealth(c context.Context, req *types.QueryTokenPairHealthRequest) (*types.QueryTokenPairHealthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var health []types.TokenPairHealth
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pair types.TokenPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return err
+		}
+		health = append(health, k.GetTokenPairHealth(ctx, pair))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTokenPairHealthResponse{
+		Health:     health,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TokenPairTombstones returns the token pairs removed because their ERC20
+// contract no longer has code and the deregistered native coin token pairs
+func (k Keeper) TokenPairTombstones(c context.Context, req *types.QueryTokenPairTombstonesRequest) (*types.QueryTokenPairTombstonesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var tombstones []types.TokenPairTombstone
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairTombstone)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var tombstone types.TokenPairTombstone
+		if err := k.cdc.Unmarshal(value, &tombstone); err != nil {
+			return err
+		}
+		tombstones = append(tombstones, tombstone)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTokenPairTombstonesResponse{
+		Tombstones: tombstones,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Pauses returns the active pause of the module, if any, and the active pauses
+// of the token pairs
+func (k Keeper) Pauses(c context.Context, req *types.QueryPausesRequest) (*types.QueryPausesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var pauses []types.Pause
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPairPause)
+
+	// NOTE: expired pauses are pruned at the end of the block
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var pause types.Pause
+		if err := k.cdc.Unmarshal(value, &pause); err != nil {
+			return false, err
+		}
+
+		if !pause.IsActive(ctx.BlockTime()) {
+			return false, nil
+		}
+
+		if accumulate {
+			pauses = append(pauses, pause)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryPausesResponse{
+		PairPauses: pauses,
+		Pagination: pageRes,
+	}
+
+	if pause, paused := k.IsModulePaused(ctx); paused {
+		res.ModulePause = &pause
+	}
+
+	return res, nil
+}
+
+// Pause returns the active pause of a given token pair
+func (k Keeper) Pause(c context.Context, req *types.QueryPauseRequest) (*types.QueryPauseResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(req.Token); err != nil {
+		if err := sdk.ValidateDenom(req.Token); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for token %s, should be either hex ('0x...') cosmos denom", req.Token,
+			)
+		}
+	}
+
+	id := k.GetTokenPairID(ctx, req.Token)
+
+	if len(id) == 0 {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	pause, paused := k.IsPairPaused(ctx, pair.GetERC20Contract())
+	if !paused {
+		return nil, status.Errorf(codes.NotFound, "active pause for token pair with token '%s'", req.Token)
+	}
+
+	return &types.QueryPauseResponse{Pause: pause}, nil
+}
+
+// ConversionFee returns the conversion fee that applies to a given token pair
+// and, if an amount is provided, the fee charged on its conversion
+func (k Keeper) ConversionFee(c context.Context, req *types.QueryConversionFeeRequest) (*types.QueryConversionFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(req.Token); err != nil {
+		if err := sdk.ValidateDenom(req.Token); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for token %s, should be either hex ('0x...') cosmos denom", req.Token,
+			)
+		}
+	}
+
+	amount := sdk.ZeroInt()
+	if req.Amount != "" {
+		var ok bool
+		amount, ok = sdk.NewIntFromString(req.Amount)
+		if !ok || amount.IsNegative() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid amount %s", req.Amount)
+		}
+	}
+
+	id := k.GetTokenPairID(ctx, req.Token)
+
+	if len(id) == 0 {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	conversionFee, pairFee := k.GetConversionFee(ctx, pair)
+
+	fee := sdk.ZeroInt()
+	if amount.IsPositive() && !conversionFee.IsZero() {
+		fee = conversionFee.Compute(amount)
+	}
+
+	return &types.QueryConversionFeeResponse{
+		ConversionFee: conversionFee,
+		PairFee:       pairFee,
+		Destination:   k.GetParams(ctx).ConversionFeeDestination,
+		Fee:           sdk.Coin{Denom: pair.Denom, Amount: fee},
+	}, nil
+}
+
+// PredictERC20Address returns the address of the ERC20 contract deployed on the
+// registration of a Cosmos coin, derived from its base denomination
+func (k Keeper) PredictERC20Address(c context.Context, req *types.QueryPredictERC20AddressRequest) (*types.QueryPredictERC20AddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// return the contract of the token pair if the coin is already registered
+	if id := k.GetDenomMap(ctx, req.Denom); len(id) != 0 {
+		pair, found := k.GetTokenPair(ctx, id)
+		if found {
+			return &types.QueryPredictERC20AddressResponse{Erc20Address: pair.Erc20Address}, nil
+		}
+	}
+
+	addr := ComputeERC20Address(req.Denom)
+	return &types.QueryPredictERC20AddressResponse{Erc20Address: addr.Hex()}, nil
+}
+
+// PairedBalances returns the Cosmos coin and ERC20 token balances of an
+// account for each of the registered token pairs
+func (k Keeper) PairedBalances(c context.Context, req *types.QueryPairedBalancesRequest) (*types.QueryPairedBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if the address is a hex address, if not, check if it is a valid
+	// bech32 address
+	var account common.Address
+	if err := ethermint.ValidateAddress(req.Address); err == nil {
+		account = common.HexToAddress(req.Address)
+	} else {
+		addr, err := sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for address %s, should be either hex ('0x...') or bech32", req.Address,
+			)
+		}
+		account = common.BytesToAddress(addr)
+	}
+
+	// EVM calls modify the module account nonce, so the state transitions are
+	// discarded to keep the query side-effect free
+	cacheCtx, _ := ctx.CacheContext()
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	var balances []types.PairedBalance
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pair types.TokenPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return err
+		}
+
+		// NOTE: the ERC20 balance is zero if the contract is suicided or the
+		// call fails
+		erc20Balance := sdk.ZeroInt()
+		if k.isContract(cacheCtx, pair) {
+			if balance := k.balanceOf(cacheCtx, erc20, pair.GetERC20Contract(), account); balance != nil {
+				erc20Balance = sdk.NewIntFromBigInt(balance)
+			}
+		}
+
+		balances = append(balances, types.PairedBalance{
+			Erc20Address: pair.Erc20Address,
+			Coin:         k.bankKeeper.GetBalance(ctx, account.Bytes(), pair.Denom),
+			Erc20Balance: erc20Balance,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPairedBalancesResponse{
+		Balances:   balances,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Params return hub contract param
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// TokenPairStats returns the cumulative conversion statistics of a given token
+// pair
+func (k Keeper) TokenPairStats(c context.Context, req *types.QueryTokenPairStatsRequest) (*types.QueryTokenPairStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(req.Token); err != nil {
+		if err := sdk.ValidateDenom(req.Token); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for token %s, should be either hex ('0x...') cosmos denom", req.Token,
+			)
+		}
+	}
+
+	id := k.GetTokenPairID(ctx, req.Token)
+
+	if len(id) == 0 {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	// token pairs that have never been converted have empty statistics
+	stats, found := k.GetTokenPairStats(ctx, pair.GetERC20Contract())
+	if !found {
+		stats = types.NewTokenPairStats(pair.GetERC20Contract())
+	}
+
+	return &types.QueryTokenPairStatsResponse{Stats: stats}, nil
+}
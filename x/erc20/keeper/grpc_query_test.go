@@ -5,9 +5,13 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/tharsis/ethermint/tests"
 
+	"github.com/tharsis/evmos/x/erc20/keeper"
 	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
+	inflationtypes "github.com/tharsis/evmos/x/inflation/types"
 )
 
 func (suite *KeeperTestSuite) TestTokenPairs() {
@@ -153,3 +157,930 @@ func (suite *KeeperTestSuite) TestTokenPair() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRateLimits() {
+	var (
+		req    *types.QueryRateLimitsRequest
+		expRes *types.QueryRateLimitsResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"no rate limits",
+			func() {
+				req = &types.QueryRateLimitsRequest{}
+				expRes = &types.QueryRateLimitsResponse{Pagination: &query.PageResponse{}}
+			},
+			true,
+		},
+		{
+			"2 rate limits w/pagination",
+			func() {
+				req = &types.QueryRateLimitsRequest{
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+				}
+				rl := types.NewRateLimit(tests.GenerateAddress(), sdk.NewInt(10), sdk.NewInt(100), sdk.NewInt(100), 10, "")
+				rl2 := types.NewRateLimit(tests.GenerateAddress(), sdk.NewInt(20), sdk.NewInt(200), sdk.NewInt(200), 10, "")
+				suite.app.Erc20Keeper.SetRateLimit(suite.ctx, rl)
+				suite.app.Erc20Keeper.SetRateLimit(suite.ctx, rl2)
+
+				expRes = &types.QueryRateLimitsResponse{
+					Pagination: &query.PageResponse{Total: 2},
+					RateLimits: []types.RateLimitCapacity{
+						types.NewRateLimitCapacity(rl, types.NewConversionVolume()),
+						types.NewRateLimitCapacity(rl2, types.NewConversionVolume()),
+					},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.RateLimits(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.Pagination, res.Pagination)
+				suite.Require().Len(res.RateLimits, len(expRes.RateLimits))
+				for _, expCapacity := range expRes.RateLimits {
+					found := false
+					for _, capacity := range res.RateLimits {
+						if !expCapacity.RateLimit.Equal(capacity.RateLimit) {
+							continue
+						}
+						found = true
+						suite.Require().Equal(expCapacity.RemainingInflow.String(), capacity.RemainingInflow.String())
+						suite.Require().Equal(expCapacity.RemainingOutflow.String(), capacity.RemainingOutflow.String())
+					}
+					suite.Require().True(found)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRateLimit() {
+	var (
+		req    *types.QueryRateLimitRequest
+		expRes *types.QueryRateLimitResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid token address",
+			func() {
+				req = &types.QueryRateLimitRequest{}
+				expRes = &types.QueryRateLimitResponse{}
+			},
+			false,
+		},
+		{
+			"token pair not found",
+			func() {
+				req = &types.QueryRateLimitRequest{
+					Token: tests.GenerateAddress().Hex(),
+				}
+				expRes = &types.QueryRateLimitResponse{}
+			},
+			false,
+		},
+		{
+			"rate limit not found",
+			func() {
+				addr := tests.GenerateAddress()
+				pair := types.NewTokenPair(addr, "coin", true, types.OWNER_MODULE)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+				suite.app.Erc20Keeper.SetERC20Map(suite.ctx, addr, pair.GetID())
+				suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+
+				req = &types.QueryRateLimitRequest{
+					Token: pair.Denom,
+				}
+				expRes = &types.QueryRateLimitResponse{}
+			},
+			false,
+		},
+		{
+			"rate limit found",
+			func() {
+				addr := tests.GenerateAddress()
+				pair := types.NewTokenPair(addr, "coin", true, types.OWNER_MODULE)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+				suite.app.Erc20Keeper.SetERC20Map(suite.ctx, addr, pair.GetID())
+				suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+
+				rl := types.NewRateLimit(addr, sdk.NewInt(10), sdk.NewInt(100), sdk.NewInt(100), 10, "")
+				suite.app.Erc20Keeper.SetRateLimit(suite.ctx, rl)
+				err := suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, pair, sdk.NewInt(10), types.ConversionInflow)
+				suite.Require().NoError(err)
+
+				req = &types.QueryRateLimitRequest{
+					Token: pair.Erc20Address,
+				}
+				volume := types.ConversionVolume{Inflow: sdk.NewInt(10), Outflow: sdk.ZeroInt()}
+				expRes = &types.QueryRateLimitResponse{RateLimit: types.NewRateLimitCapacity(rl, volume)}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.RateLimit(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(expRes.RateLimit.RateLimit.Equal(res.RateLimit.RateLimit))
+				suite.Require().Equal(expRes.RateLimit.Inflow.String(), res.RateLimit.Inflow.String())
+				suite.Require().Equal(expRes.RateLimit.RemainingInflow.String(), res.RateLimit.RemainingInflow.String())
+				suite.Require().Equal(expRes.RateLimit.RemainingOutflow.String(), res.RateLimit.RemainingOutflow.String())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConversionFee() {
+	var (
+		req    *types.QueryConversionFeeRequest
+		expRes *types.QueryConversionFeeResponse
+	)
+
+	setPair := func() types.TokenPair {
+		addr := tests.GenerateAddress()
+		pair := types.NewTokenPair(addr, "coin", true, types.OWNER_MODULE)
+		suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+		suite.app.Erc20Keeper.SetERC20Map(suite.ctx, addr, pair.GetID())
+		suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+		return pair
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid token address",
+			func() {
+				req = &types.QueryConversionFeeRequest{}
+				expRes = &types.QueryConversionFeeResponse{}
+			},
+			false,
+		},
+		{
+			"token pair not found",
+			func() {
+				req = &types.QueryConversionFeeRequest{
+					Token: tests.GenerateAddress().Hex(),
+				}
+				expRes = &types.QueryConversionFeeResponse{}
+			},
+			false,
+		},
+		{
+			"invalid amount",
+			func() {
+				pair := setPair()
+				req = &types.QueryConversionFeeRequest{
+					Token:  pair.Denom,
+					Amount: "-1",
+				}
+				expRes = &types.QueryConversionFeeResponse{}
+			},
+			false,
+		},
+		{
+			"global conversion fee",
+			func() {
+				pair := setPair()
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.ConversionFeeRate = sdk.NewDecWithPrec(1, 2)
+				params.ConversionFeeDestination = types.FEE_DESTINATION_BURN
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+
+				req = &types.QueryConversionFeeRequest{
+					Token:  pair.Denom,
+					Amount: "1000",
+				}
+				expRes = &types.QueryConversionFeeResponse{
+					ConversionFee: types.NewConversionFee(pair.GetERC20Contract(), sdk.NewDecWithPrec(1, 2), sdk.ZeroInt()),
+					PairFee:       false,
+					Destination:   types.FEE_DESTINATION_BURN,
+					Fee:           sdk.NewCoin(pair.Denom, sdk.NewInt(10)),
+				}
+			},
+			true,
+		},
+		{
+			"pair conversion fee without amount",
+			func() {
+				pair := setPair()
+				conversionFee := types.NewConversionFee(pair.GetERC20Contract(), sdk.ZeroDec(), sdk.NewInt(5))
+				suite.app.Erc20Keeper.SetConversionFee(suite.ctx, conversionFee)
+
+				req = &types.QueryConversionFeeRequest{
+					Token: pair.Erc20Address,
+				}
+				expRes = &types.QueryConversionFeeResponse{
+					ConversionFee: conversionFee,
+					PairFee:       true,
+					Destination:   types.FEE_DESTINATION_COMMUNITY_POOL,
+					Fee:           sdk.NewCoin(pair.Denom, sdk.ZeroInt()),
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.ConversionFee(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(expRes.ConversionFee.Equal(res.ConversionFee))
+				suite.Require().Equal(expRes.PairFee, res.PairFee)
+				suite.Require().Equal(expRes.Destination, res.Destination)
+				suite.Require().True(expRes.Fee.IsEqual(res.Fee))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTokenPairStats() {
+	var (
+		req    *types.QueryTokenPairStatsRequest
+		expRes *types.QueryTokenPairStatsResponse
+	)
+
+	setPair := func() types.TokenPair {
+		addr := tests.GenerateAddress()
+		pair := types.NewTokenPair(addr, "coin", true, types.OWNER_MODULE)
+		suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+		suite.app.Erc20Keeper.SetERC20Map(suite.ctx, addr, pair.GetID())
+		suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+		return pair
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid token address",
+			func() {
+				req = &types.QueryTokenPairStatsRequest{}
+				expRes = &types.QueryTokenPairStatsResponse{}
+			},
+			false,
+		},
+		{
+			"token pair not found",
+			func() {
+				req = &types.QueryTokenPairStatsRequest{
+					Token: tests.GenerateAddress().Hex(),
+				}
+				expRes = &types.QueryTokenPairStatsResponse{}
+			},
+			false,
+		},
+		{
+			"token pair without conversions",
+			func() {
+				pair := setPair()
+				req = &types.QueryTokenPairStatsRequest{
+					Token: pair.Denom,
+				}
+				expRes = &types.QueryTokenPairStatsResponse{
+					Stats: types.NewTokenPairStats(pair.GetERC20Contract()),
+				}
+			},
+			true,
+		},
+		{
+			"token pair with conversions",
+			func() {
+				pair := setPair()
+				stats := types.NewTokenPairStats(pair.GetERC20Contract()).
+					Add(sdk.NewInt(100), types.ConversionInflow, 1, false).
+					Add(sdk.NewInt(20), types.ConversionOutflow, 2, true)
+				suite.app.Erc20Keeper.SetTokenPairStats(suite.ctx, stats)
+
+				req = &types.QueryTokenPairStatsRequest{
+					Token: pair.Erc20Address,
+				}
+				expRes = &types.QueryTokenPairStatsResponse{
+					Stats: stats,
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.TokenPairStats(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(expRes.Stats.Equal(res.Stats))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMintCaps() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.MintCaps(ctx, &types.QueryMintCapsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.MintCaps)
+
+	addr := tests.GenerateAddress()
+	pair := types.NewTokenPair(addr, "coin", true, types.OWNER_EXTERNAL)
+	suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+	suite.app.Erc20Keeper.SetERC20Map(suite.ctx, addr, pair.GetID())
+	suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+
+	mintCap := types.NewMintCap(addr, sdk.NewInt(100))
+	suite.app.Erc20Keeper.SetMintCap(suite.ctx, mintCap)
+
+	res, err = suite.queryClient.MintCaps(ctx, &types.QueryMintCapsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.MintCaps, 1)
+	suite.Require().Equal(mintCap, res.MintCaps[0].MintCap)
+	suite.Require().True(res.MintCaps[0].Supply.IsZero())
+	suite.Require().Equal(mintCap.Cap.String(), res.MintCaps[0].Remaining.String())
+}
+
+func (suite *KeeperTestSuite) TestMintCap() {
+	var (
+		req    *types.QueryMintCapRequest
+		expRes *types.QueryMintCapResponse
+	)
+
+	setPair := func() types.TokenPair {
+		addr := tests.GenerateAddress()
+		pair := types.NewTokenPair(addr, "coin", true, types.OWNER_EXTERNAL)
+		suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+		suite.app.Erc20Keeper.SetERC20Map(suite.ctx, addr, pair.GetID())
+		suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+		return pair
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid token address",
+			func() {
+				req = &types.QueryMintCapRequest{}
+				expRes = &types.QueryMintCapResponse{}
+			},
+			false,
+		},
+		{
+			"token pair not found",
+			func() {
+				req = &types.QueryMintCapRequest{
+					Token: tests.GenerateAddress().Hex(),
+				}
+				expRes = &types.QueryMintCapResponse{}
+			},
+			false,
+		},
+		{
+			"token pair without mint cap",
+			func() {
+				pair := setPair()
+				req = &types.QueryMintCapRequest{
+					Token: pair.Denom,
+				}
+				expRes = &types.QueryMintCapResponse{}
+			},
+			false,
+		},
+		{
+			"token pair with mint cap",
+			func() {
+				pair := setPair()
+				mintCap := types.NewMintCap(pair.GetERC20Contract(), sdk.NewInt(100))
+				suite.app.Erc20Keeper.SetMintCap(suite.ctx, mintCap)
+
+				coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 40))
+				err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+
+				req = &types.QueryMintCapRequest{
+					Token: pair.Erc20Address,
+				}
+				expRes = &types.QueryMintCapResponse{
+					MintCap: types.NewMintCapUtilization(mintCap, sdk.NewInt(40)),
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.MintCap(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.MintCap.MintCap, res.MintCap.MintCap)
+				suite.Require().Equal(expRes.MintCap.Supply.String(), res.MintCap.Supply.String())
+				suite.Require().Equal(expRes.MintCap.Remaining.String(), res.MintCap.Remaining.String())
+				suite.Require().True(expRes.MintCap.Utilization.Equal(res.MintCap.Utilization))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSafetyPolicy() {
+	var (
+		req    *types.QuerySafetyPolicyRequest
+		expRes *types.QuerySafetyPolicyResponse
+	)
+
+	setPair := func() types.TokenPair {
+		addr := tests.GenerateAddress()
+		pair := types.NewTokenPair(addr, "coin", true, types.OWNER_EXTERNAL)
+		suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+		suite.app.Erc20Keeper.SetERC20Map(suite.ctx, addr, pair.GetID())
+		suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+		return pair
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid token address",
+			func() {
+				req = &types.QuerySafetyPolicyRequest{}
+				expRes = &types.QuerySafetyPolicyResponse{}
+			},
+			false,
+		},
+		{
+			"token pair not found",
+			func() {
+				req = &types.QuerySafetyPolicyRequest{
+					Token: tests.GenerateAddress().Hex(),
+				}
+				expRes = &types.QuerySafetyPolicyResponse{}
+			},
+			false,
+		},
+		{
+			"token pair with default policy",
+			func() {
+				pair := setPair()
+				req = &types.QuerySafetyPolicyRequest{
+					Token: pair.Denom,
+				}
+				expRes = &types.QuerySafetyPolicyResponse{
+					SafetyPolicy: types.DefaultSafetyPolicy(pair.GetERC20Contract()),
+				}
+			},
+			true,
+		},
+		{
+			"token pair with safety policy",
+			func() {
+				pair := setPair()
+				policy := types.NewSafetyPolicy(pair.GetERC20Contract(), []string{"Transfer(address,address,uint256)"}, nil, 1)
+				suite.app.Erc20Keeper.SetSafetyPolicy(suite.ctx, policy)
+
+				req = &types.QuerySafetyPolicyRequest{
+					Token: pair.Erc20Address,
+				}
+				expRes = &types.QuerySafetyPolicyResponse{
+					SafetyPolicy: policy,
+					PairPolicy:   true,
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.SafetyPolicy(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWrappedEVMDenom() {
+	var expRes *types.QueryWrappedEVMDenomResponse
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"wrapped evm denom not registered",
+			func() {
+				expRes = &types.QueryWrappedEVMDenomResponse{}
+			},
+			false,
+		},
+		{
+			"wrapped evm denom registered",
+			func() {
+				pair, err := suite.app.Erc20Keeper.RegisterWrappedEVMDenom(suite.ctx)
+				suite.Require().NoError(err)
+				expRes = &types.QueryWrappedEVMDenomResponse{TokenPair: *pair}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.WrappedEVMDenom(ctx, &types.QueryWrappedEVMDenomRequest{})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTokenPairHealth() {
+	var req *types.QueryTokenPairHealthRequest
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expHealthy []bool
+	}{
+		{
+			"no pairs registered",
+			func() {
+				req = &types.QueryTokenPairHealthRequest{}
+			},
+			nil,
+		},
+		{
+			"pair without contract code",
+			func() {
+				req = &types.QueryTokenPairHealthRequest{
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+				}
+				pair := types.NewTokenPair(tests.GenerateAddress(), "coin", true, types.OWNER_MODULE)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+			},
+			[]bool{false},
+		},
+		{
+			"healthy registered coin",
+			func() {
+				req = &types.QueryTokenPairHealthRequest{}
+				suite.setupRegisterCoin()
+			},
+			[]bool{true},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.TokenPairHealth(ctx, req)
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Health, len(tc.expHealthy))
+			for i, healthy := range tc.expHealthy {
+				suite.Require().Equal(healthy, res.Health[i].Healthy, res.Health[i].Issues)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTokenPairTombstones() {
+	var (
+		req    *types.QueryTokenPairTombstonesRequest
+		expRes *types.QueryTokenPairTombstonesResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"no tombstones",
+			func() {
+				req = &types.QueryTokenPairTombstonesRequest{}
+				expRes = &types.QueryTokenPairTombstonesResponse{Pagination: &query.PageResponse{}}
+			},
+		},
+		{
+			"1 tombstone w/pagination",
+			func() {
+				req = &types.QueryTokenPairTombstonesRequest{
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+				}
+				pair := types.NewTokenPair(tests.GenerateAddress(), "coin", true, types.OWNER_MODULE)
+				tombstone := types.NewTokenPairTombstone(pair, 1, "ERC20 contract has no code", sdk.NewInt(100), sdk.NewInt(100))
+				suite.app.Erc20Keeper.SetTokenPairTombstone(suite.ctx, tombstone)
+
+				expRes = &types.QueryTokenPairTombstonesResponse{
+					Pagination: &query.PageResponse{Total: 1},
+					Tombstones: []types.TokenPairTombstone{tombstone},
+				}
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.TokenPairTombstones(ctx, req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expRes.Pagination, res.Pagination)
+			suite.Require().ElementsMatch(expRes.Tombstones, res.Tombstones)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPairedBalances() {
+	var (
+		req    *types.QueryPairedBalancesRequest
+		expRes *types.QueryPairedBalancesResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid address",
+			func() {
+				suite.SetupTest()
+				req = &types.QueryPairedBalancesRequest{Address: "invalid"}
+			},
+			false,
+		},
+		{
+			"no pairs registered",
+			func() {
+				suite.SetupTest()
+				req = &types.QueryPairedBalancesRequest{Address: suite.address.Hex()}
+				expRes = &types.QueryPairedBalancesResponse{Pagination: &query.PageResponse{}}
+			},
+			true,
+		},
+		{
+			"hex address",
+			func() {
+				coinPair, erc20Pair := suite.setupBatchConversion()
+				req = &types.QueryPairedBalancesRequest{
+					Address:    suite.address.Hex(),
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+				}
+				expRes = &types.QueryPairedBalancesResponse{
+					Pagination: &query.PageResponse{Total: 2},
+					Balances: []types.PairedBalance{
+						{Erc20Address: coinPair.Erc20Address, Coin: sdk.NewInt64Coin(coinPair.Denom, 100), Erc20Balance: sdk.ZeroInt()},
+						{Erc20Address: erc20Pair.Erc20Address, Coin: sdk.NewInt64Coin(erc20Pair.Denom, 100), Erc20Balance: sdk.NewInt(100)},
+					},
+				}
+			},
+			true,
+		},
+		{
+			"bech32 address",
+			func() {
+				coinPair, erc20Pair := suite.setupBatchConversion()
+				req = &types.QueryPairedBalancesRequest{
+					Address: sdk.AccAddress(suite.address.Bytes()).String(),
+				}
+				expRes = &types.QueryPairedBalancesResponse{
+					Pagination: &query.PageResponse{Total: 2},
+					Balances: []types.PairedBalance{
+						{Erc20Address: coinPair.Erc20Address, Coin: sdk.NewInt64Coin(coinPair.Denom, 100), Erc20Balance: sdk.ZeroInt()},
+						{Erc20Address: erc20Pair.Erc20Address, Coin: sdk.NewInt64Coin(erc20Pair.Denom, 100), Erc20Balance: sdk.NewInt(100)},
+					},
+				}
+			},
+			true,
+		},
+		{
+			"account without balances",
+			func() {
+				coinPair, erc20Pair := suite.setupBatchConversion()
+				req = &types.QueryPairedBalancesRequest{Address: tests.GenerateAddress().Hex()}
+				expRes = &types.QueryPairedBalancesResponse{
+					Pagination: &query.PageResponse{Total: 2},
+					Balances: []types.PairedBalance{
+						{Erc20Address: coinPair.Erc20Address, Coin: sdk.NewInt64Coin(coinPair.Denom, 0), Erc20Balance: sdk.ZeroInt()},
+						{Erc20Address: erc20Pair.Erc20Address, Coin: sdk.NewInt64Coin(erc20Pair.Denom, 0), Erc20Balance: sdk.ZeroInt()},
+					},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.app.Erc20Keeper.PairedBalances(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.Pagination.Total, res.Pagination.Total)
+				suite.Require().Len(res.Balances, len(expRes.Balances))
+				for _, expBalance := range expRes.Balances {
+					found := false
+					for _, balance := range res.Balances {
+						if expBalance.Erc20Address != balance.Erc20Address {
+							continue
+						}
+						found = true
+						suite.Require().Equal(expBalance.Coin.String(), balance.Coin.String())
+						suite.Require().Equal(expBalance.Erc20Balance.String(), balance.Erc20Balance.String())
+					}
+					suite.Require().True(found)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestPredictERC20Address() {
+	var (
+		req    *types.QueryPredictERC20AddressRequest
+		expRes *types.QueryPredictERC20AddressResponse
+	)
+
+	metadata := banktypes.Metadata{
+		Description: "description",
+		Base:        cosmosTokenBase,
+		// NOTE: Denom units MUST be increasing
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    cosmosTokenBase,
+				Exponent: 0,
+			},
+			{
+				Denom:    cosmosTokenDisplay,
+				Exponent: defaultExponent,
+			},
+		},
+		Name:    cosmosTokenBase,
+		Symbol:  erc20Symbol,
+		Display: cosmosTokenDisplay,
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid denom",
+			func() {
+				suite.SetupTest()
+				req = &types.QueryPredictERC20AddressRequest{}
+			},
+			false,
+		},
+		{
+			"unregistered coin without metadata",
+			func() {
+				suite.SetupTest()
+				req = &types.QueryPredictERC20AddressRequest{Denom: cosmosTokenBase}
+				expRes = &types.QueryPredictERC20AddressResponse{Erc20Address: keeper.ComputeERC20Address(cosmosTokenBase).Hex()}
+			},
+			true,
+		},
+		{
+			"registered coin",
+			func() {
+				_, pair := suite.setupRegisterCoin()
+				req = &types.QueryPredictERC20AddressRequest{Denom: pair.Denom}
+				expRes = &types.QueryPredictERC20AddressResponse{Erc20Address: pair.Erc20Address}
+			},
+			true,
+		},
+		{
+			"unregistered coin - matches the deployed contract",
+			func() {
+				suite.SetupTest()
+				err := suite.app.BankKeeper.MintCoins(suite.ctx, inflationtypes.ModuleName, sdk.Coins{sdk.NewInt64Coin(metadata.Base, 1)})
+				suite.Require().NoError(err)
+
+				// register the coin on a discarded context
+				cacheCtx, _ := suite.ctx.CacheContext()
+				pair, err := suite.app.Erc20Keeper.RegisterCoin(cacheCtx, metadata, 0, 0)
+				suite.Require().NoError(err)
+
+				req = &types.QueryPredictERC20AddressRequest{Denom: metadata.Base}
+				expRes = &types.QueryPredictERC20AddressResponse{Erc20Address: pair.Erc20Address}
+			},
+			true,
+		},
+		{
+			"unregistered coin - independent of the scaling and the implementation",
+			func() {
+				suite.SetupTest()
+				err := suite.app.BankKeeper.MintCoins(suite.ctx, inflationtypes.ModuleName, sdk.Coins{sdk.NewInt64Coin(metadata.Base, 1)})
+				suite.Require().NoError(err)
+
+				// register the coin on a discarded context with a scaling
+				// exponent and another implementation
+				cacheCtx, _ := suite.ctx.CacheContext()
+				suite.app.Erc20Keeper.SetERC20Implementation(cacheCtx, contracts.ERC20PermitImplementation)
+				pair, err := suite.app.Erc20Keeper.RegisterCoin(cacheCtx, metadata, 6, 0)
+				suite.Require().NoError(err)
+
+				req = &types.QueryPredictERC20AddressRequest{Denom: metadata.Base}
+				expRes = &types.QueryPredictERC20AddressResponse{Erc20Address: pair.Erc20Address}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			tc.malleate()
+
+			res, err := suite.queryClient.PredictERC20Address(sdk.WrapSDKContext(suite.ctx), req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// OnRecvPacket converts the ICS20 vouchers received by the transfer module
+// into their ERC20 token representation. The conversion is only performed if:
+//  - the transfer module acknowledged the packet successfully
+//  - the global parameters for intrarelaying and IBC conversion are enabled
+//  - the received denomination is an ICS20 voucher (i.e `ibc/{hash}`), so
+//    that native coins returning to the chain are kept as Cosmos coins
+//  - the voucher is registered on a Cosmos-native coin token pair, other than
+//    the wrapped EVM denom
+//  - the token pair is enabled
+//
+// The conversion is charged the conversion fee and counted against the rate
+// limit of the token pair, as the vouchers entering the EVM are not distinct
+// from coins converted through a MsgConvertCoin. If the conversion fails, the
+// state changes from the conversion are reverted and the vouchers are kept as
+// Cosmos coins on the receiver account.
+func (k Keeper) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack exported.Acknowledgement,
+) exported.Acknowledgement {
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	params := k.GetParams(ctx)
+	if !params.EnableErc20 || !params.EnableIBCConversion {
+		return ack
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// NOTE: should be unreachable as the packet data has already been
+		// decoded by the transfer module
+		return ack
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return ack
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok || !amount.IsPositive() {
+		return ack
+	}
+
+	coin := sdk.Coin{Denom: GetReceivedDenom(packet, data.Denom), Amount: amount}
+	if !strings.HasPrefix(coin.Denom, transfertypes.DenomPrefix+"/") {
+		return ack
+	}
+
+	id := k.GetTokenPairID(ctx, coin.Denom)
+	if len(id) == 0 {
+		// no token pair is registered for the received denomination
+		return ack
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found || !pair.Enabled || !pair.IsNativeCoin() || k.IsWrappedEVMDenomPair(ctx, pair) {
+		return ack
+	}
+
+	// use a cached context to discard the state changes from a failed conversion
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	msg := types.NewMsgConvertCoin(coin, common.BytesToAddress(receiver.Bytes()), receiver)
+	if _, err := k.ConvertCoin(sdk.WrapSDKContext(cacheCtx), msg); err != nil {
+		k.Logger(ctx).Debug(
+			"failed to convert received IBC vouchers to ERC20 tokens",
+			"coin", coin.String(), "contract", pair.Erc20Address, "receiver", data.Receiver,
+			"error", err.Error(),
+		)
+		return ack
+	}
+
+	writeCache()
+	// NOTE: the cached context has its own event manager
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return ack
+}
+
+// GetReceivedDenom returns the denomination of the coins credited to the
+// receiver by the transfer module for the given packet and ICS20 packet data
+// denomination.
+func GetReceivedDenom(packet channeltypes.Packet, denom string) string {
+	// NOTE: the logic below mirrors the transfer module OnRecvPacket
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// remove prefix added by sender chain
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := denom[len(voucherPrefix):]
+
+		// the denomination is either the native denom or the hash of the path
+		// if the denomination is not native
+		denomTrace := transfertypes.ParseDenomTrace(unprefixedDenom)
+		if denomTrace.Path != "" {
+			return denomTrace.IBCDenom()
+		}
+		return unprefixedDenom
+	}
+
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+// OnAcknowledgementPacket converts the coins refunded by the transfer module
+// back to ERC20 tokens if the packet was sent through a MsgTransferERC20 and
+// the counterparty chain acknowledged it with an error.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack channeltypes.Acknowledgement,
+) {
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		k.refundERC20Transfer(ctx, packet)
+	default:
+		// the tokens were received by the counterparty chain
+		k.DeleteERC20Transfer(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	}
+}
+
+// OnTimeoutPacket converts the coins refunded by the transfer module back to
+// ERC20 tokens if the packet was sent through a MsgTransferERC20.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	k.refundERC20Transfer(ctx, packet)
+}
+
+// refundERC20Transfer converts the coins refunded to the sender of a
+// MsgTransferERC20 packet back to ERC20 tokens. The conversion fee and the rate
+// limit were already applied when the tokens were converted for the transfer,
+// so the refund is exempt from both. If the conversion fails, the refund is
+// kept as Cosmos coins on the sender account.
+func (k Keeper) refundERC20Transfer(ctx sdk.Context, packet channeltypes.Packet) {
+	contract, found := k.GetERC20Transfer(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		// packet wasn't sent through a MsgTransferERC20
+		return
+	}
+
+	k.DeleteERC20Transfer(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok || !amount.IsPositive() {
+		return
+	}
+
+	// NOTE: the transfer module refunds the denomination sent, which is the
+	// hash of the denomination trace for non native coins
+	coin := sdk.Coin{Denom: transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), Amount: amount}
+
+	// use a cached context to discard the state changes from a failed conversion
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	msg := types.NewMsgConvertCoin(coin, common.BytesToAddress(sender.Bytes()), sender)
+	if _, err := k.convertCoin(cacheCtx, msg, true); err != nil {
+		k.Logger(ctx).Debug(
+			"failed to convert refunded coins to ERC20 tokens",
+			"coin", coin.String(), "contract", contract.String(), "sender", data.Sender,
+			"error", err.Error(),
+		)
+		return
+	}
+
+	writeCache()
+	// NOTE: the cached context has its own event manager
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundERC20,
+			sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, coin.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, contract.String()),
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+		),
+	)
+}
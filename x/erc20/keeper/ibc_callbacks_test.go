@@ -0,0 +1,472 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
+	"github.com/tharsis/evmos/x/erc20/keeper"
+	"github.com/tharsis/evmos/x/erc20/types"
+	inflationtypes "github.com/tharsis/evmos/x/inflation/types"
+)
+
+const (
+	ibcBaseDenom = "uatom"
+	ibcChannel   = "channel-0"
+)
+
+// mockTransferKeeper escrows the sent tokens on the transfer module account
+// without sending an IBC packet.
+type mockTransferKeeper struct {
+	bankKeeper types.BankKeeper
+	err        error
+}
+
+func (m mockTransferKeeper) SendTransfer(
+	ctx sdk.Context,
+	_, _ string,
+	token sdk.Coin,
+	sender sdk.AccAddress,
+	_ string,
+	_ clienttypes.Height,
+	_ uint64,
+) error {
+	if m.err != nil {
+		return m.err
+	}
+	return m.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, transfertypes.ModuleName, sdk.Coins{token})
+}
+
+var ibcDenom = transfertypes.ParseDenomTrace(
+	transfertypes.GetPrefixedDenom(transfertypes.PortID, ibcChannel, ibcBaseDenom),
+).IBCDenom()
+
+func (suite *KeeperTestSuite) setupRegisterIBCVoucher() (banktypes.Metadata, *types.TokenPair) {
+	suite.SetupTest()
+	metadata := banktypes.Metadata{
+		Description: "IBC voucher of uatom (channel 0)",
+		Base:        ibcDenom,
+		// NOTE: Denom units MUST be increasing
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    ibcDenom,
+				Exponent: 0,
+			},
+		},
+		Name:    "ATOM channel-0",
+		Symbol:  "ibcATOM-0",
+		Display: ibcDenom,
+	}
+
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, inflationtypes.ModuleName, sdk.Coins{sdk.NewInt64Coin(metadata.Base, 1)})
+	suite.Require().NoError(err)
+
+	pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, metadata, 0, 0)
+	suite.Require().NoError(err)
+	suite.Commit()
+	return metadata, pair
+}
+
+// erc20KeeperWithTransfer returns an erc20 keeper that shares the app store
+// and sends the ICS20 transfers through the given transfer keeper.
+func (suite *KeeperTestSuite) erc20KeeperWithTransfer(tk types.TransferKeeper) keeper.Keeper {
+	return keeper.NewKeeper(
+		suite.app.GetKey(types.StoreKey), suite.app.AppCodec(), suite.app.GetSubspace(types.ModuleName),
+		suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.EvmKeeper,
+		tk, suite.app.IBCKeeper.ChannelKeeper, suite.app.EpochsKeeper,
+	)
+}
+
+// setupTransferERC20 registers a Cosmos-native coin token pair, converts the
+// minted coins to ERC20 tokens and sends the given amount of tokens through a
+// MsgTransferERC20 on the transfer/channel-0 channel with sequence 1. It
+// returns the keeper used for the transfer, the token pair and the sent packet.
+func (suite *KeeperTestSuite) setupTransferERC20(mint, amount int64) (keeper.Keeper, *types.TokenPair, channeltypes.Packet) {
+	_, pair := suite.setupRegisterCoin()
+	suite.Require().NotNil(pair)
+
+	k := suite.erc20KeeperWithTransfer(mockTransferKeeper{bankKeeper: suite.app.BankKeeper})
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, mint))
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins)
+	suite.Require().NoError(err)
+
+	_, err = k.ConvertCoin(sdk.WrapSDKContext(suite.ctx), types.NewMsgConvertCoin(coins[0], suite.address, sender))
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	suite.app.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.ctx, transfertypes.PortID, ibcChannel, 1)
+
+	msg := types.NewMsgTransferERC20(
+		sdk.NewInt(amount), pair.GetERC20Contract(), suite.address,
+		transfertypes.PortID, ibcChannel, "cosmos1receiver",
+		clienttypes.NewHeight(0, 100), 0,
+	)
+	_, err = k.TransferERC20(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	data := transfertypes.NewFungibleTokenPacketData(pair.Denom, fmt.Sprintf("%d", amount), sender.String(), "cosmos1receiver")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, ibcChannel, transfertypes.PortID, "channel-1", clienttypes.NewHeight(0, 100), 0)
+	return k, pair, packet
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	var (
+		packet   channeltypes.Packet
+		ack      exported.Acknowledgement
+		receiver sdk.AccAddress
+	)
+
+	amount := int64(100)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expConvert bool
+	}{
+		{
+			"ok - vouchers converted",
+			func() {},
+			true,
+		},
+		{
+			"no-op - error acknowledgement",
+			func() {
+				ack = channeltypes.NewErrorAcknowledgement("transfer failed")
+			},
+			false,
+		},
+		{
+			"no-op - ibc conversion disabled",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.EnableIBCConversion = false
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			false,
+		},
+		{
+			"no-op - intrarelaying disabled",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.EnableErc20 = false
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			false,
+		},
+		{
+			"no-op - token pair disabled",
+			func() {
+				_, err := suite.app.Erc20Keeper.ToggleRelay(suite.ctx, ibcDenom)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"no-op - denomination not registered",
+			func() {
+				data := transfertypes.NewFungibleTokenPacketData(ibcBaseDenom, fmt.Sprintf("%d", amount), "cosmos1sender", receiver.String())
+				packet = channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, "channel-1", transfertypes.PortID, "channel-1", clienttypes.NewHeight(0, 100), 0)
+			},
+			false,
+		},
+		{
+			"no-op - invalid receiver",
+			func() {
+				data := transfertypes.NewFungibleTokenPacketData(ibcBaseDenom, fmt.Sprintf("%d", amount), "cosmos1sender", suite.address.Hex())
+				packet = channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, ibcChannel, transfertypes.PortID, ibcChannel, clienttypes.NewHeight(0, 100), 0)
+			},
+			false,
+		},
+		{
+			"no-op - conversion failed, coins kept on receiver",
+			func() {
+				data := transfertypes.NewFungibleTokenPacketData(ibcBaseDenom, fmt.Sprintf("%d", amount+1), "cosmos1sender", receiver.String())
+				packet = channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, ibcChannel, transfertypes.PortID, ibcChannel, clienttypes.NewHeight(0, 100), 0)
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			_, pair := suite.setupRegisterIBCVoucher()
+			suite.Require().NotNil(pair)
+			receiver = sdk.AccAddress(suite.address.Bytes())
+
+			// credit the vouchers as the transfer module does
+			coins := sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, amount))
+			err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+			suite.Require().NoError(err)
+			err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, receiver, coins)
+			suite.Require().NoError(err)
+
+			data := transfertypes.NewFungibleTokenPacketData(ibcBaseDenom, fmt.Sprintf("%d", amount), "cosmos1sender", receiver.String())
+			packet = channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, ibcChannel, transfertypes.PortID, ibcChannel, clienttypes.NewHeight(0, 100), 0)
+			ack = channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+			tc.malleate()
+
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			res := suite.app.Erc20Keeper.OnRecvPacket(suite.ctx, packet, ack)
+			suite.Require().Equal(ack, res)
+			suite.Require().Equal(tc.expConvert, hasEvent(suite.ctx.EventManager().Events(), types.EventTypeConvertCoin))
+			suite.Commit()
+
+			balanceToken := suite.BalanceOf(pair.GetERC20Contract(), suite.address)
+			balanceCoin := suite.app.BankKeeper.GetBalance(suite.ctx, receiver, ibcDenom)
+
+			if tc.expConvert {
+				suite.Require().Equal(amount, balanceToken.(*big.Int).Int64())
+				suite.Require().True(balanceCoin.IsZero())
+			} else {
+				suite.Require().Equal(int64(0), balanceToken.(*big.Int).Int64())
+				suite.Require().Equal(sdk.NewInt(amount), balanceCoin.Amount)
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketNativeDenom() {
+	amount := int64(100)
+
+	testCases := []struct {
+		name     string
+		register func() *types.TokenPair
+	}{
+		{
+			"native coin returning to the chain",
+			func() *types.TokenPair {
+				_, pair := suite.setupRegisterCoin()
+				return pair
+			},
+		},
+		{
+			"wrapped evm denom returning to the chain",
+			func() *types.TokenPair {
+				suite.SetupTest()
+				pair, err := suite.app.Erc20Keeper.RegisterWrappedEVMDenom(suite.ctx)
+				suite.Require().NoError(err)
+				return pair
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			pair := tc.register()
+			suite.Require().NotNil(pair)
+			receiver := sdk.AccAddress(suite.address.Bytes())
+
+			// credit the unescrowed coins as the transfer module does
+			coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, amount))
+			err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+			suite.Require().NoError(err)
+			err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, receiver, coins)
+			suite.Require().NoError(err)
+
+			// the denomination is prefixed by the port and channel of the chain
+			// the coins are sent back from
+			denom := transfertypes.GetPrefixedDenom(transfertypes.PortID, ibcChannel, pair.Denom)
+			data := transfertypes.NewFungibleTokenPacketData(denom, fmt.Sprintf("%d", amount), "cosmos1sender", receiver.String())
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, transfertypes.PortID, ibcChannel, transfertypes.PortID, ibcChannel, clienttypes.NewHeight(0, 100), 0)
+			suite.Require().Equal(pair.Denom, keeper.GetReceivedDenom(packet, data.Denom))
+			ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			res := suite.app.Erc20Keeper.OnRecvPacket(suite.ctx, packet, ack)
+			suite.Require().Equal(ack, res)
+			suite.Require().False(hasEvent(suite.ctx.EventManager().Events(), types.EventTypeConvertCoin))
+			suite.Commit()
+
+			suite.Require().Equal(int64(0), suite.BalanceOf(pair.GetERC20Contract(), suite.address).(*big.Int).Int64())
+			suite.Require().Equal(sdk.NewInt(amount), suite.app.BankKeeper.GetBalance(suite.ctx, receiver, pair.Denom).Amount)
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestGetReceivedDenom() {
+	testCases := []struct {
+		name     string
+		packet   channeltypes.Packet
+		denom    string
+		expDenom string
+	}{
+		{
+			"sender chain is source",
+			channeltypes.NewPacket(nil, 1, transfertypes.PortID, "channel-1", transfertypes.PortID, ibcChannel, clienttypes.NewHeight(0, 100), 0),
+			ibcBaseDenom,
+			ibcDenom,
+		},
+		{
+			"receiver chain is source - native denom",
+			channeltypes.NewPacket(nil, 1, transfertypes.PortID, "channel-1", transfertypes.PortID, ibcChannel, clienttypes.NewHeight(0, 100), 0),
+			"transfer/channel-1/aevmos",
+			"aevmos",
+		},
+		{
+			"receiver chain is source - ibc denom",
+			channeltypes.NewPacket(nil, 1, transfertypes.PortID, "channel-1", transfertypes.PortID, "channel-2", clienttypes.NewHeight(0, 100), 0),
+			"transfer/channel-1/transfer/channel-0/uatom",
+			ibcDenom,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expDenom, keeper.GetReceivedDenom(tc.packet, tc.denom), tc.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	amount := int64(10)
+	mint := int64(100)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		ack       channeltypes.Acknowledgement
+		refunded  bool
+		expRefund bool
+	}{
+		{
+			"ok - success ack, tokens received by counterparty",
+			func() {},
+			channeltypes.NewResultAcknowledgement([]byte{byte(1)}),
+			false,
+			false,
+		},
+		{
+			"ok - error ack, refund converted to ERC20",
+			func() {},
+			channeltypes.NewErrorAcknowledgement("transfer failed"),
+			true,
+			true,
+		},
+		{
+			"ok - error ack, refund exempt from the conversion fee and the rate limit",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.ConversionFeeRate = sdk.NewDecWithPrec(1, 1)
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+
+				denom := suite.app.Erc20Keeper.GetAllTokenPairs(suite.ctx)[0].Denom
+				_, err := suite.app.Erc20Keeper.UpdateRateLimit(suite.ctx, denom, sdk.ZeroInt(), sdk.OneInt(), sdk.ZeroInt(), 10, "")
+				suite.Require().NoError(err)
+			},
+			channeltypes.NewErrorAcknowledgement("transfer failed"),
+			true,
+			true,
+		},
+		{
+			"no-op - error ack, packet not sent through MsgTransferERC20",
+			func() {
+				suite.app.Erc20Keeper.DeleteERC20Transfer(suite.ctx, transfertypes.PortID, ibcChannel, 1)
+			},
+			channeltypes.NewErrorAcknowledgement("transfer failed"),
+			true,
+			false,
+		},
+		{
+			"no-op - error ack, conversion failed and refund kept as coins",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.EnableErc20 = false
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			channeltypes.NewErrorAcknowledgement("transfer failed"),
+			true,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			k, pair, packet := suite.setupTransferERC20(mint, amount)
+
+			_, found := k.GetERC20Transfer(suite.ctx, transfertypes.PortID, ibcChannel, 1)
+			suite.Require().True(found)
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			coinName := pair.Denom
+			if tc.refunded {
+				// refund the escrowed coins as the transfer module does
+				coins := sdk.NewCoins(sdk.NewInt64Coin(coinName, amount))
+				err := suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, transfertypes.ModuleName, sender, coins)
+				suite.Require().NoError(err)
+			}
+
+			tc.malleate()
+
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			k.OnAcknowledgementPacket(suite.ctx, packet, tc.ack)
+			suite.Require().Equal(tc.expRefund, hasEvent(suite.ctx.EventManager().Events(), types.EventTypeConvertCoin))
+			suite.Commit()
+
+			_, found = k.GetERC20Transfer(suite.ctx, transfertypes.PortID, ibcChannel, 1)
+			suite.Require().False(found)
+
+			balanceToken := suite.BalanceOf(pair.GetERC20Contract(), suite.address)
+			balanceCoin := suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinName)
+
+			switch {
+			case tc.expRefund:
+				suite.Require().Equal(mint, balanceToken.(*big.Int).Int64())
+				suite.Require().True(balanceCoin.IsZero())
+			case tc.refunded:
+				suite.Require().Equal(mint-amount, balanceToken.(*big.Int).Int64())
+				suite.Require().Equal(sdk.NewInt(amount), balanceCoin.Amount)
+			default:
+				suite.Require().Equal(mint-amount, balanceToken.(*big.Int).Int64())
+				suite.Require().True(balanceCoin.IsZero())
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
+	amount := int64(10)
+	mint := int64(100)
+
+	suite.mintFeeCollector = true
+	k, pair, packet := suite.setupTransferERC20(mint, amount)
+
+	// refund the escrowed coins as the transfer module does
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coinName := pair.Denom
+	coins := sdk.NewCoins(sdk.NewInt64Coin(coinName, amount))
+	err := suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, transfertypes.ModuleName, sender, coins)
+	suite.Require().NoError(err)
+
+	k.OnTimeoutPacket(suite.ctx, packet)
+	suite.Commit()
+
+	_, found := k.GetERC20Transfer(suite.ctx, transfertypes.PortID, ibcChannel, 1)
+	suite.Require().False(found)
+
+	balanceToken := suite.BalanceOf(pair.GetERC20Contract(), suite.address)
+	balanceCoin := suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinName)
+	suite.Require().Equal(mint, balanceToken.(*big.Int).Int64())
+	suite.Require().True(balanceCoin.IsZero())
+	suite.mintFeeCollector = false
+}
+
+func hasEvent(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
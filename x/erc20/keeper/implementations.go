@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
+)
+
+// GetERC20Implementation returns the name of the implementation of the ERC20
+// proxies deployed for the registered Cosmos coins
+func (k Keeper) GetERC20Implementation(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyERC20Implementation)
+	if len(bz) == 0 {
+		return contracts.DefaultERC20Implementation
+	}
+	return string(bz)
+}
+
+// SetERC20Implementation sets the name of the implementation of the ERC20
+// proxies deployed for the registered Cosmos coins
+func (k Keeper) SetERC20Implementation(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyERC20Implementation, []byte(name))
+}
+
+// ERC20ImplementationAddress returns the address of the given implementation,
+// which is deployed with CREATE2 from the erc20 module account.
+func ERC20ImplementationAddress(implementation contracts.ERC20Implementation) common.Address {
+	return crypto.CreateAddress2(types.ModuleAddress, common.Hash{}, crypto.Keccak256(erc20ImplementationCreationCode(implementation)))
+}
+
+// erc20ImplementationCreationCode returns the creation code of the given
+// implementation, including the address of its base implementation if any.
+func erc20ImplementationCreationCode(implementation contracts.ERC20Implementation) []byte {
+	if implementation.Base == "" {
+		return implementation.CreationCode
+	}
+
+	// NOTE: the base implementations are checked on the registry initialization
+	base, _ := contracts.GetERC20Implementation(implementation.Base)
+	code := make([]byte, 0, len(implementation.CreationCode)+32)
+	code = append(code, implementation.CreationCode...)
+	return append(code, common.LeftPadBytes(ERC20ImplementationAddress(base).Bytes(), 32)...)
+}
+
+// IsERC20Proxy returns true if the contract is an ERC20 proxy deployed by the
+// erc20 module
+func (k Keeper) IsERC20Proxy(ctx sdk.Context, contract common.Address) bool {
+	acc := k.evmKeeper.GetAccount(ctx, contract)
+	return acc != nil && bytes.Equal(acc.CodeHash, contracts.ERC20ProxyRuntimeCodeHash.Bytes())
+}
+
+// UpgradeERC20Implementation points the given ERC20 proxies to the
+// implementation with the given name. If no proxy is given, all the proxies of
+// the registered Cosmos coins are upgraded and the implementation is used for
+// the coins registered afterwards. It returns the upgraded proxies.
+func (k Keeper) UpgradeERC20Implementation(
+	ctx sdk.Context,
+	name string,
+	proxies []common.Address,
+) ([]common.Address, error) {
+	implementation, found := contracts.GetERC20Implementation(name)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidImplementation, "implementation '%s' not found", name)
+	}
+
+	if len(proxies) == 0 {
+		for _, pair := range k.GetAllTokenPairs(ctx) {
+			contract := pair.GetERC20Contract()
+			if pair.IsNativeCoin() && k.IsERC20Proxy(ctx, contract) {
+				proxies = append(proxies, contract)
+			}
+		}
+		k.SetERC20Implementation(ctx, name)
+	} else {
+		for _, contract := range proxies {
+			id := k.GetERC20Map(ctx, contract)
+			pair, found := k.GetTokenPair(ctx, id)
+			if !found {
+				return nil, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token '%s' not registered", contract)
+			}
+
+			if !pair.IsNativeCoin() || !k.IsERC20Proxy(ctx, contract) {
+				return nil, sdkerrors.Wrapf(types.ErrInvalidImplementation, "contract %s is not an ERC20 proxy", contract)
+			}
+		}
+	}
+
+	implementationAddr, err := k.deployERC20Implementation(ctx, implementation)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := contracts.ERC20ProxyContract.ABI
+	for _, contract := range proxies {
+		if _, err := k.CallEVM(ctx, proxy, types.ModuleAddress, contract, "upgradeTo", implementationAddr); err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to upgrade proxy %s", contract)
+		}
+	}
+
+	return proxies, nil
+}
+
+// deployERC20Implementation deploys the given implementation along with its
+// base implementations if they are not deployed yet and returns its address
+func (k Keeper) deployERC20Implementation(
+	ctx sdk.Context,
+	implementation contracts.ERC20Implementation,
+) (common.Address, error) {
+	addr := ERC20ImplementationAddress(implementation)
+	if acc := k.evmKeeper.GetAccount(ctx, addr); acc != nil && acc.IsContract() {
+		return addr, nil
+	}
+
+	if implementation.Base != "" {
+		base, _ := contracts.GetERC20Implementation(implementation.Base)
+		if _, err := k.deployERC20Implementation(ctx, base); err != nil {
+			return common.Address{}, err
+		}
+	}
+
+	if _, err := k.CallEVMCreate2(ctx, types.ModuleAddress, common.Hash{}, erc20ImplementationCreationCode(implementation)); err != nil {
+		return common.Address{}, sdkerrors.Wrapf(err, "failed to deploy implementation %s", implementation.Name)
+	}
+	return addr, nil
+}
@@ -0,0 +1,397 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/tharsis/evmos/x/erc20/keeper"
+	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
+)
+
+// proxyQuery calls a view method of the ERC20 proxy from the module account and
+// returns the address it returns
+func (suite *KeeperTestSuite) proxyQuery(contract common.Address, method string) common.Address {
+	proxy := contracts.ERC20ProxyContract.ABI
+
+	res, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, proxy, types.ModuleAddress, contract, method)
+	suite.Require().NoError(err)
+
+	unpacked, err := proxy.Unpack(method, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Len(unpacked, 1)
+
+	addr, ok := unpacked[0].(common.Address)
+	suite.Require().True(ok)
+	return addr
+}
+
+// storageVariable returns the storage layout entry of the variable with the
+// given label
+func (suite *KeeperTestSuite) storageVariable(layout []contracts.StorageVariable, label string) contracts.StorageVariable {
+	for _, variable := range layout {
+		if variable.Label == label {
+			return variable
+		}
+	}
+	suite.FailNow(fmt.Sprintf("variable %s not found in storage layout", label))
+	return contracts.StorageVariable{}
+}
+
+func (suite *KeeperTestSuite) TestERC20ImplementationsStorageLayout() {
+	suite.Require().NotEmpty(contracts.ERC20Implementations)
+	latest := contracts.ERC20Implementations[len(contracts.ERC20Implementations)-1]
+	suite.Require().Equal(contracts.DefaultERC20Implementation, latest.Name)
+
+	for i := 1; i < len(contracts.ERC20Implementations); i++ {
+		previous := contracts.ERC20Implementations[i-1]
+		implementation := contracts.ERC20Implementations[i]
+		suite.Require().True(
+			contracts.IsStorageLayoutCompatible(previous.StorageLayout, implementation.StorageLayout),
+			"implementation %s is not storage compatible with %s", implementation.Name, previous.Name,
+		)
+	}
+
+	layout := contracts.ERC20Implementations[0].StorageLayout
+	testCases := []struct {
+		name     string
+		layout   []contracts.StorageVariable
+		expCompt bool
+	}{
+		{"same layout", layout, true},
+		{"appended variable", append(append([]contracts.StorageVariable{}, layout...), contracts.StorageVariable{Label: "_nonces", Slot: 8, Type: "mapping(address => uint256)"}), true},
+		{"removed variable", layout[:len(layout)-1], false},
+		{"moved variable", append([]contracts.StorageVariable{layout[1], layout[0]}, layout[2:]...), false},
+		{"retyped variable", append(append([]contracts.StorageVariable{}, layout[:4]...), append([]contracts.StorageVariable{{Label: "_totalSupply", Slot: 4, Type: "uint128"}}, layout[5:]...)...), false},
+	}
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expCompt, contracts.IsStorageLayoutCompatible(layout, tc.layout), tc.name)
+	}
+}
+
+// proxyView calls a view method of the ERC20 proxy with the given ABI and
+// returns its first return value
+func (suite *KeeperTestSuite) proxyView(contractABI abi.ABI, contract common.Address, method string, args ...interface{}) interface{} {
+	res, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, contractABI, types.ModuleAddress, contract, method, args...)
+	suite.Require().NoError(err)
+
+	unpacked, err := contractABI.Unpack(method, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(unpacked)
+	return unpacked[0]
+}
+
+// TestERC20ImplementationStorageProbe checks the declared storage layout of
+// the default implementation against its code: a value written at the
+// location of each variable must be returned by the matching getter of a
+// deployed proxy.
+func (suite *KeeperTestSuite) TestERC20ImplementationStorageProbe() {
+	_, pair := suite.setupRegisterCoin()
+	suite.Require().NotNil(pair)
+	contract := pair.GetERC20Contract()
+
+	implementation, found := contracts.GetERC20Implementation(contracts.DefaultERC20Implementation)
+	suite.Require().True(found)
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	permit := contracts.ERC20PermitContract.ABI
+	role := crypto.Keccak256Hash([]byte("PROBE_ROLE"))
+	owner, spender := tests.GenerateAddress(), tests.GenerateAddress()
+
+	word := func(v uint64) common.Hash {
+		return common.BigToHash(new(big.Int).SetUint64(v))
+	}
+	// mappingSlot returns the location of the value of a mapping key
+	mappingSlot := func(key []byte, slot common.Hash) common.Hash {
+		return crypto.Keccak256Hash(common.LeftPadBytes(key, 32), slot.Bytes())
+	}
+	setState := func(slot common.Hash, value []byte) {
+		suite.app.EvmKeeper.SetState(suite.ctx, contract, slot, value)
+	}
+	// setShortString stores a string of less than 32 bytes left aligned with
+	// twice its length in the last byte
+	setShortString := func(slot common.Hash, value string) {
+		bz := common.RightPadBytes([]byte(value), 32)
+		bz[31] = byte(2 * len(value))
+		setState(slot, bz)
+	}
+
+	probes := map[string]func(variable contracts.StorageVariable){
+		"_roles": func(variable contracts.StorageVariable) {
+			// the members of a role are the first field of the RoleData struct
+			members := mappingSlot(role.Bytes(), word(variable.Slot))
+			setState(mappingSlot(owner.Bytes(), members), word(1).Bytes())
+			suite.Require().Equal(true, suite.proxyView(erc20, contract, "hasRole", role, owner))
+		},
+		"_roleMembers": func(variable contracts.StorageVariable) {
+			// the length of the values array of the set is stored on its slot
+			setState(mappingSlot(role.Bytes(), word(variable.Slot)), word(3).Bytes())
+			suite.Require().Equal(big.NewInt(3), suite.proxyView(erc20, contract, "getRoleMemberCount", role))
+		},
+		"_balances": func(variable contracts.StorageVariable) {
+			setState(mappingSlot(owner.Bytes(), word(variable.Slot)), word(11).Bytes())
+			suite.Require().Equal(big.NewInt(11), suite.proxyView(erc20, contract, "balanceOf", owner))
+		},
+		"_allowances": func(variable contracts.StorageVariable) {
+			allowances := mappingSlot(owner.Bytes(), word(variable.Slot))
+			setState(mappingSlot(spender.Bytes(), allowances), word(12).Bytes())
+			suite.Require().Equal(big.NewInt(12), suite.proxyView(erc20, contract, "allowance", owner, spender))
+		},
+		"_totalSupply": func(variable contracts.StorageVariable) {
+			setState(word(variable.Slot), word(13).Bytes())
+			suite.Require().Equal(big.NewInt(13), suite.proxyView(erc20, contract, "totalSupply"))
+		},
+		"_name": func(variable contracts.StorageVariable) {
+			setShortString(word(variable.Slot), "probe name")
+			suite.Require().Equal("probe name", suite.proxyView(erc20, contract, "name"))
+		},
+		"_symbol": func(variable contracts.StorageVariable) {
+			setShortString(word(variable.Slot), "PROBE")
+			suite.Require().Equal("PROBE", suite.proxyView(erc20, contract, "symbol"))
+		},
+		"_paused": func(variable contracts.StorageVariable) {
+			bz := suite.app.EvmKeeper.GetState(suite.ctx, contract, word(variable.Slot))
+			bz[31-variable.Offset] = 1
+			setState(word(variable.Slot), bz.Bytes())
+			suite.Require().Equal(true, suite.proxyView(erc20, contract, "paused"))
+		},
+		"_decimals": func(variable contracts.StorageVariable) {
+			bz := suite.app.EvmKeeper.GetState(suite.ctx, contract, word(variable.Slot))
+			bz[31-variable.Offset] = 6
+			setState(word(variable.Slot), bz.Bytes())
+			suite.Require().Equal(uint8(6), suite.proxyView(erc20, contract, "decimals"))
+		},
+		"_nonces": func(variable contracts.StorageVariable) {
+			// the counter value is the first field of the Counter struct
+			setState(mappingSlot(owner.Bytes(), word(variable.Slot)), word(14).Bytes())
+			suite.Require().Equal(big.NewInt(14), suite.proxyView(permit, contract, "nonces", owner))
+		},
+	}
+
+	for _, variable := range implementation.StorageLayout {
+		probe, ok := probes[variable.Label]
+		suite.Require().True(ok, "no probe for variable %s", variable.Label)
+		probe(variable)
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterCoinDeploysProxy() {
+	_, pair := suite.setupRegisterCoin()
+	suite.Require().NotNil(pair)
+
+	contract := pair.GetERC20Contract()
+	implementation, found := contracts.GetERC20Implementation(contracts.DefaultERC20Implementation)
+	suite.Require().True(found)
+
+	suite.Require().True(suite.app.Erc20Keeper.IsERC20Proxy(suite.ctx, contract))
+	suite.Require().Equal(keeper.ERC20ImplementationAddress(implementation), suite.proxyQuery(contract, "implementation"))
+	suite.Require().Equal(types.ModuleAddress, suite.proxyQuery(contract, "admin"))
+	suite.Require().Equal(contracts.DefaultERC20Implementation, suite.app.Erc20Keeper.GetERC20Implementation(suite.ctx))
+
+	// the proxy storage follows the declared storage layout of the implementation
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 100))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+
+	msg := types.NewMsgConvertCoin(sdk.NewInt64Coin(cosmosTokenBase, 10), suite.address, sender)
+	_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	slot := func(variable contracts.StorageVariable) common.Hash {
+		return common.BigToHash(new(big.Int).SetUint64(variable.Slot))
+	}
+
+	totalSupply := suite.storageVariable(implementation.StorageLayout, "_totalSupply")
+	suite.Require().Equal(common.BigToHash(big.NewInt(10)), suite.app.EvmKeeper.GetState(suite.ctx, contract, slot(totalSupply)))
+
+	balances := suite.storageVariable(implementation.StorageLayout, "_balances")
+	balanceSlot := crypto.Keccak256Hash(common.LeftPadBytes(suite.address.Bytes(), 32), slot(balances).Bytes())
+	suite.Require().Equal(common.BigToHash(big.NewInt(10)), suite.app.EvmKeeper.GetState(suite.ctx, contract, balanceSlot))
+
+	// short strings are stored left aligned with twice their length in the last byte
+	name := suite.storageVariable(implementation.StorageLayout, "_name")
+	nameWord := suite.app.EvmKeeper.GetState(suite.ctx, contract, slot(name))
+	suite.Require().Equal(byte(2*len(cosmosTokenBase)), nameWord[31])
+	suite.Require().Equal(cosmosTokenBase, string(nameWord[:len(cosmosTokenBase)]))
+
+	paused := suite.storageVariable(implementation.StorageLayout, "_paused")
+	decimals := suite.storageVariable(implementation.StorageLayout, "_decimals")
+	suite.Require().Equal(paused.Slot, decimals.Slot)
+	packed := suite.app.EvmKeeper.GetState(suite.ctx, contract, slot(decimals))
+	suite.Require().Equal(byte(0), packed[31-paused.Offset])
+	suite.Require().Equal(byte(0), packed[31-decimals.Offset])
+}
+
+func (suite *KeeperTestSuite) TestUpgradeERC20Implementation() {
+	var (
+		pair    *types.TokenPair
+		proxies []common.Address
+	)
+
+	// an implementation with different creation code but the same runtime code
+	latest := contracts.ERC20Implementations[len(contracts.ERC20Implementations)-1]
+	ctorArgs, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("", "upgraded", "UPGR", uint8(18))
+	suite.Require().NoError(err)
+	upgraded := contracts.ERC20Implementation{
+		Name:          "ERC20MinterBurnerDecimalsUpgraded",
+		CreationCode:  append(append([]byte{}, contracts.ERC20MinterBurnerDecimalsContract.Bin...), ctorArgs...),
+		StorageLayout: latest.StorageLayout,
+	}
+
+	registry := contracts.ERC20Implementations
+	contracts.ERC20Implementations = append(append([]contracts.ERC20Implementation{}, registry...), upgraded)
+	defer func() {
+		contracts.ERC20Implementations = registry
+	}()
+
+	testCases := []struct {
+		name           string
+		malleate       func()
+		implementation string
+		expPass        bool
+		expDefault     bool
+	}{
+		{
+			"fail - unknown implementation",
+			func() {},
+			"ERC20Unknown",
+			false,
+			false,
+		},
+		{
+			"fail - token not registered",
+			func() {
+				proxies = []common.Address{tests.GenerateAddress()}
+			},
+			upgraded.Name,
+			false,
+			false,
+		},
+		{
+			"fail - token pair of an ERC20 contract",
+			func() {
+				contract := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.Commit()
+				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract, types.TOKEN_BEHAVIOUR_STANDARD, 0, 0, nil)
+				suite.Require().NoError(err)
+				proxies = []common.Address{contract}
+			},
+			upgraded.Name,
+			false,
+			false,
+		},
+		{
+			"ok - given proxies",
+			func() {
+				proxies = []common.Address{pair.GetERC20Contract()}
+			},
+			upgraded.Name,
+			true,
+			false,
+		},
+		{
+			"ok - all proxies",
+			func() {
+				proxies = nil
+			},
+			upgraded.Name,
+			true,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			_, pair = suite.setupRegisterCoin()
+			suite.Require().NotNil(pair)
+			contract := pair.GetERC20Contract()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 100))
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+
+			msg := types.NewMsgConvertCoin(sdk.NewInt64Coin(cosmosTokenBase, 10), suite.address, sender)
+			_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().NoError(err)
+
+			previous := suite.proxyQuery(contract, "implementation")
+
+			tc.malleate()
+
+			res, err := suite.app.Erc20Keeper.UpgradeERC20Implementation(suite.ctx, tc.implementation, proxies)
+			if !tc.expPass {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Equal(previous, suite.proxyQuery(contract, "implementation"))
+				return
+			}
+
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal([]common.Address{contract}, res)
+			suite.Require().Equal(keeper.ERC20ImplementationAddress(upgraded), suite.proxyQuery(contract, "implementation"))
+			suite.Require().Equal(types.ModuleAddress, suite.proxyQuery(contract, "admin"))
+
+			if tc.expDefault {
+				suite.Require().Equal(upgraded.Name, suite.app.Erc20Keeper.GetERC20Implementation(suite.ctx))
+			} else {
+				suite.Require().Equal(contracts.DefaultERC20Implementation, suite.app.Erc20Keeper.GetERC20Implementation(suite.ctx))
+			}
+
+			// state is kept and the module can still mint and burn through the proxy
+			suite.Require().Equal(cosmosTokenBase, suite.NameOf(contract))
+			suite.Require().Equal(big.NewInt(10), suite.BalanceOf(contract, suite.address))
+
+			_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().NoError(err)
+			suite.Require().Equal(big.NewInt(20), suite.BalanceOf(contract, suite.address))
+
+			msgERC20 := types.NewMsgConvertERC20(sdk.NewInt(20), sender, contract, suite.address)
+			_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msgERC20)
+			suite.Require().NoError(err)
+			suite.Require().Equal(int64(0), suite.BalanceOf(contract, suite.address).(*big.Int).Int64())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpgradeERC20ImplementationNotAdmin() {
+	_, pair := suite.setupRegisterCoin()
+	suite.Require().NotNil(pair)
+	contract := pair.GetERC20Contract()
+
+	implementation := suite.proxyQuery(contract, "implementation")
+	proxy := contracts.ERC20ProxyContract.ABI
+
+	// calls from any other account are delegated to the implementation, which
+	// doesn't have an upgradeTo method
+	_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, proxy, suite.address, contract, "upgradeTo", contract)
+	suite.Require().Error(err)
+	suite.Require().Equal(implementation, suite.proxyQuery(contract, "implementation"))
+
+	// the module can't point the proxy to an account without code
+	_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, proxy, types.ModuleAddress, contract, "upgradeTo", tests.GenerateAddress())
+	suite.Require().Error(err)
+	suite.Require().Equal(implementation, suite.proxyQuery(contract, "implementation"))
+}
+
+func (suite *KeeperTestSuite) TestSetERC20DetailsNotAdmin() {
+	_, pair := suite.setupRegisterCoin()
+	suite.Require().NotNil(pair)
+	contract := pair.GetERC20Contract()
+
+	erc20 := contracts.ERC20MetadataContract.ABI
+	_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, suite.address, contract, "setName", "Renamed")
+	suite.Require().Error(err)
+	_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, suite.address, contract, "setSymbol", "RNMD")
+	suite.Require().Error(err)
+
+	erc20Data, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, contract)
+	suite.Require().NoError(err)
+	suite.Require().Equal(cosmosTokenBase, erc20Data.Name)
+	suite.Require().Equal(erc20Symbol, erc20Data.Symbol)
+}
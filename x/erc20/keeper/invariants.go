@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
+)
+
+// RegisterInvariants registers the erc20 module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "native-coin-escrow", NativeCoinEscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "native-erc20-escrow", NativeERC20EscrowInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "token-pair-maps", TokenPairMapsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the erc20 module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := NativeCoinEscrowInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = NativeERC20EscrowInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return TokenPairMapsInvariant(k)(ctx)
+	}
+}
+
+// NativeCoinEscrowInvariant checks that, for every token pair owned by the
+// module (i.e native Cosmos coin), the coin balance escrowed on the module
+// account, scaled up with the pair scaling, covers the total supply of the
+// ERC20 representation. The escrow can exceed the supply, as any holder can
+// burn its tokens on the ERC20 contract. The registration deposits held by
+// the module account and the escrow stranded by tombstoned token pairs are not
+// part of the escrow. The wrapped EVM denom is escrowed on its contract
+// instead, whose balance must cover the total supply.
+func NativeCoinEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		// EVM calls modify the module account nonce, so the state transitions
+		// are discarded to keep the invariant checks side-effect free
+		cacheCtx, _ := ctx.CacheContext()
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+		for _, pair := range k.GetAllTokenPairs(ctx) {
+			if !pair.IsNativeCoin() || !k.isContract(cacheCtx, pair) {
+				continue
+			}
+
+			escrowed := k.escrowedCoins(ctx, pair)
+			totalSupply := k.totalSupply(cacheCtx, erc20, pair)
+
+			backed := totalSupply != nil && pair.CoinsToTokens(escrowed).GTE(sdk.NewIntFromBigInt(totalSupply))
+
+			// the wrapped EVM denom is escrowed on its contract, which can also
+			// receive coins outside of deposits and conversions
+			if k.IsWrappedEVMDenomPair(ctx, pair) {
+				escrowed = k.bankKeeper.GetBalance(ctx, sdk.AccAddress(pair.GetERC20Contract().Bytes()), pair.Denom).Amount
+				backed = totalSupply != nil && escrowed.GTE(sdk.NewIntFromBigInt(totalSupply))
+			}
+
+			if !backed {
+				broken++
+				msg += fmt.Sprintf(
+					"\ttoken pair %s: escrowed coins %s, ERC20 total supply %v\n",
+					pair.Denom, escrowed, totalSupply,
+				)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "native-coin-escrow",
+			fmt.Sprintf("found %d native coin token pairs with insufficient escrow\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// NativeERC20EscrowInvariant checks that, for every token pair owned by an
+// external contract (i.e native ERC20), the ERC20 balance held by the module
+// covers the bank supply of the coin representation, scaled up with the pair
+// scaling. The balance can exceed the supply, as anyone can transfer tokens to
+// the module address.
+func NativeERC20EscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		// EVM calls modify the module account nonce, so the state transitions
+		// are discarded to keep the invariant checks side-effect free
+		cacheCtx, _ := ctx.CacheContext()
+		erc20 := contracts.ERC20BurnableContract.ABI
+
+		for _, pair := range k.GetAllTokenPairs(ctx) {
+			if !pair.IsNativeERC20() || !k.isContract(cacheCtx, pair) {
+				continue
+			}
+
+			supply := k.bankKeeper.GetSupply(ctx, pair.Denom).Amount
+			escrowed := k.balanceOf(cacheCtx, erc20, pair.GetERC20Contract(), types.ModuleAddress)
+
+			if escrowed == nil || sdk.NewIntFromBigInt(escrowed).LT(pair.CoinsToTokens(supply)) {
+				broken++
+				msg += fmt.Sprintf(
+					"\ttoken pair %s: coin supply %s, escrowed ERC20 balance %v\n",
+					pair.Erc20Address, supply, escrowed,
+				)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "native-erc20-escrow",
+			fmt.Sprintf("found %d native ERC20 token pairs with insufficient escrow\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// TokenPairMapsInvariant checks that every token pair is indexed by both its
+// ERC20 contract address and its coin denomination.
+func TokenPairMapsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		for _, pair := range k.GetAllTokenPairs(ctx) {
+			id := pair.GetID()
+
+			if !bytes.Equal(k.GetERC20Map(ctx, pair.GetERC20Contract()), id) {
+				broken++
+				msg += fmt.Sprintf("\ttoken pair %s not indexed by ERC20 address\n", pair.Erc20Address)
+			}
+
+			if !bytes.Equal(k.GetDenomMap(ctx, pair.Denom), id) {
+				broken++
+				msg += fmt.Sprintf("\ttoken pair %s not indexed by denom\n", pair.Denom)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "token-pair-maps",
+			fmt.Sprintf("found %d missing token pair indexes\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// isContract returns true if the token pair ERC20 contract has code. Pairs of
+// self-destructed contracts are skipped as they can no longer be converted.
+func (k Keeper) isContract(ctx sdk.Context, pair types.TokenPair) bool {
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, pair.GetERC20Contract())
+	return acc != nil && acc.IsContract()
+}
+
+// totalSupply queries the total supply of the token pair ERC20 contract
+func (k Keeper) totalSupply(ctx sdk.Context, abi abi.ABI, pair types.TokenPair) *big.Int {
+	res, err := k.CallEVM(ctx, abi, types.ModuleAddress, pair.GetERC20Contract(), "totalSupply")
+	if err != nil {
+		return nil
+	}
+
+	unpacked, _ := abi.Unpack("totalSupply", res.Ret)
+	if len(unpacked) == 0 {
+		return nil
+	}
+
+	supply, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil
+	}
+
+	return supply
+}
@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/evmos/x/erc20/keeper"
+	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
+)
+
+func (suite *KeeperTestSuite) TestNativeCoinEscrowInvariant() {
+	testCases := []struct {
+		name     string
+		malleate func(types.TokenPair)
+		expPass  bool
+	}{
+		{
+			"ok - no conversions",
+			func(types.TokenPair) {},
+			true,
+		},
+		{
+			"ok - coins converted to ERC20",
+			func(pair types.TokenPair) {
+				sender := sdk.AccAddress(suite.address.Bytes())
+				coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, sdk.NewInt(100)))
+				suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+				suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins)
+
+				msg := types.NewMsgConvertCoin(sdk.NewCoin(pair.Denom, sdk.NewInt(10)), suite.address, sender)
+				_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"ok - tokens burned by a holder",
+			func(pair types.TokenPair) {
+				sender := sdk.AccAddress(suite.address.Bytes())
+				coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, sdk.NewInt(100)))
+				suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+				suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins)
+
+				msg := types.NewMsgConvertCoin(sdk.NewCoin(pair.Denom, sdk.NewInt(10)), suite.address, sender)
+				_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+
+				_, err = suite.app.Erc20Keeper.CallEVM(
+					suite.ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, suite.address, pair.GetERC20Contract(),
+					"burn", big.NewInt(5),
+				)
+				suite.Require().NoError(err)
+				suite.Require().Equal(int64(5), suite.BalanceOf(pair.GetERC20Contract(), suite.address).(*big.Int).Int64())
+			},
+			true,
+		},
+		{
+			"fail - escrowed coins burned",
+			func(pair types.TokenPair) {
+				sender := sdk.AccAddress(suite.address.Bytes())
+				coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, sdk.NewInt(100)))
+				suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+				suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins)
+
+				msg := types.NewMsgConvertCoin(sdk.NewCoin(pair.Denom, sdk.NewInt(10)), suite.address, sender)
+				_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+
+				burn := sdk.NewCoins(sdk.NewCoin(pair.Denom, sdk.NewInt(5)))
+				err = suite.app.BankKeeper.BurnCoins(suite.ctx, types.ModuleName, burn)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			_, pair := suite.setupRegisterCoin()
+			suite.Require().NotNil(pair)
+
+			tc.malleate(*pair)
+			suite.Commit()
+
+			msg, broken := keeper.NativeCoinEscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+			if tc.expPass {
+				suite.Require().False(broken, msg)
+			} else {
+				suite.Require().True(broken, msg)
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestNativeERC20EscrowInvariant() {
+	testCases := []struct {
+		name         string
+		malleate     func(common.Address)
+		contractType int
+		expPass      bool
+	}{
+		{
+			"ok - no conversions",
+			func(common.Address) {},
+			contractMinterBurner,
+			true,
+		},
+		{
+			"ok - ERC20 converted to coins",
+			func(contractAddr common.Address) {
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+				suite.Commit()
+
+				sender := sdk.AccAddress(suite.address.Bytes())
+				msg := types.NewMsgConvertERC20(sdk.NewInt(10), sender, contractAddr, suite.address)
+				_, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			contractMinterBurner,
+			true,
+		},
+		{
+			"ok - tokens transferred to the module address",
+			func(contractAddr common.Address) {
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+				suite.Commit()
+
+				sender := sdk.AccAddress(suite.address.Bytes())
+				msg := types.NewMsgConvertERC20(sdk.NewInt(10), sender, contractAddr, suite.address)
+				_, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+
+				suite.TransferERC20Token(contractAddr, suite.address, types.ModuleAddress, big.NewInt(5))
+				suite.Commit()
+				suite.Require().Equal(int64(15), suite.BalanceOf(contractAddr, types.ModuleAddress).(*big.Int).Int64())
+			},
+			contractMinterBurner,
+			true,
+		},
+		{
+			"fail - coins minted without escrow",
+			func(contractAddr common.Address) {
+				coins := sdk.NewCoins(sdk.NewCoin(types.CreateDenom(contractAddr.String()), sdk.NewInt(10)))
+				err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			contractMinterBurner,
+			false,
+		},
+		{
+			"fail - malicious delayed contract escrow drained",
+			func(contractAddr common.Address) {
+				// the transfer to the module approves the thief to spend the
+				// escrowed tokens
+				suite.TransferERC20Token(contractAddr, suite.address, types.ModuleAddress, big.NewInt(10))
+				suite.Commit()
+
+				thief := common.HexToAddress("0x4dC6ac40Af078661fc43823086E1513635Eeab14")
+				acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, thief.Bytes())
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+				_, err := suite.app.Erc20Keeper.CallEVM(
+					suite.ctx, contracts.ERC20MaliciousDelayedContract.ABI, thief, contractAddr,
+					"transferFrom", types.ModuleAddress, thief, big.NewInt(10),
+				)
+				suite.Require().NoError(err)
+			},
+			contractMaliciousDelayed,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			contractAddr := suite.setupRegisterERC20Pair(tc.contractType)
+			suite.Require().NotNil(contractAddr)
+
+			tc.malleate(contractAddr)
+			suite.Commit()
+
+			msg, broken := keeper.NativeERC20EscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+			if tc.expPass {
+				suite.Require().False(broken, msg)
+			} else {
+				suite.Require().True(broken, msg)
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestTokenPairMapsInvariant() {
+	testCases := []struct {
+		name     string
+		malleate func(types.TokenPair)
+		expPass  bool
+	}{
+		{
+			"ok - token pair indexed",
+			func(types.TokenPair) {},
+			true,
+		},
+		{
+			"fail - token pair not indexed by ERC20 address",
+			func(pair types.TokenPair) {
+				suite.app.Erc20Keeper.DeleteERC20Map(suite.ctx, pair.GetERC20Contract())
+			},
+			false,
+		},
+		{
+			"fail - token pair not indexed by denom",
+			func(pair types.TokenPair) {
+				suite.app.Erc20Keeper.DeleteDenomMap(suite.ctx, pair.Denom)
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			_, pair := suite.setupRegisterCoin()
+			suite.Require().NotNil(pair)
+
+			tc.malleate(*pair)
+
+			msg, broken := keeper.TokenPairMapsInvariant(suite.app.Erc20Keeper)(suite.ctx)
+			if tc.expPass {
+				suite.Require().False(broken, msg)
+			} else {
+				suite.Require().True(broken, msg)
+			}
+		})
+	}
+}
@@ -21,7 +21,15 @@ type Keeper struct {
 
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
 	evmKeeper     *evmkeeper.Keeper // TODO: use interface
+
+	transferKeeper types.TransferKeeper
+	channelKeeper  types.ChannelKeeper
+	epochsKeeper   types.EpochsKeeper
+
+	// rules checked on the logs of the EVM calls made by the conversions
+	safetyRules []SafetyRule
 }
 
 // NewKeeper creates new instances of the erc20 Keeper
@@ -31,7 +39,11 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
+	dk types.DistributionKeeper,
 	evmKeeper *evmkeeper.Keeper,
+	tk types.TransferKeeper,
+	ck types.ChannelKeeper,
+	ek types.EpochsKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -39,12 +51,17 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeKey:      storeKey,
-		cdc:           cdc,
-		paramstore:    ps,
-		accountKeeper: ak,
-		bankKeeper:    bk,
-		evmKeeper:     evmKeeper,
+		storeKey:       storeKey,
+		cdc:            cdc,
+		paramstore:     ps,
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+		distrKeeper:    dk,
+		evmKeeper:      evmKeeper,
+		transferKeeper: tk,
+		channelKeeper:  ck,
+		epochsKeeper:   ek,
+		safetyRules:    DefaultSafetyRules(),
 	}
 }
 
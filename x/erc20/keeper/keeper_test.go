@@ -308,6 +308,48 @@ func (suite *KeeperTestSuite) DeployContractDirectBalanceManipulation(name strin
 	return crypto.CreateAddress(suite.address, nonce)
 }
 
+// DeployContractQuirky deploys one of the constructor-less non-standard test
+// tokens (e.g. ERC20NoReturn, ERC20Rebasing).
+func (suite *KeeperTestSuite) DeployContractQuirky(contract evm.CompiledContract) common.Address {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	chainID := suite.app.EvmKeeper.ChainID()
+
+	data := contract.Bin
+	args, err := json.Marshal(&evm.TransactionArgs{
+		From: &suite.address,
+		Data: (*hexutil.Bytes)(&data),
+	})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClientEvm.EstimateGas(ctx, &evm.EthCallRequest{
+		Args:   args,
+		GasCap: uint64(config.DefaultGasCap),
+	})
+	suite.Require().NoError(err)
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+
+	erc20DeployTx := evm.NewTxContract(
+		chainID,
+		nonce,
+		nil,     // amount
+		res.Gas, // gasLimit
+		nil,     // gasPrice
+		suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx),
+		big.NewInt(1),
+		data,                   // input
+		&ethtypes.AccessList{}, // accesses
+	)
+
+	erc20DeployTx.From = suite.address.Hex()
+	err = erc20DeployTx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer)
+	suite.Require().NoError(err)
+	rsp, err := suite.app.EvmKeeper.EthereumTx(ctx, erc20DeployTx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rsp.VmError)
+	return crypto.CreateAddress(suite.address, nonce)
+}
+
 func (suite *KeeperTestSuite) Commit() {
 	_ = suite.app.Commit()
 	header := suite.ctx.BlockHeader()
@@ -349,10 +391,25 @@ func (suite *KeeperTestSuite) GrantERC20Token(contractAddr, from, to common.Addr
 }
 
 func (suite *KeeperTestSuite) sendTx(contractAddr, from common.Address, transferData []byte) *evm.MsgEthereumTx {
+	ercTransferTx, rsp := suite.deliverTx(contractAddr, from, transferData)
+	suite.Require().Empty(rsp.VmError)
+	return ercTransferTx
+}
+
+// deliverTx sends the transaction and returns its response without checking
+// the EVM execution result
+func (suite *KeeperTestSuite) deliverTx(contractAddr, from common.Address, transferData []byte) (*evm.MsgEthereumTx, *evm.MsgEthereumTxResponse) {
+	return suite.deliverTxWithValue(contractAddr, from, nil, transferData)
+}
+
+// deliverTxWithValue sends the transaction transferring the given amount of
+// the EVM denom to the contract and returns its response without checking the
+// EVM execution result
+func (suite *KeeperTestSuite) deliverTxWithValue(contractAddr, from common.Address, value *big.Int, transferData []byte) (*evm.MsgEthereumTx, *evm.MsgEthereumTxResponse) {
 	ctx := sdk.WrapSDKContext(suite.ctx)
 	chainID := suite.app.EvmKeeper.ChainID()
 
-	args, err := json.Marshal(&evm.TransactionArgs{To: &contractAddr, From: &from, Data: (*hexutil.Bytes)(&transferData)})
+	args, err := json.Marshal(&evm.TransactionArgs{To: &contractAddr, From: &from, Value: (*hexutil.Big)(value), Data: (*hexutil.Bytes)(&transferData)})
 	suite.Require().NoError(err)
 	res, err := suite.queryClientEvm.EstimateGas(ctx, &evm.EthCallRequest{
 		Args:   args,
@@ -369,7 +426,7 @@ func (suite *KeeperTestSuite) sendTx(contractAddr, from common.Address, transfer
 		chainID,
 		nonce,
 		&contractAddr,
-		nil,
+		value,
 		res.Gas,
 		nil,
 		suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx),
@@ -383,8 +440,7 @@ func (suite *KeeperTestSuite) sendTx(contractAddr, from common.Address, transfer
 	suite.Require().NoError(err)
 	rsp, err := suite.app.EvmKeeper.EthereumTx(ctx, ercTransferTx)
 	suite.Require().NoError(err)
-	suite.Require().Empty(rsp.VmError)
-	return ercTransferTx
+	return ercTransferTx, rsp
 }
 
 func (suite *KeeperTestSuite) BalanceOf(contract, account common.Address) interface{} {
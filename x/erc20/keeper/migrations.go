@@ -1,5 +1,12 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -11,3 +18,39 @@ func NewMigrator(keeper Keeper) Migrator {
 		keeper: keeper,
 	}
 }
+
+// Migrate1to2 migrates the store from consensus version 1 to 2. It sets the
+// parameters added in version 2 to their defaults and registers the wrapped
+// EVM denomination token pair. The token pairs of version 1 decode with the
+// default values of the fields added in version 2 and are left untouched.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.migrateParams(ctx)
+	return m.migrateWrappedEVMDenom(ctx)
+}
+
+// migrateParams keeps the parameters of version 1 and sets the ones added in
+// version 2 to their defaults. The version 1 parameters are read one by one,
+// as reading the whole parameter set fails on the missing keys.
+func (m Migrator) migrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	m.keeper.paramstore.GetIfExists(ctx, types.ParamStoreKeyEnableErc20, &params.EnableErc20)
+	m.keeper.paramstore.GetIfExists(ctx, types.ParamStoreKeyEnableEVMHook, &params.EnableEVMHook)
+	m.keeper.SetParams(ctx, params)
+}
+
+// migrateWrappedEVMDenom deploys and registers the WEVMOS token pair, which
+// version 1 chains don't have. The registration is skipped if intrarelaying is
+// disabled or if the EVM denomination is already registered, in which case it
+// is left to a RegisterWrappedEVMDenomProposal.
+func (m Migrator) migrateWrappedEVMDenom(ctx sdk.Context) error {
+	denom := m.keeper.evmKeeper.GetParams(ctx).EvmDenom
+	if !m.keeper.GetParams(ctx).EnableErc20 || m.keeper.IsDenomRegistered(ctx, denom) {
+		m.keeper.Logger(ctx).Info("skipping the wrapped evm denom registration", "denom", denom)
+		return nil
+	}
+
+	if _, err := m.keeper.RegisterWrappedEVMDenom(ctx); err != nil {
+		return sdkerrors.Wrap(err, "failed to register the wrapped evm denom")
+	}
+	return nil
+}
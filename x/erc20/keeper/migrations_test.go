@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/tharsis/evmos/x/erc20/keeper"
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// v1 store fixture: a token pair of a native Cosmos coin as stored by the
+// version 1 module and its parameters
+const (
+	v1PairContract = "0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd"
+	v1PairDenom    = "acoin"
+	v1PairBytes    = "0a2a307838306235613332453446303332423261303538623446323945433935454566454542383761446364120561636f696e18012001"
+)
+
+// setV1Store replaces the erc20 store and parameters with the version 1 store
+// fixture
+func (suite *KeeperTestSuite) setV1Store(enableErc20 bool) {
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	iterator := store.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	paramsStore := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		paramsStore.Delete(pair.Key)
+	}
+	paramsStore.Set(types.ParamStoreKeyEnableErc20, []byte(strconv.FormatBool(enableErc20)))
+	paramsStore.Set(types.ParamStoreKeyEnableEVMHook, []byte("true"))
+
+	bz, err := hex.DecodeString(v1PairBytes)
+	suite.Require().NoError(err)
+	id := tmhash.Sum([]byte(v1PairContract + "|" + v1PairDenom))
+	store.Set(append(types.KeyPrefixTokenPair, id...), bz)
+	store.Set(append(types.KeyPrefixTokenPairByERC20, common.HexToAddress(v1PairContract).Bytes()...), id)
+	store.Set(append(types.KeyPrefixTokenPairByDenom, []byte(v1PairDenom)...), id)
+}
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	testCases := []struct {
+		name          string
+		enableErc20   bool
+		expRegistered bool
+	}{
+		{
+			"intrarelaying enabled - wrapped evm denom registered",
+			true,
+			true,
+		},
+		{
+			"intrarelaying disabled - wrapped evm denom left to governance",
+			false,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			suite.setV1Store(tc.enableErc20)
+
+			m := keeper.NewMigrator(suite.app.Erc20Keeper)
+			suite.Require().NoError(m.Migrate1to2(suite.ctx))
+
+			expParams := types.DefaultParams()
+			expParams.EnableErc20 = tc.enableErc20
+			suite.Require().Equal(expParams, suite.app.Erc20Keeper.GetParams(suite.ctx))
+
+			// the version 1 token pair decodes with the default values of the
+			// fields added in version 2
+			id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, v1PairDenom)
+			pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+			suite.Require().True(found)
+			suite.Require().Equal(v1PairContract, pair.Erc20Address)
+			suite.Require().True(pair.Enabled)
+			suite.Require().Equal(types.OWNER_MODULE, pair.ContractOwner)
+			suite.Require().Equal(types.TOKEN_BEHAVIOUR_STANDARD, pair.Behaviour)
+			suite.Require().Equal(uint32(0), pair.ScalingExponent)
+			suite.Require().Equal(uint64(0), pair.GasLimit)
+			suite.Require().NoError(pair.Validate())
+
+			contract, found := suite.app.Erc20Keeper.GetWrappedEVMDenomContract(suite.ctx)
+			suite.Require().Equal(tc.expRegistered, found)
+			if tc.expRegistered {
+				wrapped, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, suite.app.Erc20Keeper.GetERC20Map(suite.ctx, contract))
+				suite.Require().True(found)
+				suite.Require().Equal(suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom, wrapped.Denom)
+				suite.Require().Len(suite.app.Erc20Keeper.GetAllTokenPairs(suite.ctx), 2)
+			} else {
+				suite.Require().Len(suite.app.Erc20Keeper.GetAllTokenPairs(suite.ctx), 1)
+			}
+		})
+	}
+}
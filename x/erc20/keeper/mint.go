@@ -11,6 +11,7 @@ import (
 // MintingEnabled checks that:
 //  - the global parameter for intrarelaying is enabled
 //  - minting is enabled for the given (erc20,coin) token pair
+//  - neither the module nor the token pair are paused by the guardian
 //  - recipient address is not on the blocked list
 //  - bank module transfers are enabled for the Cosmos coin
 func (k Keeper) MintingEnabled(ctx sdk.Context, sender, receiver sdk.AccAddress, token string) (types.TokenPair, error) {
@@ -21,6 +22,9 @@ func (k Keeper) MintingEnabled(ctx sdk.Context, sender, receiver sdk.AccAddress,
 
 	id := k.GetTokenPairID(ctx, token)
 	if len(id) == 0 {
+		if k.IsTokenTombstoned(ctx, token) {
+			return types.TokenPair{}, sdkerrors.Wrapf(types.ErrTokenPairTombstone, "token '%s' was tombstoned", token)
+		}
 		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token '%s' not registered", token)
 	}
 
@@ -33,6 +37,10 @@ func (k Keeper) MintingEnabled(ctx sdk.Context, sender, receiver sdk.AccAddress,
 		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrNotAllowedBridge, "minting token '%s' is not enabled by governance", token)
 	}
 
+	if err := k.CheckNotPaused(ctx, pair); err != nil {
+		return types.TokenPair{}, err
+	}
+
 	if k.bankKeeper.BlockedAddr(receiver.Bytes()) {
 		return types.TokenPair{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", receiver)
 	}
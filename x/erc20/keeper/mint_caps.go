@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// GetAllMintCaps returns all the mint caps of the token pairs stored
+func (k Keeper) GetAllMintCaps(ctx sdk.Context) []types.MintCap {
+	mintCaps := []types.MintCap{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixMintCap)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var mintCap types.MintCap
+		k.cdc.MustUnmarshal(iterator.Value(), &mintCap)
+
+		mintCaps = append(mintCaps, mintCap)
+	}
+
+	return mintCaps
+}
+
+// GetMintCap returns the mint cap of the token pair with the given ERC20
+// contract
+func (k Keeper) GetMintCap(ctx sdk.Context, contract common.Address) (types.MintCap, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintCap)
+	var mintCap types.MintCap
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.MintCap{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &mintCap)
+	return mintCap, true
+}
+
+// SetMintCap stores the mint cap of a token pair
+func (k Keeper) SetMintCap(ctx sdk.Context, mintCap types.MintCap) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintCap)
+	bz := k.cdc.MustMarshal(&mintCap)
+	store.Set(mintCap.GetERC20Contract().Bytes(), bz)
+}
+
+// DeleteMintCap removes the mint cap of the token pair with the given ERC20
+// contract
+func (k Keeper) DeleteMintCap(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintCap)
+	store.Delete(contract.Bytes())
+}
+
+// GetMintCapUtilization returns the current supply of the Cosmos coin of the
+// token pair of the given mint cap and the amount that can still be minted
+func (k Keeper) GetMintCapUtilization(ctx sdk.Context, mintCap types.MintCap, denom string) types.MintCapUtilization {
+	supply := k.bankKeeper.GetSupply(ctx, denom)
+	return types.NewMintCapUtilization(mintCap, supply.Amount)
+}
+
+// CheckMintCap checks that minting the given amount of Cosmos coins of the
+// token pair doesn't take their supply over the mint cap of the pair. It's a
+// no-op if the token pair has no mint cap.
+func (k Keeper) CheckMintCap(ctx sdk.Context, pair types.TokenPair, amount sdk.Int) error {
+	mintCap, found := k.GetMintCap(ctx, pair.GetERC20Contract())
+	if !found {
+		return nil
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, pair.Denom).Amount.Add(amount)
+	if supply.GT(mintCap.Cap) {
+		return sdkerrors.Wrapf(
+			types.ErrMintCapExceeded,
+			"supply %s%s is greater than the mint cap %s of token pair %s",
+			supply, pair.Denom, mintCap.Cap, pair.Erc20Address,
+		)
+	}
+
+	return nil
+}
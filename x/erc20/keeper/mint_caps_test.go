@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
+)
+
+func (suite *KeeperTestSuite) TestGetAllMintCaps() {
+	suite.SetupTest()
+
+	mintCaps := suite.app.Erc20Keeper.GetAllMintCaps(suite.ctx)
+	suite.Require().Empty(mintCaps)
+
+	mc := types.NewMintCap(tests.GenerateAddress(), sdk.NewInt(100))
+	mc2 := types.NewMintCap(tests.GenerateAddress(), sdk.NewInt(200))
+	suite.app.Erc20Keeper.SetMintCap(suite.ctx, mc)
+	suite.app.Erc20Keeper.SetMintCap(suite.ctx, mc2)
+
+	mintCaps = suite.app.Erc20Keeper.GetAllMintCaps(suite.ctx)
+	suite.Require().Len(mintCaps, 2)
+
+	res, found := suite.app.Erc20Keeper.GetMintCap(suite.ctx, mc.GetERC20Contract())
+	suite.Require().True(found)
+	suite.Require().Equal(mc, res)
+
+	suite.app.Erc20Keeper.DeleteMintCap(suite.ctx, mc.GetERC20Contract())
+	mintCaps = suite.app.Erc20Keeper.GetAllMintCaps(suite.ctx)
+	suite.Require().Len(mintCaps, 1)
+	suite.Require().Equal(mc2, mintCaps[0])
+}
+
+func (suite *KeeperTestSuite) TestUpdateMintCap() {
+	_, coinPair := suite.setupRegisterCoin()
+
+	_, err := suite.app.Erc20Keeper.UpdateMintCap(suite.ctx, "unregistered", sdk.NewInt(100))
+	suite.Require().Error(err)
+
+	// mint caps don't apply to native Cosmos coins
+	_, err = suite.app.Erc20Keeper.UpdateMintCap(suite.ctx, coinPair.Denom, sdk.NewInt(100))
+	suite.Require().ErrorIs(err, types.ErrUndefinedOwner)
+
+	contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+	mintCap, err := suite.app.Erc20Keeper.UpdateMintCap(suite.ctx, contractAddr.String(), sdk.NewInt(100))
+	suite.Require().NoError(err)
+	suite.Require().Equal(contractAddr.String(), mintCap.Erc20Address)
+
+	res, found := suite.app.Erc20Keeper.GetMintCap(suite.ctx, contractAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(mintCap, res)
+
+	// a zero cap removes the mint cap
+	_, err = suite.app.Erc20Keeper.UpdateMintCap(suite.ctx, types.CreateDenom(contractAddr.String()), sdk.ZeroInt())
+	suite.Require().NoError(err)
+	_, found = suite.app.Erc20Keeper.GetMintCap(suite.ctx, contractAddr)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20MintCap() {
+	testCases := []struct {
+		name    string
+		convert int64
+		expPass bool
+	}{
+		{"ok - cap reached", 50, true},
+		{"fail - cap exceeded", 51, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+
+			_, err := suite.app.Erc20Keeper.UpdateMintCap(suite.ctx, contractAddr.String(), sdk.NewInt(100))
+			suite.Require().NoError(err)
+
+			suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(200))
+			suite.Commit()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			coinName := types.CreateDenom(contractAddr.String())
+
+			// first conversion mints half of the cap
+			msg := types.NewMsgConvertERC20(sdk.NewInt(50), sender, contractAddr, suite.address)
+			_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			msg = types.NewMsgConvertERC20(sdk.NewInt(tc.convert), sender, contractAddr, suite.address)
+			_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Commit()
+			cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinName)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(int64(50+tc.convert), cosmosBalance.Amount.Int64())
+			} else {
+				suite.Require().ErrorIs(err, types.ErrMintCapExceeded, tc.name)
+				suite.Require().Equal(int64(50), cosmosBalance.Amount.Int64())
+			}
+
+			// coins converted back into tokens free up the cap
+			coinMsg := types.NewMsgConvertCoin(sdk.NewInt64Coin(coinName, 50), suite.address, sender)
+			_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), coinMsg)
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgConvertERC20(sdk.NewInt(50), sender, contractAddr, suite.address)
+			_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().NoError(err)
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestEvmHooksMintCap() {
+	testCases := []struct {
+		name     string
+		transfer int64
+		expPass  bool
+	}{
+		{"ok - within the mint cap", 10, true},
+		{"fail - mint cap exceeded", 11, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			suite.ensureHooksSet()
+
+			contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+
+			_, err := suite.app.Erc20Keeper.UpdateMintCap(suite.ctx, contractAddr.String(), sdk.NewInt(10))
+			suite.Require().NoError(err)
+
+			suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+
+			// transfer the tokens to the module address to convert them through
+			// the EVM hook
+			transferData, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("transfer", types.ModuleAddress, big.NewInt(tc.transfer))
+			suite.Require().NoError(err)
+			_, rsp := suite.deliverTx(contractAddr, suite.address, transferData)
+			suite.Commit()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, types.CreateDenom(contractAddr.String()))
+			balance := suite.BalanceOf(contractAddr, suite.address)
+			if tc.expPass {
+				suite.Require().Empty(rsp.VmError)
+				suite.Require().Equal(tc.transfer, cosmosBalance.Amount.Int64())
+				suite.Require().Equal(100-tc.transfer, balance.(*big.Int).Int64())
+			} else {
+				suite.Require().NotEmpty(rsp.VmError)
+				suite.Require().True(cosmosBalance.Amount.IsZero())
+				suite.Require().Equal(int64(100), balance.(*big.Int).Int64())
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestMintCapDeregisterTokenPair() {
+	suite.SetupTest()
+	contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+
+	_, err := suite.app.Erc20Keeper.UpdateMintCap(suite.ctx, contractAddr.String(), sdk.NewInt(100))
+	suite.Require().NoError(err)
+
+	_, _, err = suite.app.Erc20Keeper.DeregisterTokenPair(suite.ctx, contractAddr.String(), nil)
+	suite.Require().NoError(err)
+
+	_, found := suite.app.Erc20Keeper.GetMintCap(suite.ctx, contractAddr)
+	suite.Require().False(found)
+}
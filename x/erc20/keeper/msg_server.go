@@ -3,12 +3,13 @@ package keeper
 import (
 	"context"
 	"math/big"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 
@@ -25,7 +26,17 @@ func (k Keeper) ConvertCoin(
 	msg *types.MsgConvertCoin,
 ) (*types.MsgConvertCoinResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	return k.convertCoin(ctx, msg, false)
+}
 
+// convertCoin converts Cosmos-native Coins into ERC20 tokens. If exempt is
+// true, the conversion fee is not charged and the conversion is not counted
+// against the rate limit of the token pair.
+func (k Keeper) convertCoin(
+	ctx sdk.Context,
+	msg *types.MsgConvertCoin,
+	exempt bool,
+) (*types.MsgConvertCoinResponse, error) {
 	// Error checked during msg validation
 	receiver := common.HexToAddress(msg.Receiver)
 	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
@@ -35,26 +46,23 @@ func (k Keeper) ConvertCoin(
 		return nil, err
 	}
 
-	// Remove token pair if contract is suicided
-	erc20 := common.HexToAddress(pair.Erc20Address)
-	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, erc20)
+	// the token pair of a destroyed contract is tombstoned by the EndBlocker
+	if !k.isContract(ctx, pair) {
+		return nil, sdkerrors.Wrapf(types.ErrTokenPairTombstone, "ERC20 contract %s has no code", pair.Erc20Address)
+	}
 
-	if acc == nil || !acc.IsContract() {
-		k.DeleteTokenPair(ctx, pair)
-		k.Logger(ctx).Debug(
-			"deleting selfdestructed token pair from state",
-			"contract", pair.Erc20Address,
-		)
-		// NOTE: return nil error to persist the changes from the deletion
-		return nil, nil
+	if !exempt {
+		if err := k.ConsumeRateLimit(ctx, pair, msg.Coin.Amount, types.ConversionInflow); err != nil {
+			return nil, err
+		}
 	}
 
 	// Check ownership and execute conversion
 	switch {
 	case pair.IsNativeCoin():
-		return k.convertCoinNativeCoin(ctx, pair, msg, receiver, sender) // case 1.1
+		return k.convertCoinNativeCoin(ctx, pair, msg, receiver, sender, exempt) // case 1.1
 	case pair.IsNativeERC20():
-		return k.convertCoinNativeERC20(ctx, pair, msg, receiver, sender) // case 2.2
+		return k.convertCoinNativeERC20(ctx, pair, msg, receiver, sender, exempt) // case 2.2
 	default:
 		return nil, types.ErrUndefinedOwner
 	}
@@ -77,18 +85,20 @@ func (k Keeper) ConvertERC20(
 		return nil, err
 	}
 
-	// Remove token pair if contract is suicided
-	erc20 := common.HexToAddress(pair.Erc20Address)
-	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, erc20)
+	// the token pair of a destroyed contract is tombstoned by the EndBlocker
+	if !k.isContract(ctx, pair) {
+		return nil, sdkerrors.Wrapf(types.ErrTokenPairTombstone, "ERC20 contract %s has no code", pair.Erc20Address)
+	}
 
-	if acc == nil || !acc.IsContract() {
-		k.DeleteTokenPair(ctx, pair)
-		k.Logger(ctx).Debug(
-			"deleting selfdestructed token pair from state",
-			"contract", pair.Erc20Address,
-		)
-		// NOTE: return nil error to persist the changes from the deletion
-		return nil, nil
+	// Rate limits apply to the amount of Cosmos coins, which is scaled down from
+	// the amount of tokens
+	amount, _, err := tokensToCoins(pair, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ConsumeRateLimit(ctx, pair, amount, types.ConversionOutflow); err != nil {
+		return nil, err
 	}
 
 	// Check ownership
@@ -102,17 +112,483 @@ func (k Keeper) ConvertERC20(
 	}
 }
 
+// TransferERC20 converts ERC20 tokens into Cosmos-native Coins and sends them
+// to a counterparty chain through an ICS20 transfer within the same state
+// transition. The ERC20 transfer is tracked by its packet sequence so that the
+// refunded coins are converted back to ERC20 tokens if the packet times out or
+// is acknowledged with an error.
+func (k Keeper) TransferERC20(
+	goCtx context.Context,
+	msg *types.MsgTransferERC20,
+) (*types.MsgTransferERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	sender := common.HexToAddress(msg.Sender)
+	contract := common.HexToAddress(msg.ContractAddress)
+
+	id := k.GetTokenPairID(ctx, msg.ContractAddress)
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		if k.IsTokenTombstoned(ctx, msg.ContractAddress) {
+			return nil, sdkerrors.Wrapf(types.ErrTokenPairTombstone, "token pair for contract '%s' was tombstoned", msg.ContractAddress)
+		}
+		return nil, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token pair for contract '%s' not registered", msg.ContractAddress)
+	}
+
+	balanceCoin := k.bankKeeper.GetBalance(ctx, sender.Bytes(), pair.Denom)
+
+	// Convert the ERC20 tokens to Cosmos coins on the sender account
+	convertMsg := types.NewMsgConvertERC20(msg.Amount, sender.Bytes(), contract, sender)
+	if _, err := k.ConvertERC20(goCtx, convertMsg); err != nil {
+		return nil, err
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, msg.SourcePort, msg.SourceChannel)
+	if !found {
+		return nil, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", msg.SourcePort, msg.SourceChannel,
+		)
+	}
+
+	// Send the converted coins through the ICS20 transfer, which are less than
+	// the amount of tokens if a conversion fee was charged
+	balanceCoinAfter := k.bankKeeper.GetBalance(ctx, sender.Bytes(), pair.Denom)
+	coin := balanceCoinAfter.Sub(balanceCoin)
+	if err := k.transferKeeper.SendTransfer(
+		ctx, msg.SourcePort, msg.SourceChannel, coin, sender.Bytes(), msg.Receiver,
+		msg.TimeoutHeight, msg.TimeoutTimestamp,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to send ICS20 transfer")
+	}
+
+	k.SetERC20Transfer(ctx, msg.SourcePort, msg.SourceChannel, sequence, contract)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeTransferERC20,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, msg.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeySrcPort, msg.SourcePort),
+				sdk.NewAttribute(types.AttributeKeySrcChannel, msg.SourceChannel),
+			),
+		},
+	)
+
+	return &types.MsgTransferERC20Response{}, nil
+}
+
+// ConvertCoins converts multiple Cosmos-native Coins into ERC20 tokens within
+// a single state transition. Each coin is converted through the same flow as
+// ConvertCoin and the whole batch is reverted if any of the conversions fails,
+// with an error wrapping the index of the failed coin.
+func (k Keeper) ConvertCoins(
+	goCtx context.Context,
+	msg *types.MsgConvertCoins,
+) (*types.MsgConvertCoinsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver := common.HexToAddress(msg.Receiver)
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+
+	// NOTE: the state changes of the batch are reverted with the transaction if
+	// any of the conversions fails
+	results := make([]types.ConversionResult, 0, len(msg.Coins))
+
+	for i, coin := range msg.Coins {
+		convertMsg := types.NewMsgConvertCoin(coin, receiver, sender)
+		if _, err := k.ConvertCoin(goCtx, convertMsg); err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to convert coin %d (%s)", i, coin)
+		}
+
+		// the token pair is registered as the conversion succeeded
+		pair, _ := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, coin.Denom))
+		results = append(results, types.ConversionResult{
+			Erc20Address: pair.Erc20Address,
+			Coin:         coin,
+		})
+	}
+
+	return &types.MsgConvertCoinsResponse{Results: results}, nil
+}
+
+// ConvertERC20S converts multiple ERC20 tokens into Cosmos-native Coins within
+// a single state transition. Each token is converted through the same flow as
+// ConvertERC20 and the whole batch is reverted if any of the conversions
+// fails, with an error wrapping the index of the failed token.
+func (k Keeper) ConvertERC20S(
+	goCtx context.Context,
+	msg *types.MsgConvertERC20S,
+) (*types.MsgConvertERC20SResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver, _ := sdk.AccAddressFromBech32(msg.Receiver)
+	sender := common.HexToAddress(msg.Sender)
+
+	// NOTE: the state changes of the batch are reverted with the transaction if
+	// any of the conversions fails
+	results := make([]types.ConversionResult, 0, len(msg.Tokens))
+
+	for i, token := range msg.Tokens {
+		pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, token.ContractAddress))
+		if !found {
+			return nil, sdkerrors.Wrapf(
+				types.ErrInternalTokenPair,
+				"failed to convert token %d: token '%s' not registered", i, token.ContractAddress,
+			)
+		}
+
+		balanceCoin := k.bankKeeper.GetBalance(ctx, receiver, pair.Denom)
+
+		contract := common.HexToAddress(token.ContractAddress)
+		convertMsg := types.NewMsgConvertERC20(token.Amount, receiver, contract, sender)
+		if _, err := k.ConvertERC20(goCtx, convertMsg); err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to convert token %d (%s of contract %s)", i, token.Amount, token.ContractAddress)
+		}
+
+		// NOTE: the converted amount can be less than the requested one for
+		// fee-on-transfer tokens
+		balanceCoinAfter := k.bankKeeper.GetBalance(ctx, receiver, pair.Denom)
+		results = append(results, types.ConversionResult{
+			Erc20Address: pair.Erc20Address,
+			Coin:         balanceCoinAfter.Sub(balanceCoin),
+		})
+	}
+
+	return &types.MsgConvertERC20SResponse{Results: results}, nil
+}
+
+// ConvertERC20WithPermit converts ERC20 tokens into Cosmos-native Coins on
+// behalf of their owner, who approves the module account to spend the tokens
+// with an EIP-2612 permit. The tokens are transferred to the module account
+// and then burned or escrowed depending on the owner of the token pair, and
+// the coins are sent to the account of the owner. The relayer submitting the
+// message pays the transaction fees.
+func (k Keeper) ConvertERC20WithPermit(
+	goCtx context.Context,
+	msg *types.MsgConvertERC20WithPermit,
+) (*types.MsgConvertERC20WithPermitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	owner := common.HexToAddress(msg.Owner)
+	receiver := sdk.AccAddress(owner.Bytes())
+
+	pair, err := k.MintingEnabled(ctx, receiver, receiver, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// the token pair of a destroyed contract is tombstoned by the EndBlocker
+	if !k.isContract(ctx, pair) {
+		return nil, sdkerrors.Wrapf(types.ErrTokenPairTombstone, "ERC20 contract %s has no code", pair.Erc20Address)
+	}
+
+	if !pair.IsNativeCoin() && !pair.IsNativeERC20() {
+		return nil, types.ErrUndefinedOwner
+	}
+
+	amount, _, err := tokensToCoins(pair, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ConsumeRateLimit(ctx, pair, amount, types.ConversionOutflow); err != nil {
+		return nil, err
+	}
+
+	// Transfer the tokens of the owner to the module account
+	escrowed, err := k.transferWithPermit(ctx, pair, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, fee, err := k.convertTransferredERC20(ctx, pair, owner, escrowed)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeConvertERC20WithPermit,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+				sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, msg.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+				sdk.NewAttribute(types.AttributeKeyRelayer, msg.Relayer),
+			),
+		},
+	)
+
+	return &types.MsgConvertERC20WithPermitResponse{}, nil
+}
+
+// ConvertERC20From converts ERC20 tokens into Cosmos-native Coins on behalf of
+// their owner, who approves the module account to spend the tokens. It allows
+// smart contracts, which can't sign Cosmos transactions, to convert their
+// tokens. The tokens are transferred to the module account and then burned or
+// escrowed depending on the owner of the token pair, and the coins are sent to
+// the account of the owner. The relayer submitting the message pays the
+// transaction fees.
+func (k Keeper) ConvertERC20From(
+	goCtx context.Context,
+	msg *types.MsgConvertERC20From,
+) (*types.MsgConvertERC20FromResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	owner := common.HexToAddress(msg.Owner)
+	receiver := sdk.AccAddress(owner.Bytes())
+
+	pair, err := k.MintingEnabled(ctx, receiver, receiver, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// the token pair of a destroyed contract is tombstoned by the EndBlocker
+	if !k.isContract(ctx, pair) {
+		return nil, sdkerrors.Wrapf(types.ErrTokenPairTombstone, "ERC20 contract %s has no code", pair.Erc20Address)
+	}
+
+	if !pair.IsNativeCoin() && !pair.IsNativeERC20() {
+		return nil, types.ErrUndefinedOwner
+	}
+
+	amount, _, err := tokensToCoins(pair, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ConsumeRateLimit(ctx, pair, amount, types.ConversionOutflow); err != nil {
+		return nil, err
+	}
+
+	// Transfer the approved tokens of the owner to the module account
+	escrowed, err := k.transferFromOwner(ctx, pair, owner, msg.Amount.BigInt())
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, fee, err := k.convertTransferredERC20(ctx, pair, owner, escrowed)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeConvertERC20From,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+				sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, msg.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+				sdk.NewAttribute(types.AttributeKeyRelayer, msg.Relayer),
+			),
+		},
+	)
+
+	return &types.MsgConvertERC20FromResponse{}, nil
+}
+
+// convertTransferredERC20 converts the tokens transferred from the owner to
+// the module account into Cosmos coins for the account of the owner:
+//  - Refund the tokens that can't be converted with the pair scaling
+//  - Burn the tokens of a native coin pair, as the coins were escrowed with
+//    ConvertCoin, or keep the tokens of a native ERC20 pair escrowed and mint
+//    coins within the pair mint cap
+//  - Collect the conversion fee and send the remaining coins to the owner
+//  - Check if coin balance increased by amount minus the fee
+// It returns the amount of converted tokens and the collected fee.
+func (k Keeper) convertTransferredERC20(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	owner common.Address,
+	escrowed *big.Int,
+) (sdk.Int, sdk.Int, error) {
+	receiver := sdk.AccAddress(owner.Bytes())
+	balanceCoin := k.bankKeeper.GetBalance(ctx, receiver, pair.Denom)
+
+	// Refund the tokens that can't be converted with the pair scaling
+	amount, remainder, err := tokensToCoins(pair, sdk.NewIntFromBigInt(escrowed))
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	if err := k.refundTokenRemainder(ctx, pair, owner, remainder); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	tokens := sdk.NewIntFromBigInt(escrowed).Sub(remainder)
+	coins := sdk.Coins{{Denom: pair.Denom, Amount: amount}}
+
+	fee, err := k.ComputeConversionFee(ctx, pair, coins[0].Amount)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	if pair.IsNativeCoin() {
+		// Burn the transferred tokens, the coins were escrowed with ConvertCoin
+		erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		if _, err := k.CallEVM(ctx, erc20ABI, types.ModuleAddress, pair.GetERC20Contract(), "burnCoins", types.ModuleAddress, tokens.BigInt()); err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
+		if err := k.unescrowWrappedEVMDenom(ctx, pair, coins[0].Amount); err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
+	} else {
+		// Keep the tokens escrowed and mint coins within the pair mint cap
+		if err := k.CheckMintCap(ctx, pair, coins[0].Amount); err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
+	}
+
+	// Collect the fee from the escrowed or minted coins
+	if err := k.collectConversionFee(ctx, pair, fee); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	// Send the coins to the owner
+	received := sdk.Coins{{Denom: pair.Denom, Amount: coins[0].Amount.Sub(fee)}}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, received); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	// Check expected Receiver balance after transfer execution
+	balanceCoinAfter := k.bankKeeper.GetBalance(ctx, receiver, pair.Denom)
+	expCoin := balanceCoin.Add(received[0])
+	if ok := balanceCoinAfter.IsEqual(expCoin); !ok {
+		return sdk.Int{}, sdk.Int{}, sdkerrors.Wrapf(
+			types.ErrInvalidConversionBalance,
+			"invalid coin balance - expected: %v, actual: %v",
+			expCoin, balanceCoinAfter,
+		)
+	}
+
+	k.recordConversion(ctx, pair, coins[0].Amount, types.ConversionOutflow, false)
+	return tokens, fee, nil
+}
+
+// transferWithPermit transfers the tokens of the owner to the module account
+// using the permit of the message:
+//  - Call `permit` to approve the module account to spend the amount
+//  - Check for unexpected `approve` events in logs
+//  - Transfer the approved tokens with transferFromOwner
+// It returns the amount of tokens received by the module account.
+func (k Keeper) transferWithPermit(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	msg *types.MsgConvertERC20WithPermit,
+) (*big.Int, error) {
+	contract := pair.GetERC20Contract()
+	owner := common.HexToAddress(msg.Owner)
+	amount := msg.Amount.BigInt()
+
+	// Approve the module account with the permit of the owner
+	v, r, s := msg.PermitSignature()
+	deadline := new(big.Int).SetUint64(msg.Deadline)
+	res, err := k.CallEVM(
+		ctx, contracts.ERC20PermitContract.ABI, types.ModuleAddress, contract,
+		"permit", owner, types.ModuleAddress, amount, deadline, v, r, s,
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to execute permit")
+	}
+
+	if err := k.checkConversionSafety(ctx, pair, res, newApprovalEvent(contract, owner, types.ModuleAddress, amount)); err != nil {
+		return nil, err
+	}
+
+	return k.transferFromOwner(ctx, pair, owner, amount)
+}
+
+// transferFromOwner transfers the tokens of the owner to the module account
+// within the allowance of the owner to the module account:
+//  - Check if the allowance covers the amount
+//  - Call `transferFrom` from the module account
+//  - Check if the escrow balance increased by amount
+//  - Check for unexpected `approve` events in logs
+// It returns the amount of tokens received by the module account.
+func (k Keeper) transferFromOwner(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	owner common.Address,
+	amount *big.Int,
+) (*big.Int, error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+
+	allowance, err := k.allowance(ctx, erc20, contract, owner, types.ModuleAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if allowance.Cmp(amount) < 0 {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"allowance of %s to the module account is lower than the amount - allowance: %v, amount: %v", owner, allowance, amount,
+		)
+	}
+
+	// Transfer the approved tokens to the module account
+	balanceToken := k.balanceOf(ctx, erc20, contract, types.ModuleAddress)
+	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "transferFrom", owner, types.ModuleAddress, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := transferSucceeded(pair, erc20, res)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "failed to execute transferFrom")
+	}
+
+	balanceTokenAfter := k.balanceOf(ctx, erc20, contract, types.ModuleAddress)
+	escrowed, err := checkTransferredBalance(pair, balanceToken, balanceTokenAfter, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// The allowance of the module account is spent by the transfer
+	remaining := new(big.Int).Sub(allowance, amount)
+	if err := k.checkConversionSafety(ctx, pair, res, newApprovalEvent(contract, owner, types.ModuleAddress, remaining)); err != nil {
+		return nil, err
+	}
+
+	return escrowed, nil
+}
+
 // convertCoinNativeCoin handles the Coin conversion flow for a native coin
 // token pair:
 //  - Escrow Coins on module account (Coins are not burned)
-//  - Mint Tokens and send to receiver
-//  - Check if token balance increased by amount
+//  - Collect the conversion fee from the escrowed Coins, unless exempt
+//  - Move the escrowed Coins to the contract of the wrapped EVM denom
+//  - Mint Tokens for the amount minus the fee, scaled up with the pair
+//    scaling, and send to receiver
+//  - Check if token balance increased by the minted Tokens
 func (k Keeper) convertCoinNativeCoin(
 	ctx sdk.Context,
 	pair types.TokenPair,
 	msg *types.MsgConvertCoin,
 	receiver common.Address,
 	sender sdk.AccAddress,
+	exempt bool,
 ) (*types.MsgConvertCoinResponse, error) {
 	// NOTE: ignore validation from NewCoin constructor
 	coins := sdk.Coins{msg.Coin}
@@ -120,19 +596,38 @@ func (k Keeper) convertCoinNativeCoin(
 	contract := pair.GetERC20Contract()
 	balanceToken := k.balanceOf(ctx, erc20, contract, receiver)
 
+	var err error
+	fee := sdk.ZeroInt()
+	if !exempt {
+		fee, err = k.ComputeConversionFee(ctx, pair, msg.Coin.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Escrow Coins on module account
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to escrow coins")
 	}
 
+	// Collect the fee from the escrowed Coins
+	if err := k.collectConversionFee(ctx, pair, fee); err != nil {
+		return nil, err
+	}
+
+	// Move the Coins backing the wrapped EVM denom to its contract
+	if err := k.escrowWrappedEVMDenom(ctx, pair, msg.Coin.Amount.Sub(fee)); err != nil {
+		return nil, err
+	}
+
 	// Mint Tokens and send to receiver
-	_, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "mint", receiver, msg.Coin.Amount.BigInt())
+	tokens := pair.CoinsToTokens(msg.Coin.Amount.Sub(fee)).BigInt()
+	_, err = k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "mint", receiver, tokens)
 	if err != nil {
 		return nil, err
 	}
 
 	// Check expected Receiver balance after transfer execution
-	tokens := msg.Coin.Amount.BigInt()
 	balanceTokenAfter := k.balanceOf(ctx, erc20, contract, receiver)
 	exp := big.NewInt(0).Add(balanceToken, tokens)
 	if r := balanceTokenAfter.Cmp(exp); r != 0 {
@@ -142,6 +637,8 @@ func (k Keeper) convertCoinNativeCoin(
 		)
 	}
 
+	k.recordConversion(ctx, pair, msg.Coin.Amount, types.ConversionInflow, false)
+
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
@@ -151,6 +648,7 @@ func (k Keeper) convertCoinNativeCoin(
 				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Coin.Amount.String()),
 				sdk.NewAttribute(types.AttributeKeyCosmosCoin, msg.Coin.Denom),
 				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
 			),
 		},
 	)
@@ -159,10 +657,13 @@ func (k Keeper) convertCoinNativeCoin(
 }
 
 // convertERC20NativeCoin handles the erc20 conversion flow for a native coin token pair:
-//  - Burn escrowed tokens
-//  - Unescrow coins that have been previously escrowed with ConvertCoin
-//  - Check if coin balance increased by amount
-//  - Check if token balance decreased by amount
+//  - Burn escrowed tokens, except for the remainder that can't be converted
+//    with the pair scaling
+//  - Move the escrowed coins from the contract of the wrapped EVM denom
+//  - Collect the conversion fee from the coins previously escrowed with ConvertCoin
+//  - Unescrow the remaining coins and send to receiver
+//  - Check if coin balance increased by the scaled amount minus the fee
+//  - Check if token balance decreased by the burned tokens
 func (k Keeper) convertERC20NativeCoin(
 	ctx sdk.Context,
 	pair types.TokenPair,
@@ -170,20 +671,42 @@ func (k Keeper) convertERC20NativeCoin(
 	receiver sdk.AccAddress,
 	sender common.Address,
 ) (*types.MsgConvertERC20Response, error) {
+	amount, remainder, err := tokensToCoins(pair, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := k.ComputeConversionFee(ctx, pair, amount)
+	if err != nil {
+		return nil, err
+	}
+
 	// NOTE: coin fields already validated
-	coins := sdk.Coins{sdk.Coin{Denom: pair.Denom, Amount: msg.Amount}}
+	coins := sdk.Coins{sdk.Coin{Denom: pair.Denom, Amount: amount.Sub(fee)}}
 
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
 	contract := pair.GetERC20Contract()
 	balanceCoin := k.bankKeeper.GetBalance(ctx, receiver, pair.Denom)
 	balanceToken := k.balanceOf(ctx, erc20, contract, sender)
 
-	// Burn escrowed tokens
-	_, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "burnCoins", sender, msg.Amount.BigInt())
+	// Burn escrowed tokens. The remainder is not burned and stays on the sender
+	// balance.
+	tokens := msg.Amount.Sub(remainder).BigInt()
+	_, err = k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "burnCoins", sender, tokens)
 	if err != nil {
 		return nil, err
 	}
 
+	// Move the Coins backing the wrapped EVM denom back from its contract
+	if err := k.unescrowWrappedEVMDenom(ctx, pair, amount); err != nil {
+		return nil, err
+	}
+
+	// Collect the fee from the escrowed Coins
+	if err := k.collectConversionFee(ctx, pair, fee); err != nil {
+		return nil, err
+	}
+
 	// Unescrow Coins and send to receiver
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
 		return nil, err
@@ -200,7 +723,6 @@ func (k Keeper) convertERC20NativeCoin(
 	}
 
 	// Check expected Sender balance after transfer execution
-	tokens := coins[0].Amount.BigInt()
 	balanceTokenAfter := k.balanceOf(ctx, erc20, contract, sender)
 	expToken := big.NewInt(0).Sub(balanceToken, tokens)
 	if r := balanceTokenAfter.Cmp(expToken); r != 0 {
@@ -211,15 +733,18 @@ func (k Keeper) convertERC20NativeCoin(
 		)
 	}
 
+	k.recordConversion(ctx, pair, amount, types.ConversionOutflow, false)
+
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
 				types.EventTypeConvertERC20,
 				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
 				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
-				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewIntFromBigInt(tokens).String()),
 				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
 				sdk.NewAttribute(types.AttributeKeyERC20Token, msg.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
 			),
 		},
 	)
@@ -229,9 +754,12 @@ func (k Keeper) convertERC20NativeCoin(
 
 // convertERC20NativeToken handles the erc20 conversion flow for a native erc20 token pair:
 //  - Escrow tokens on module account (Don't burn as module is not contract owner)
-//  - Mint coins on module
-//  - Send minted coins to the receiver
-//  - Check if coin balance increased by amount
+//  - Refund the escrowed tokens that can't be converted with the pair scaling
+//  - Mint coins on module for the escrowed tokens, scaled down with the pair
+//    scaling
+//  - Collect the conversion fee from the minted coins
+//  - Send the remaining minted coins to the receiver
+//  - Check if coin balance increased by amount minus the fee
 //  - Check if token balance decreased by amount
 //  - Check for unexpected `appove` event in logs
 func (k Keeper) convertERC20NativeToken(
@@ -241,8 +769,6 @@ func (k Keeper) convertERC20NativeToken(
 	receiver sdk.AccAddress,
 	sender common.Address,
 ) (*types.MsgConvertERC20Response, error) {
-	// NOTE: coin fields already validated
-	coins := sdk.Coins{sdk.Coin{Denom: pair.Denom, Amount: msg.Amount}}
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
 	contract := pair.GetERC20Contract()
 	balanceCoin := k.bankKeeper.GetBalance(ctx, receiver, pair.Denom)
@@ -259,40 +785,63 @@ func (k Keeper) convertERC20NativeToken(
 	}
 
 	// Check unpackedRet execution
-	var unpackedRet types.ERC20BoolResponse
-	if err := erc20.UnpackIntoInterface(&unpackedRet, "transfer", res.Ret); err != nil {
+	ok, err := transferSucceeded(pair, erc20, res)
+	if err != nil {
 		return nil, err
 	}
 
-	if !unpackedRet.Value {
+	if !ok {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "failed to execute transfer")
 	}
 
-	// Check expected escrow balance after transfer execution
-	tokens := coins[0].Amount.BigInt()
+	// Check expected escrow balance after transfer execution. Coins are minted
+	// for the tokens actually escrowed, which can be less than the transferred
+	// amount for fee-on-transfer tokens.
 	balanceTokenAfter := k.balanceOf(ctx, erc20, contract, types.ModuleAddress)
-	expToken := big.NewInt(0).Add(balanceToken, tokens)
-	if r := balanceTokenAfter.Cmp(expToken); r != 0 {
-		return nil, sdkerrors.Wrapf(
-			types.ErrInvalidConversionBalance,
-			"invalid token balance - expected: %v, actual: %v",
-			expToken, balanceTokenAfter,
-		)
+	escrowed, err := checkTransferredBalance(pair, balanceToken, balanceTokenAfter, msg.Amount.BigInt())
+	if err != nil {
+		return nil, err
 	}
 
-	// Mint coins
+	// Refund the escrowed tokens that can't be converted with the pair scaling
+	amount, remainder, err := tokensToCoins(pair, sdk.NewIntFromBigInt(escrowed))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.refundTokenRemainder(ctx, pair, sender, remainder); err != nil {
+		return nil, err
+	}
+	tokens := sdk.NewIntFromBigInt(escrowed).Sub(remainder)
+	coins := sdk.Coins{{Denom: pair.Denom, Amount: amount}}
+
+	fee, err := k.ComputeConversionFee(ctx, pair, coins[0].Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mint coins within the pair mint cap
+	if err := k.CheckMintCap(ctx, pair, coins[0].Amount); err != nil {
+		return nil, err
+	}
 	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
 		return nil, err
 	}
 
+	// Collect the fee from the minted coins
+	if err := k.collectConversionFee(ctx, pair, fee); err != nil {
+		return nil, err
+	}
+
 	// Send minted coins to the receiver
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+	received := sdk.Coins{{Denom: pair.Denom, Amount: coins[0].Amount.Sub(fee)}}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, received); err != nil {
 		return nil, err
 	}
 
 	// Check expected Receiver balance after transfer execution
 	balanceCoinAfter := k.bankKeeper.GetBalance(ctx, receiver, pair.Denom)
-	expCoin := balanceCoin.Add(coins[0])
+	expCoin := balanceCoin.Add(received[0])
 	if ok := balanceCoinAfter.IsEqual(expCoin); !ok {
 		return nil, sdkerrors.Wrapf(
 			types.ErrInvalidConversionBalance,
@@ -301,20 +850,23 @@ func (k Keeper) convertERC20NativeToken(
 		)
 	}
 
-	// Check for unexpected `appove` event in logs
-	if err := k.monitorApprovalEvent(res); err != nil {
+	// Check the logs against the safety policy of the token pair
+	if err := k.checkConversionSafety(ctx, pair, res); err != nil {
 		return nil, err
 	}
 
+	k.recordConversion(ctx, pair, coins[0].Amount, types.ConversionOutflow, false)
+
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
 				types.EventTypeConvertERC20,
 				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
 				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
-				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
 				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
 				sdk.NewAttribute(types.AttributeKeyERC20Token, msg.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
 			),
 		},
 	)
@@ -325,9 +877,12 @@ func (k Keeper) convertERC20NativeToken(
 // convertCoinNativeERC20 handles the Coin conversion flow for a native ERC20
 // token pair:
 //  - Escrow Coins on module account
-//  - Unescrow Tokens that have been previously escrowed with ConvertERC20 and send to receiver
-//  - Burn escrowed Coins
-//  - Check if token balance increased by amount
+//  - Collect the conversion fee from the escrowed Coins, unless exempt
+//  - Unescrow Tokens that have been previously escrowed with ConvertERC20 for
+//    the amount minus the fee, scaled up with the pair scaling, and send to
+//    receiver
+//  - Burn the remaining escrowed Coins
+//  - Check if token balance increased by amount minus the fee
 //  - Check for unexpected `appove` event in logs
 func (k Keeper) convertCoinNativeERC20(
 	ctx sdk.Context,
@@ -335,6 +890,7 @@ func (k Keeper) convertCoinNativeERC20(
 	msg *types.MsgConvertCoin,
 	receiver common.Address,
 	sender sdk.AccAddress,
+	exempt bool,
 ) (*types.MsgConvertCoinResponse, error) {
 	// NOTE: ignore validation from NewCoin constructor
 	coins := sdk.Coins{msg.Coin}
@@ -343,36 +899,47 @@ func (k Keeper) convertCoinNativeERC20(
 	contract := pair.GetERC20Contract()
 	balanceToken := k.balanceOf(ctx, erc20, contract, receiver)
 
+	var err error
+	fee := sdk.ZeroInt()
+	if !exempt {
+		fee, err = k.ComputeConversionFee(ctx, pair, msg.Coin.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Escrow Coins on module account
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to escrow coins")
 	}
 
+	// Collect the fee from the escrowed Coins
+	if err := k.collectConversionFee(ctx, pair, fee); err != nil {
+		return nil, err
+	}
+	coins = sdk.Coins{{Denom: pair.Denom, Amount: msg.Coin.Amount.Sub(fee)}}
+
 	// Unescrow Tokens and send to receiver
-	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "transfer", receiver, msg.Coin.Amount.BigInt())
+	tokens := pair.CoinsToTokens(coins[0].Amount).BigInt()
+	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "transfer", receiver, tokens)
 	if err != nil {
 		return nil, err
 	}
 
 	// Check unpackedRet execution
-	var unpackedRet types.ERC20BoolResponse
-	if err := erc20.UnpackIntoInterface(&unpackedRet, "transfer", res.Ret); err != nil {
+	ok, err := transferSucceeded(pair, erc20, res)
+	if err != nil {
 		return nil, err
 	}
 
-	if !unpackedRet.Value {
+	if !ok {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "failed to execute unescrow tokens from user")
 	}
 
 	// Check expected Receiver balance after transfer execution
-	tokens := msg.Coin.Amount.BigInt()
 	balanceTokenAfter := k.balanceOf(ctx, erc20, contract, receiver)
-	exp := big.NewInt(0).Add(balanceToken, tokens)
-	if r := balanceTokenAfter.Cmp(exp); r != 0 {
-		return nil, sdkerrors.Wrapf(
-			types.ErrInvalidConversionBalance,
-			"invalid token balance - expected: %v, actual: %v", exp, balanceTokenAfter,
-		)
+	if _, err := checkTransferredBalance(pair, balanceToken, balanceTokenAfter, tokens); err != nil {
+		return nil, err
 	}
 
 	// Burn escrowed Coins
@@ -381,11 +948,13 @@ func (k Keeper) convertCoinNativeERC20(
 		return nil, sdkerrors.Wrap(err, "failed to burn coins")
 	}
 
-	// Check for unexpected `appove` event in logs
-	if err := k.monitorApprovalEvent(res); err != nil {
+	// Check the logs against the safety policy of the token pair
+	if err := k.checkConversionSafety(ctx, pair, res); err != nil {
 		return nil, err
 	}
 
+	k.recordConversion(ctx, pair, msg.Coin.Amount, types.ConversionInflow, false)
+
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
@@ -395,6 +964,7 @@ func (k Keeper) convertCoinNativeERC20(
 				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Coin.Amount.String()),
 				sdk.NewAttribute(types.AttributeKeyCosmosCoin, msg.Coin.Denom),
 				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
 			),
 		},
 	)
@@ -426,22 +996,172 @@ func (k Keeper) balanceOf(
 	return balance
 }
 
-// monitorApprovalEvent returns an error if the given transactions logs include
-// an unexpected `approve` event
-func (k Keeper) monitorApprovalEvent(res *evmtypes.MsgEthereumTxResponse) error {
-	if res == nil || len(res.Logs) == 0 {
-		return nil
+// allowance queries the amount of tokens of an owner that a spender is allowed
+// to transfer for a given ERC20 contract
+func (k Keeper) allowance(
+	ctx sdk.Context,
+	abi abi.ABI,
+	contract, owner, spender common.Address,
+) (*big.Int, error) {
+	res, err := k.CallEVM(ctx, abi, types.ModuleAddress, contract, "allowance", owner, spender)
+	if err != nil {
+		return nil, err
 	}
 
-	logApprovalSig := []byte("Approval(address,address,uint256)")
-	logApprovalSigHash := crypto.Keccak256Hash(logApprovalSig)
+	var allowanceRes types.ERC20Uint256Response
+	if err := abi.UnpackIntoInterface(&allowanceRes, "allowance", res.Ret); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to unpack allowance: %s", err.Error())
+	}
 
-	for _, log := range res.Logs {
-		if log.Topics[0] == logApprovalSigHash.Hex() {
-			return sdkerrors.Wrapf(
-				types.ErrUnexpectedEvent, "unexpected approval event",
-			)
-		}
+	return allowanceRes.Value, nil
+}
+
+// transferSucceeded returns true if the response of an ERC20 transfer signals
+// a successful transfer. The transfer of tokens that don't return a value (eg:
+// USDT) is successful if no data is returned.
+func transferSucceeded(pair types.TokenPair, erc20 abi.ABI, res *evmtypes.MsgEthereumTxResponse) (bool, error) {
+	if len(res.Ret) == 0 && pair.AcceptsEmptyTransferReturn() {
+		return true, nil
 	}
-	return nil
+
+	var unpackedRet types.ERC20BoolResponse
+	if err := erc20.UnpackIntoInterface(&unpackedRet, "transfer", res.Ret); err != nil {
+		return false, err
+	}
+
+	return unpackedRet.Value, nil
+}
+
+// checkTransferredBalance returns the amount of tokens received by an account
+// on the transfer of the given amount. Fee-on-transfer tokens can deliver less
+// than the transferred amount, while other tokens must deliver the exact
+// amount. Balances that increase by more than the transferred amount are
+// rejected, as rebasing tokens are not supported.
+func checkTransferredBalance(pair types.TokenPair, before, after, amount *big.Int) (*big.Int, error) {
+	if before == nil || after == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidConversionBalance, "failed to query token balance")
+	}
+
+	received := new(big.Int).Sub(after, before)
+
+	switch {
+	case received.Cmp(amount) > 0:
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidConversionBalance,
+			"invalid token balance - received %v tokens, more than the transferred %v: rebasing tokens are not supported",
+			received, amount,
+		)
+	case pair.IsFeeOnTransfer() && received.Sign() > 0:
+		return received, nil
+	case received.Cmp(amount) != 0:
+		exp := new(big.Int).Add(before, amount)
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidConversionBalance,
+			"invalid token balance - expected: %v, actual: %v", exp, after,
+		)
+	}
+
+	return received, nil
+}
+
+// RegisterERC20Permissionless registers a token pair for an existing ERC20
+// contract without a governance proposal. The sender locks the registration
+// deposit and the token pair is activated after the challenge period unless
+// governance vetoes it.
+func (k Keeper) RegisterERC20Permissionless(
+	goCtx context.Context,
+	msg *types.MsgRegisterERC20,
+) (*types.MsgRegisterERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	contract := common.HexToAddress(msg.ContractAddress)
+
+	pair, registration, err := k.SubmitERC20Registration(ctx, sender, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSubmitRegistration,
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyBehaviour, pair.Behaviour.String()),
+				sdk.NewAttribute(types.AttributeKeyScalingExponent, strconv.FormatUint(uint64(pair.ScalingExponent), 10)),
+				sdk.NewAttribute(types.AttributeKeyRegistrant, registration.Registrant),
+				sdk.NewAttribute(types.AttributeKeyDeposit, registration.Deposit.String()),
+				sdk.NewAttribute(types.AttributeKeyActivationTime, registration.ActivationTime.String()),
+			),
+		},
+	)
+
+	return &types.MsgRegisterERC20Response{
+		Denom:          pair.Denom,
+		ActivationTime: registration.ActivationTime,
+	}, nil
+}
+
+// PausePair pauses the conversions of a token pair until the pause expires or
+// governance lifts it. Only the guardian can pause a token pair.
+func (k Keeper) PausePair(
+	goCtx context.Context,
+	msg *types.MsgPausePair,
+) (*types.MsgPausePairResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	guardian, _ := sdk.AccAddressFromBech32(msg.Guardian)
+
+	pair, pause, err := k.PauseTokenPair(ctx, guardian, msg.Token, msg.Reason, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypePausePair,
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyGuardian, msg.Guardian),
+				sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+				sdk.NewAttribute(types.AttributeKeyExpiry, pause.Expiry.String()),
+			),
+		},
+	)
+
+	return &types.MsgPausePairResponse{Expiry: pause.Expiry}, nil
+}
+
+// PauseModule pauses the conversions of all the token pairs until the pause
+// expires or governance lifts it. Only the guardian can pause the module.
+func (k Keeper) PauseModule(
+	goCtx context.Context,
+	msg *types.MsgPauseModule,
+) (*types.MsgPauseModuleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	guardian, _ := sdk.AccAddressFromBech32(msg.Guardian)
+
+	pause, err := k.PauseAllTokenPairs(ctx, guardian, msg.Reason, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypePauseModule,
+				sdk.NewAttribute(types.AttributeKeyGuardian, msg.Guardian),
+				sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+				sdk.NewAttribute(types.AttributeKeyExpiry, pause.Expiry.String()),
+			),
+		},
+	)
+
+	return &types.MsgPauseModuleResponse{Expiry: pause.Expiry}, nil
 }
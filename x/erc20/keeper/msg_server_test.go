@@ -1,15 +1,25 @@
 package keeper_test
 
 import (
+	"crypto/ecdsa"
 	"fmt"
 	"math/big"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tharsis/ethermint/tests"
+	evm "github.com/tharsis/ethermint/x/evm/types"
 
+	"github.com/tharsis/evmos/x/erc20/keeper"
 	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
 	inflationtypes "github.com/tharsis/evmos/x/inflation/types"
 )
 
@@ -25,7 +35,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 		{"ok - sufficient funds", 100, 10, func(common.Address) {}, true, false},
 		{"ok - equal funds", 10, 10, func(common.Address) {}, true, false},
 		{
-			"ok - suicided contract",
+			"fail - suicided contract",
 			10,
 			10,
 			func(erc20 common.Address) {
@@ -34,7 +44,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 				suite.Require().True(ok)
 				suite.Require().NoError(stateDb.Commit())
 			},
-			true,
+			false,
 			true,
 		},
 		{"fail - insufficient funds", 0, 10, func(common.Address) {}, false, false},
@@ -100,6 +110,9 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 				}
 			} else {
 				suite.Require().Error(err, tc.name)
+				if tc.selfdestructed {
+					suite.Require().ErrorIs(err, types.ErrTokenPairTombstone)
+				}
 			}
 		})
 	}
@@ -214,7 +227,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 			false,
 		},
 		{
-			"ok - suicided contract",
+			"fail - suicided contract",
 			10,
 			10,
 			func(erc20 common.Address) {
@@ -224,7 +237,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				suite.Require().NoError(stateDb.Commit())
 			},
 			contractMinterBurner,
-			true,
+			false,
 			true,
 		},
 		{
@@ -319,6 +332,9 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				}
 			} else {
 				suite.Require().Error(err, tc.name)
+				if tc.selfdestructed {
+					suite.Require().ErrorIs(err, types.ErrTokenPairTombstone)
+				}
 			}
 		})
 	}
@@ -432,6 +448,110 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 	suite.mintFeeCollector = false
 }
 
+func (suite *KeeperTestSuite) TestConvertNonStandardERC20() {
+	testCases := []struct {
+		name         string
+		contractType int
+		behaviour    types.TokenBehaviour
+		mint         int64
+		transfer     int64
+		expMinted    int64
+		expPass      bool
+	}{
+		{
+			"fail - missing return value with standard behaviour",
+			contractNoReturn,
+			types.TOKEN_BEHAVIOUR_STANDARD,
+			100,
+			10,
+			0,
+			false,
+		},
+		{
+			"ok - missing return value with safe transfer behaviour",
+			contractNoReturn,
+			types.TOKEN_BEHAVIOUR_SAFE_TRANSFER,
+			100,
+			10,
+			10,
+			true,
+		},
+		{
+			"fail - fee on transfer with standard behaviour",
+			contractDirectBalanceManipulation,
+			types.TOKEN_BEHAVIOUR_STANDARD,
+			100,
+			10,
+			0,
+			false,
+		},
+		{
+			"ok - fee on transfer mints the escrowed amount",
+			contractDirectBalanceManipulation,
+			types.TOKEN_BEHAVIOUR_FEE_ON_TRANSFER,
+			100,
+			10,
+			5,
+			true,
+		},
+		{
+			"fail - rebasing token with standard behaviour",
+			contractRebasing,
+			types.TOKEN_BEHAVIOUR_STANDARD,
+			100,
+			10,
+			0,
+			false,
+		},
+		{
+			"fail - rebasing token with fee on transfer behaviour",
+			contractRebasing,
+			types.TOKEN_BEHAVIOUR_FEE_ON_TRANSFER,
+			100,
+			10,
+			0,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			contractAddr, err := suite.setupRegisterERC20PairWithBehaviour(tc.contractType, tc.behaviour)
+			suite.Require().NoError(err)
+
+			coinName := types.CreateDenom(contractAddr.String())
+			sender := sdk.AccAddress(suite.address.Bytes())
+
+			suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(tc.mint))
+			suite.Commit()
+
+			msg := types.NewMsgConvertERC20(sdk.NewInt(tc.transfer), sender, contractAddr, suite.address)
+			_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Commit()
+
+			cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinName)
+			if !tc.expPass {
+				suite.Require().Error(err, tc.name)
+				suite.Require().True(cosmosBalance.Amount.IsZero())
+				return
+			}
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(tc.expMinted, cosmosBalance.Amount.Int64())
+
+			// Convert all the minted coins back to ERC20s
+			coinMsg := types.NewMsgConvertCoin(sdk.NewCoin(coinName, cosmosBalance.Amount), suite.address, sender)
+			_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), coinMsg)
+			suite.Require().NoError(err, tc.name)
+			suite.Commit()
+
+			cosmosBalance = suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinName)
+			suite.Require().True(cosmosBalance.Amount.IsZero())
+			escrow := suite.BalanceOf(contractAddr, types.ModuleAddress)
+			suite.Require().Equal(int64(0), escrow.(*big.Int).Int64())
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestConvertNativeIBC() {
 	suite.SetupTest()
 	base := "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2"
@@ -454,7 +574,721 @@ func (suite *KeeperTestSuite) TestConvertNativeIBC() {
 	err := suite.app.BankKeeper.MintCoins(suite.ctx, inflationtypes.ModuleName, sdk.Coins{sdk.NewInt64Coin(base, 1)})
 	suite.Require().NoError(err)
 
-	_, err = suite.app.Erc20Keeper.RegisterCoin(suite.ctx, validMetadata)
+	_, err = suite.app.Erc20Keeper.RegisterCoin(suite.ctx, validMetadata, 0, 0)
+	suite.Require().NoError(err)
+	suite.Commit()
+}
+
+func (suite *KeeperTestSuite) TestTransferERC20() {
+	testCases := []struct {
+		name     string
+		mint     int64
+		transfer int64
+		channel  string
+		err      error
+		expPass  bool
+	}{
+		{"ok - sufficient funds", 100, 10, ibcChannel, nil, true},
+		{"ok - equal funds", 10, 10, ibcChannel, nil, true},
+		{"fail - insufficient funds", 0, 10, ibcChannel, nil, false},
+		{"fail - channel not found", 100, 10, "channel-1", nil, false},
+		{"fail - transfer failed", 100, 10, ibcChannel, fmt.Errorf("transfer failed"), false},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+			suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(tc.mint))
+			suite.Commit()
+
+			k := suite.erc20KeeperWithTransfer(mockTransferKeeper{bankKeeper: suite.app.BankKeeper, err: tc.err})
+			suite.app.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.ctx, transfertypes.PortID, ibcChannel, 1)
+
+			msg := types.NewMsgTransferERC20(
+				sdk.NewInt(tc.transfer), contractAddr, suite.address,
+				transfertypes.PortID, tc.channel, "cosmos1receiver",
+				clienttypes.NewHeight(0, 100), 0,
+			)
+			res, err := k.TransferERC20(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Commit()
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(&types.MsgTransferERC20Response{}, res)
+
+				sender := sdk.AccAddress(suite.address.Bytes())
+				coinName := types.CreateDenom(contractAddr.String())
+				escrow := suite.app.AccountKeeper.GetModuleAddress(transfertypes.ModuleName)
+
+				balance := suite.BalanceOf(contractAddr, suite.address)
+				suite.Require().Equal(tc.mint-tc.transfer, balance.(*big.Int).Int64())
+				suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinName).IsZero())
+				suite.Require().Equal(sdk.NewInt(tc.transfer), suite.app.BankKeeper.GetBalance(suite.ctx, escrow, coinName).Amount)
+
+				contract, found := k.GetERC20Transfer(suite.ctx, transfertypes.PortID, tc.channel, 1)
+				suite.Require().True(found)
+				suite.Require().Equal(contractAddr, contract)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+// setupBatchConversion registers a native coin token pair and a native ERC20
+// token pair and funds the sender with 100 coins of the native coin pair and
+// 100 coins and 100 tokens of the native ERC20 pair
+func (suite *KeeperTestSuite) setupBatchConversion() (types.TokenPair, types.TokenPair) {
+	_, coinPair := suite.setupRegisterCoin()
+	suite.Require().NotNil(coinPair)
+
+	contractAddr := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	suite.Commit()
+	erc20Pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOUR_STANDARD, 0, 0, nil)
+	suite.Require().NoError(err)
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coins := sdk.NewCoins(sdk.NewCoin(coinPair.Denom, sdk.NewInt(100)))
+	suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+	suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins)
+
+	suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(200))
+	suite.Commit()
+
+	msg := types.NewMsgConvertERC20(sdk.NewInt(100), sender, contractAddr, suite.address)
+	_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
 	suite.Require().NoError(err)
 	suite.Commit()
+
+	return *coinPair, *erc20Pair
+}
+
+func (suite *KeeperTestSuite) TestConvertCoins() {
+	testCases := []struct {
+		name       string
+		coinAmt    int64
+		erc20Amt   int64
+		extraDenom string
+		expPass    bool
+		expIndex   int
+	}{
+		{"ok - native coin and native ERC20 pairs", 10, 20, "", true, 0},
+		{"ok - equal funds", 100, 100, "", true, 0},
+		{"fail - insufficient funds on second entry", 10, 101, "", false, 1},
+		{"fail - unregistered denom", 10, 20, "bcoin", false, 1},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			coinPair, erc20Pair := suite.setupBatchConversion()
+			sender := sdk.AccAddress(suite.address.Bytes())
+
+			coins := sdk.NewCoins(
+				sdk.NewCoin(coinPair.Denom, sdk.NewInt(tc.coinAmt)),
+				sdk.NewCoin(erc20Pair.Denom, sdk.NewInt(tc.erc20Amt)),
+			)
+			if tc.extraDenom != "" {
+				extra := sdk.NewCoins(sdk.NewCoin(tc.extraDenom, sdk.NewInt(10)))
+				suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, extra)
+				suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, extra)
+				coins = coins.Add(extra...)
+			}
+
+			// the state changes are reverted with the transaction if the
+			// message fails
+			cacheCtx, writeCache := suite.ctx.CacheContext()
+			msg := types.NewMsgConvertCoins(coins, suite.address, sender)
+			res, err := suite.app.Erc20Keeper.ConvertCoins(sdk.WrapSDKContext(cacheCtx), msg)
+			if err == nil {
+				writeCache()
+			}
+			events := cacheCtx.EventManager().Events()
+			suite.Commit()
+
+			coinBalance := suite.BalanceOf(coinPair.GetERC20Contract(), suite.address)
+			erc20Balance := suite.BalanceOf(erc20Pair.GetERC20Contract(), suite.address)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Len(res.Results, 2)
+
+				convertEvents := 0
+				for _, event := range events {
+					if event.Type == types.EventTypeConvertCoin {
+						convertEvents++
+					}
+				}
+				suite.Require().Equal(2, convertEvents)
+
+				for _, result := range res.Results {
+					switch result.Coin.Denom {
+					case coinPair.Denom:
+						suite.Require().Equal(coinPair.Erc20Address, result.Erc20Address)
+					case erc20Pair.Denom:
+						suite.Require().Equal(erc20Pair.Erc20Address, result.Erc20Address)
+					default:
+						suite.FailNow("unexpected result denom", result.Coin.Denom)
+					}
+				}
+
+				suite.Require().Equal(tc.coinAmt, coinBalance.(*big.Int).Int64())
+				suite.Require().Equal(100+tc.erc20Amt, erc20Balance.(*big.Int).Int64())
+				suite.Require().Equal(100-tc.coinAmt, suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinPair.Denom).Amount.Int64())
+				suite.Require().Equal(100-tc.erc20Amt, suite.app.BankKeeper.GetBalance(suite.ctx, sender, erc20Pair.Denom).Amount.Int64())
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Contains(err.Error(), fmt.Sprintf("failed to convert coin %d", tc.expIndex))
+
+				// no conversion of the batch is committed
+				suite.Require().Equal(int64(0), coinBalance.(*big.Int).Int64())
+				suite.Require().Equal(int64(100), erc20Balance.(*big.Int).Int64())
+				suite.Require().Equal(int64(100), suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinPair.Denom).Amount.Int64())
+				suite.Require().Equal(int64(100), suite.app.BankKeeper.GetBalance(suite.ctx, sender, erc20Pair.Denom).Amount.Int64())
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20S() {
+	testCases := []struct {
+		name     string
+		coinAmt  int64
+		erc20Amt int64
+		extra    bool
+		expPass  bool
+		expIndex int
+	}{
+		{"ok - native coin and native ERC20 pairs", 10, 20, false, true, 0},
+		{"ok - equal funds", 50, 100, false, true, 0},
+		{"fail - insufficient funds on second entry", 10, 101, false, false, 1},
+		{"fail - unregistered contract", 10, 20, true, false, 2},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			coinPair, erc20Pair := suite.setupBatchConversion()
+			sender := sdk.AccAddress(suite.address.Bytes())
+
+			// Precondition: Convert Coins to ERC20
+			convertMsg := types.NewMsgConvertCoin(sdk.NewCoin(coinPair.Denom, sdk.NewInt(50)), suite.address, sender)
+			_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), convertMsg)
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			tokens := []types.ERC20Amount{
+				types.NewERC20Amount(coinPair.GetERC20Contract(), sdk.NewInt(tc.coinAmt)),
+				types.NewERC20Amount(erc20Pair.GetERC20Contract(), sdk.NewInt(tc.erc20Amt)),
+			}
+			if tc.extra {
+				tokens = append(tokens, types.NewERC20Amount(tests.GenerateAddress(), sdk.NewInt(10)))
+			}
+
+			// the state changes are reverted with the transaction if the
+			// message fails
+			cacheCtx, writeCache := suite.ctx.CacheContext()
+			msg := types.NewMsgConvertERC20S(tokens, sender, suite.address)
+			res, err := suite.app.Erc20Keeper.ConvertERC20S(sdk.WrapSDKContext(cacheCtx), msg)
+			if err == nil {
+				writeCache()
+			}
+			suite.Commit()
+
+			coinBalance := suite.BalanceOf(coinPair.GetERC20Contract(), suite.address)
+			erc20Balance := suite.BalanceOf(erc20Pair.GetERC20Contract(), suite.address)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(
+					[]types.ConversionResult{
+						{Erc20Address: coinPair.Erc20Address, Coin: sdk.NewCoin(coinPair.Denom, sdk.NewInt(tc.coinAmt))},
+						{Erc20Address: erc20Pair.Erc20Address, Coin: sdk.NewCoin(erc20Pair.Denom, sdk.NewInt(tc.erc20Amt))},
+					},
+					res.Results,
+				)
+
+				suite.Require().Equal(50-tc.coinAmt, coinBalance.(*big.Int).Int64())
+				suite.Require().Equal(100-tc.erc20Amt, erc20Balance.(*big.Int).Int64())
+				suite.Require().Equal(50+tc.coinAmt, suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinPair.Denom).Amount.Int64())
+				suite.Require().Equal(100+tc.erc20Amt, suite.app.BankKeeper.GetBalance(suite.ctx, sender, erc20Pair.Denom).Amount.Int64())
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Contains(err.Error(), fmt.Sprintf("failed to convert token %d", tc.expIndex))
+
+				// no conversion of the batch is committed
+				suite.Require().Equal(int64(50), coinBalance.(*big.Int).Int64())
+				suite.Require().Equal(int64(100), erc20Balance.(*big.Int).Int64())
+				suite.Require().Equal(int64(50), suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinPair.Denom).Amount.Int64())
+				suite.Require().Equal(int64(100), suite.app.BankKeeper.GetBalance(suite.ctx, sender, erc20Pair.Denom).Amount.Int64())
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+// permitNonce returns the EIP-2612 nonce of the owner
+func (suite *KeeperTestSuite) permitNonce(contract, owner common.Address) *big.Int {
+	permit := contracts.ERC20PermitContract.ABI
+
+	res, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, permit, types.ModuleAddress, contract, "nonces", owner)
+	suite.Require().NoError(err)
+
+	unpacked, err := permit.Unpack("nonces", res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Len(unpacked, 1)
+
+	nonce, ok := unpacked[0].(*big.Int)
+	suite.Require().True(ok)
+	return nonce
+}
+
+// signPermit signs an EIP-2612 permit for the erc20 module account, following
+// the EIP-712 encoding of the OpenZeppelin ERC20Permit contract
+func (suite *KeeperTestSuite) signPermit(key *ecdsa.PrivateKey, contract, owner common.Address, value *big.Int, deadline uint64) []byte {
+	word := func(v *big.Int) []byte {
+		return common.LeftPadBytes(v.Bytes(), 32)
+	}
+
+	domainSeparator := crypto.Keccak256(
+		crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256([]byte(suite.NameOf(contract))),
+		crypto.Keccak256([]byte("1")),
+		word(suite.app.EvmKeeper.ChainID()),
+		common.LeftPadBytes(contract.Bytes(), 32),
+	)
+
+	structHash := crypto.Keccak256(
+		crypto.Keccak256([]byte("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)")),
+		common.LeftPadBytes(owner.Bytes(), 32),
+		common.LeftPadBytes(types.ModuleAddress.Bytes(), 32),
+		word(value),
+		word(suite.permitNonce(contract, owner)),
+		word(new(big.Int).SetUint64(deadline)),
+	)
+
+	signature, err := crypto.Sign(crypto.Keccak256([]byte("\x19\x01"), domainSeparator, structHash), key)
+	suite.Require().NoError(err)
+	return signature
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20WithPermit() {
+	var (
+		contract     common.Address
+		signer       *ecdsa.PrivateKey
+		amount       *big.Int
+		permitAmount *big.Int
+		deadline     uint64
+		tamper       func([]byte)
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"ok",
+			func() {},
+			true,
+		},
+		{
+			"ok - signature with 27/28 recovery id",
+			func() {
+				tamper = func(signature []byte) {
+					signature[crypto.RecoveryIDOffset] += 27
+				}
+			},
+			true,
+		},
+		{
+			"ok - long token name",
+			func() {
+				// long strings are stored at keccak256(slot) with twice their length
+				// plus one on the slot
+				name := strings.Repeat("long token name ", 5)
+				slot := common.BigToHash(big.NewInt(5))
+				suite.app.EvmKeeper.SetState(suite.ctx, contract, slot, big.NewInt(int64(2*len(name)+1)).Bytes())
+				data := crypto.Keccak256Hash(slot.Bytes()).Big()
+				for i := 0; i < len(name); i += 32 {
+					word := common.RightPadBytes([]byte(name[i:]), 32)[:32]
+					key := common.BigToHash(new(big.Int).Add(data, big.NewInt(int64(i/32))))
+					suite.app.EvmKeeper.SetState(suite.ctx, contract, key, word)
+				}
+				suite.Require().Equal(name, suite.NameOf(contract))
+			},
+			true,
+		},
+		{
+			"fail - expired deadline",
+			func() {
+				deadline = uint64(suite.ctx.BlockTime().Unix()) - 1
+			},
+			false,
+		},
+		{
+			"fail - signed by another account",
+			func() {
+				key, err := crypto.GenerateKey()
+				suite.Require().NoError(err)
+				signer = key
+			},
+			false,
+		},
+		{
+			"fail - amount greater than the permit",
+			func() {
+				amount = big.NewInt(20)
+			},
+			false,
+		},
+		{
+			"fail - malleable signature",
+			func() {
+				tamper = func(signature []byte) {
+					s := new(big.Int).SetBytes(signature[32:64])
+					s.Sub(crypto.S256().Params().N, s)
+					copy(signature[32:64], common.LeftPadBytes(s.Bytes(), 32))
+					signature[crypto.RecoveryIDOffset] ^= 1
+				}
+			},
+			false,
+		},
+		{
+			"fail - insufficient funds",
+			func() {
+				amount = big.NewInt(200)
+				permitAmount = big.NewInt(200)
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			_, pair := suite.setupRegisterCoin()
+			suite.Require().NotNil(pair)
+			contract = pair.GetERC20Contract()
+
+			ownerKey, err := crypto.GenerateKey()
+			suite.Require().NoError(err)
+			owner := crypto.PubkeyToAddress(ownerKey.PublicKey)
+
+			// fund the owner with tokens
+			sender := sdk.AccAddress(suite.address.Bytes())
+			coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 100))
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+			_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), types.NewMsgConvertCoin(coins[0], owner, sender))
+			suite.Require().NoError(err)
+
+			signer = ownerKey
+			amount = big.NewInt(10)
+			permitAmount = big.NewInt(10)
+			deadline = uint64(suite.ctx.BlockTime().Unix()) + 3600
+			tamper = func([]byte) {}
+
+			tc.malleate()
+
+			signature := suite.signPermit(signer, contract, owner, permitAmount, deadline)
+			tamper(signature)
+
+			relayer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+			msg := types.NewMsgConvertERC20WithPermit(sdk.NewIntFromBigInt(amount), contract, owner, deadline, signature, relayer)
+			suite.Require().NoError(msg.ValidateBasic())
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.app.Erc20Keeper.ConvertERC20WithPermit(ctx, msg)
+			if !tc.expPass {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Equal(big.NewInt(100), suite.BalanceOf(contract, owner))
+				return
+			}
+
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(&types.MsgConvertERC20WithPermitResponse{}, res)
+
+			cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(owner.Bytes()), cosmosTokenBase)
+			suite.Require().Equal(amount.Int64(), cosmosBalance.Amount.Int64())
+			suite.Require().Equal(big.NewInt(90), suite.BalanceOf(contract, owner))
+			suite.Require().Equal(int64(0), suite.BalanceOf(contract, types.ModuleAddress).(*big.Int).Int64())
+			suite.Require().Equal(int64(1), suite.permitNonce(contract, owner).Int64())
+
+			erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			allowance, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, types.ModuleAddress, contract, "allowance", owner, types.ModuleAddress)
+			suite.Require().NoError(err)
+			suite.Require().Equal(common.Hash{}.Bytes(), allowance.Ret)
+
+			// the signature can't be replayed
+			_, err = suite.app.Erc20Keeper.ConvertERC20WithPermit(ctx, msg)
+			suite.Require().Error(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20WithPermitNativeERC20() {
+	testCases := []struct {
+		name     string
+		malleate func() common.Address
+		expPass  bool
+	}{
+		{
+			"ok - token with permit",
+			func() common.Address {
+				// deploy a proxy of the permit implementation owned by the account
+				ctorArgs, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("", erc20Name, erc20Symbol, erc20Decimals)
+				suite.Require().NoError(err)
+				initializer := append(append([]byte{}, contracts.ERC20MinterBurnerDecimalsContract.Bin...), ctorArgs...)
+
+				code, err := contracts.ERC20ProxyInitializerCreationCode(initializer)
+				suite.Require().NoError(err)
+				initializerAddr := suite.DeployContractQuirky(evm.CompiledContract{Bin: code})
+
+				permit, found := contracts.GetERC20Implementation(contracts.ERC20PermitImplementation)
+				suite.Require().True(found)
+
+				proxy := suite.DeployContractQuirky(contracts.ERC20ProxyContract)
+				proxyABI := contracts.ERC20ProxyContract.ABI
+				_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, proxyABI, suite.address, proxy, "upgradeTo", initializerAddr)
+				suite.Require().NoError(err)
+				_, err = suite.app.Erc20Keeper.CallEVMWithPayload(suite.ctx, suite.address, &proxy, nil)
+				suite.Require().NoError(err)
+				_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, proxyABI, suite.address, proxy, "upgradeTo", keeper.ERC20ImplementationAddress(permit))
+				suite.Require().NoError(err)
+				return proxy
+			},
+			true,
+		},
+		{
+			"fail - token without permit",
+			func() common.Address {
+				return suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			// register a coin to deploy the implementations
+			suite.setupRegisterCoin()
+
+			contract := tc.malleate()
+			suite.Commit()
+			pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract, types.TOKEN_BEHAVIOUR_STANDARD, 0, 0, nil)
+			suite.Require().NoError(err)
+
+			ownerKey, err := crypto.GenerateKey()
+			suite.Require().NoError(err)
+			owner := crypto.PubkeyToAddress(ownerKey.PublicKey)
+			_ = suite.MintERC20Token(contract, suite.address, owner, big.NewInt(100))
+
+			var signature []byte
+			deadline := uint64(suite.ctx.BlockTime().Unix()) + 3600
+			if tc.expPass {
+				signature = suite.signPermit(ownerKey, contract, owner, big.NewInt(10), deadline)
+			} else {
+				// the token has no nonces to sign the permit with
+				signature = make([]byte, crypto.SignatureLength)
+			}
+
+			relayer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+			msg := types.NewMsgConvertERC20WithPermit(sdk.NewInt(10), contract, owner, deadline, signature, relayer)
+			_, err = suite.app.Erc20Keeper.ConvertERC20WithPermit(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err, tc.name)
+				return
+			}
+
+			suite.Require().NoError(err, tc.name)
+			cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(owner.Bytes()), pair.Denom)
+			suite.Require().Equal(int64(10), cosmosBalance.Amount.Int64())
+			suite.Require().Equal(big.NewInt(90), suite.BalanceOf(contract, owner))
+			suite.Require().Equal(big.NewInt(10), suite.BalanceOf(contract, types.ModuleAddress))
+		})
+	}
+}
+
+// approveModule approves the module account to spend the given amount of the
+// owner's tokens
+func (suite *KeeperTestSuite) approveModule(contract, owner common.Address, amount *big.Int) {
+	if !suite.app.AccountKeeper.HasAccount(suite.ctx, owner.Bytes()) {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, owner.Bytes())
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, owner, contract, "approve", types.ModuleAddress, amount)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20From() {
+	var (
+		amount    *big.Int
+		allowance *big.Int
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expPass      bool
+		expAllowance int64
+	}{
+		{
+			"ok - exact allowance",
+			func() {},
+			true,
+			0,
+		},
+		{
+			"ok - allowance greater than the amount",
+			func() {
+				allowance = big.NewInt(50)
+			},
+			true,
+			40,
+		},
+		{
+			"fail - no allowance",
+			func() {
+				allowance = big.NewInt(0)
+			},
+			false,
+			0,
+		},
+		{
+			"fail - allowance lower than the amount",
+			func() {
+				allowance = big.NewInt(5)
+			},
+			false,
+			0,
+		},
+		{
+			"fail - insufficient funds",
+			func() {
+				amount = big.NewInt(200)
+				allowance = big.NewInt(200)
+			},
+			false,
+			0,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			_, pair := suite.setupRegisterCoin()
+			suite.Require().NotNil(pair)
+			contract := pair.GetERC20Contract()
+
+			// the owner can be a contract account without a key, such as a multisig
+			owner := tests.GenerateAddress()
+
+			// fund the owner with tokens
+			sender := sdk.AccAddress(suite.address.Bytes())
+			coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 100))
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+			_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), types.NewMsgConvertCoin(coins[0], owner, sender))
+			suite.Require().NoError(err)
+
+			amount = big.NewInt(10)
+			allowance = big.NewInt(10)
+
+			tc.malleate()
+
+			suite.approveModule(contract, owner, allowance)
+
+			relayer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+			msg := types.NewMsgConvertERC20From(sdk.NewIntFromBigInt(amount), contract, owner, relayer)
+			suite.Require().NoError(msg.ValidateBasic())
+
+			res, err := suite.app.Erc20Keeper.ConvertERC20From(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Equal(big.NewInt(100), suite.BalanceOf(contract, owner))
+				return
+			}
+
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(&types.MsgConvertERC20FromResponse{}, res)
+
+			// the coins are sent to the owner and never to the relayer
+			cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(owner.Bytes()), cosmosTokenBase)
+			suite.Require().Equal(amount.Int64(), cosmosBalance.Amount.Int64())
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, relayer, cosmosTokenBase).IsZero())
+			suite.Require().Equal(big.NewInt(90), suite.BalanceOf(contract, owner))
+			suite.Require().Equal(int64(0), suite.BalanceOf(contract, types.ModuleAddress).(*big.Int).Int64())
+
+			erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			remaining, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, types.ModuleAddress, contract, "allowance", owner, types.ModuleAddress)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expAllowance, new(big.Int).SetBytes(remaining.Ret).Int64())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20FromNativeERC20() {
+	suite.SetupTest()
+	contract := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	suite.Commit()
+	pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract, types.TOKEN_BEHAVIOUR_STANDARD, 0, 0, nil)
+	suite.Require().NoError(err)
+
+	owner := tests.GenerateAddress()
+	_ = suite.MintERC20Token(contract, suite.address, owner, big.NewInt(100))
+	suite.approveModule(contract, owner, big.NewInt(10))
+
+	relayer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	msg := types.NewMsgConvertERC20From(sdk.NewInt(10), contract, owner, relayer)
+	_, err = suite.app.Erc20Keeper.ConvertERC20From(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(owner.Bytes()), pair.Denom)
+	suite.Require().Equal(int64(10), cosmosBalance.Amount.Int64())
+	suite.Require().Equal(big.NewInt(90), suite.BalanceOf(contract, owner))
+	suite.Require().Equal(big.NewInt(10), suite.BalanceOf(contract, types.ModuleAddress))
+
+	// the allowance is spent
+	_, err = suite.app.Erc20Keeper.ConvertERC20From(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestConvertCoinWithAuthorization() {
+	_, pair := suite.setupRegisterCoin()
+
+	granter := sdk.AccAddress(suite.address.Bytes())
+	grantee := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 100))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, granter, coins))
+
+	authorization := types.NewConvertCoinAuthorization(sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 50)), []string{suite.address.Hex()})
+	err := suite.app.AuthzKeeper.SaveGrant(suite.ctx, grantee, granter, authorization, suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+
+	// receiver not allowed
+	msg := types.NewMsgConvertCoin(sdk.NewInt64Coin(pair.Denom, 40), tests.GenerateAddress(), granter)
+	_, err = suite.app.AuthzKeeper.DispatchActions(suite.ctx, grantee, []sdk.Msg{msg})
+	suite.Require().Error(err)
+
+	msg = types.NewMsgConvertCoin(sdk.NewInt64Coin(pair.Denom, 40), suite.address, granter)
+	_, err = suite.app.AuthzKeeper.DispatchActions(suite.ctx, grantee, []sdk.Msg{msg})
+	suite.Require().NoError(err)
+
+	balance := suite.BalanceOf(pair.GetERC20Contract(), suite.address)
+	suite.Require().Equal(int64(40), balance.(*big.Int).Int64())
+
+	updated, _ := suite.app.AuthzKeeper.GetCleanAuthorization(suite.ctx, grantee, granter, authorization.MsgTypeURL())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 10)), updated.(*types.ConvertAuthorization).SpendLimit)
+
+	// amount exceeds the remaining spend limit
+	msg = types.NewMsgConvertCoin(sdk.NewInt64Coin(pair.Denom, 11), suite.address, granter)
+	_, err = suite.app.AuthzKeeper.DispatchActions(suite.ctx, grantee, []sdk.Msg{msg})
+	suite.Require().Error(err)
+
+	// the grant is deleted once the spend limit is exhausted
+	msg = types.NewMsgConvertCoin(sdk.NewInt64Coin(pair.Denom, 10), suite.address, granter)
+	_, err = suite.app.AuthzKeeper.DispatchActions(suite.ctx, grantee, []sdk.Msg{msg})
+	suite.Require().NoError(err)
+
+	updated, _ = suite.app.AuthzKeeper.GetCleanAuthorization(suite.ctx, grantee, granter, authorization.MsgTypeURL())
+	suite.Require().Nil(updated)
 }
@@ -0,0 +1,286 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// GetAllPauses returns the pause of the module, if any, followed by the pauses
+// of the token pairs. Expired pauses that haven't been pruned yet are included.
+func (k Keeper) GetAllPauses(ctx sdk.Context) []types.Pause {
+	pauses := []types.Pause{}
+
+	if pause, found := k.GetModulePause(ctx); found {
+		pauses = append(pauses, pause)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPairPause)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pause types.Pause
+		k.cdc.MustUnmarshal(iterator.Value(), &pause)
+
+		pauses = append(pauses, pause)
+	}
+
+	return pauses
+}
+
+// GetModulePause returns the pause of the whole module
+func (k Keeper) GetModulePause(ctx sdk.Context) (types.Pause, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyModulePause)
+	if len(bz) == 0 {
+		return types.Pause{}, false
+	}
+
+	var pause types.Pause
+	k.cdc.MustUnmarshal(bz, &pause)
+	return pause, true
+}
+
+// GetPairPause returns the pause of the token pair with the given ERC20
+// contract
+func (k Keeper) GetPairPause(ctx sdk.Context, contract common.Address) (types.Pause, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPairPause)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.Pause{}, false
+	}
+
+	var pause types.Pause
+	k.cdc.MustUnmarshal(bz, &pause)
+	return pause, true
+}
+
+// SetPause stores the pause of the module or of a token pair
+func (k Keeper) SetPause(ctx sdk.Context, pause types.Pause) {
+	if pause.IsModulePause() {
+		k.SetModulePause(ctx, pause)
+		return
+	}
+
+	k.SetPairPause(ctx, pause)
+}
+
+// SetModulePause stores the pause of the whole module
+func (k Keeper) SetModulePause(ctx sdk.Context, pause types.Pause) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pause)
+	store.Set(types.KeyModulePause, bz)
+}
+
+// SetPairPause stores the pause of a token pair
+func (k Keeper) SetPairPause(ctx sdk.Context, pause types.Pause) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPairPause)
+	bz := k.cdc.MustMarshal(&pause)
+	store.Set(pause.GetERC20Contract().Bytes(), bz)
+}
+
+// DeletePause removes the pause of the module or of a token pair
+func (k Keeper) DeletePause(ctx sdk.Context, pause types.Pause) {
+	if pause.IsModulePause() {
+		k.DeleteModulePause(ctx)
+		return
+	}
+
+	k.DeletePairPause(ctx, pause.GetERC20Contract())
+}
+
+// DeleteModulePause removes the pause of the whole module
+func (k Keeper) DeleteModulePause(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyModulePause)
+}
+
+// DeletePairPause removes the pause of the token pair with the given ERC20
+// contract
+func (k Keeper) DeletePairPause(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPairPause)
+	store.Delete(contract.Bytes())
+}
+
+// IsModulePaused returns the pause of the whole module if it is active at the
+// current block time
+func (k Keeper) IsModulePaused(ctx sdk.Context) (types.Pause, bool) {
+	pause, found := k.GetModulePause(ctx)
+	if !found || !pause.IsActive(ctx.BlockTime()) {
+		return types.Pause{}, false
+	}
+
+	return pause, true
+}
+
+// IsPairPaused returns the pause of the token pair with the given ERC20
+// contract if it is active at the current block time
+func (k Keeper) IsPairPaused(ctx sdk.Context, contract common.Address) (types.Pause, bool) {
+	pause, found := k.GetPairPause(ctx, contract)
+	if !found || !pause.IsActive(ctx.BlockTime()) {
+		return types.Pause{}, false
+	}
+
+	return pause, true
+}
+
+// CheckNotPaused returns an error if the conversions of the whole module or of
+// the given token pair are paused
+func (k Keeper) CheckNotPaused(ctx sdk.Context, pair types.TokenPair) error {
+	if pause, paused := k.IsModulePaused(ctx); paused {
+		return sdkerrors.Wrapf(types.ErrPaused, "module paused until %s: %s", pause.Expiry, pause.Reason)
+	}
+
+	if pause, paused := k.IsPairPaused(ctx, pair.GetERC20Contract()); paused {
+		return sdkerrors.Wrapf(types.ErrPaused, "token pair %s paused until %s: %s", pair.Erc20Address, pause.Expiry, pause.Reason)
+	}
+
+	return nil
+}
+
+// PauseTokenPair pauses the conversions of a registered token pair for the
+// given duration. Only the guardian can pause a token pair, and only if it
+// isn't paused already.
+func (k Keeper) PauseTokenPair(
+	ctx sdk.Context,
+	guardian sdk.AccAddress,
+	token, reason string,
+	duration time.Duration,
+) (types.TokenPair, types.Pause, error) {
+	if err := k.validatePause(ctx, guardian, duration); err != nil {
+		return types.TokenPair{}, types.Pause{}, err
+	}
+
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, types.Pause{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token %s not registered", token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, types.Pause{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token %s not registered", token)
+	}
+
+	// the guardian can neither shorten nor extend an active pause, only
+	// governance can through an ExtendPauseProposal
+	if current, paused := k.IsPairPaused(ctx, pair.GetERC20Contract()); paused {
+		return types.TokenPair{}, types.Pause{}, sdkerrors.Wrapf(
+			types.ErrPaused, "token pair %s already paused until %s", pair.Erc20Address, current.Expiry,
+		)
+	}
+
+	pause := types.NewPairPause(pair.GetERC20Contract(), reason, guardian, ctx.BlockTime().Add(duration))
+	k.SetPairPause(ctx, pause)
+	return pair, pause, nil
+}
+
+// PauseAllTokenPairs pauses the conversions of the whole module for the given
+// duration. Only the guardian can pause the module, and only if it isn't
+// paused already.
+func (k Keeper) PauseAllTokenPairs(
+	ctx sdk.Context,
+	guardian sdk.AccAddress,
+	reason string,
+	duration time.Duration,
+) (types.Pause, error) {
+	if err := k.validatePause(ctx, guardian, duration); err != nil {
+		return types.Pause{}, err
+	}
+
+	// the guardian can neither shorten nor extend an active pause, only
+	// governance can through an ExtendPauseProposal
+	if current, paused := k.IsModulePaused(ctx); paused {
+		return types.Pause{}, sdkerrors.Wrapf(types.ErrPaused, "module already paused until %s", current.Expiry)
+	}
+
+	pause := types.NewModulePause(reason, guardian, ctx.BlockTime().Add(duration))
+	k.SetModulePause(ctx, pause)
+	return pause, nil
+}
+
+// validatePause checks that the signer is the guardian and that the duration
+// doesn't exceed the maximum pause duration
+func (k Keeper) validatePause(ctx sdk.Context, guardian sdk.AccAddress, duration time.Duration) error {
+	params := k.GetParams(ctx)
+	if params.Guardian == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "emergency pauses are disabled as the guardian is not set")
+	}
+
+	if guardian.String() != params.Guardian {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the guardian", guardian)
+	}
+
+	if duration > params.MaxPauseDuration {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"pause duration %s exceeds the maximum pause duration %s", duration, params.MaxPauseDuration,
+		)
+	}
+
+	return nil
+}
+
+// ExtendPause sets the expiry of an active pause to the current block time plus
+// the given duration, regardless of the maximum pause duration. A zero
+// duration lifts the pause. An empty token refers to the pause of the whole
+// module.
+func (k Keeper) ExtendPause(ctx sdk.Context, token string, duration time.Duration) (types.Pause, error) {
+	var (
+		pause  types.Pause
+		paused bool
+	)
+
+	if token == "" {
+		pause, paused = k.IsModulePaused(ctx)
+	} else {
+		id := k.GetTokenPairID(ctx, token)
+		if len(id) == 0 {
+			return types.Pause{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token %s not registered", token)
+		}
+
+		pair, found := k.GetTokenPair(ctx, id)
+		if !found {
+			return types.Pause{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token %s not registered", token)
+		}
+
+		pause, paused = k.IsPairPaused(ctx, pair.GetERC20Contract())
+	}
+
+	if !paused {
+		return types.Pause{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no active pause for '%s'", token)
+	}
+
+	pause.Expiry = ctx.BlockTime().Add(duration)
+	if duration == 0 {
+		k.DeletePause(ctx, pause)
+		return pause, nil
+	}
+
+	k.SetPause(ctx, pause)
+	return pause, nil
+}
+
+// PruneExpiredPauses removes the pauses that expired at the current block time
+func (k Keeper) PruneExpiredPauses(ctx sdk.Context) {
+	for _, pause := range k.GetAllPauses(ctx) {
+		if pause.IsActive(ctx.BlockTime()) {
+			continue
+		}
+
+		k.DeletePause(ctx, pause)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePauseExpired,
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pause.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyExpiry, pause.Expiry.String()),
+			),
+		)
+	}
+}
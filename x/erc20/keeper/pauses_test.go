@@ -0,0 +1,315 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
+)
+
+// setupGuardian sets a new guardian on the module params and returns it
+func (suite *KeeperTestSuite) setupGuardian() sdk.AccAddress {
+	guardian := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+	params.Guardian = guardian.String()
+	suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+	return guardian
+}
+
+// convertCoin funds the test account and converts the given amount of the pair
+// Cosmos coin to ERC20
+func (suite *KeeperTestSuite) convertCoin(pair *types.TokenPair, amount int64) error {
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, sdk.NewInt(amount)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+
+	msg := types.NewMsgConvertCoin(coins[0], suite.address, sender)
+	_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+	return err
+}
+
+func (suite *KeeperTestSuite) TestPausePair() {
+	var guardian sdk.AccAddress
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		duration time.Duration
+		expPass  bool
+	}{
+		{
+			"ok",
+			func() {},
+			time.Hour,
+			true,
+		},
+		{
+			"fail - guardian not set",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.Guardian = ""
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			time.Hour,
+			false,
+		},
+		{
+			"fail - signer is not the guardian",
+			func() {
+				guardian = sdk.AccAddress(tests.GenerateAddress().Bytes())
+			},
+			time.Hour,
+			false,
+		},
+		{
+			"fail - duration exceeds the maximum pause duration",
+			func() {},
+			types.DefaultMaxPauseDuration + time.Second,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			_, pair := suite.setupRegisterCoin()
+			guardian = suite.setupGuardian()
+			tc.malleate()
+
+			msg := types.NewMsgPausePair(pair.Denom, "incident", tc.duration, guardian)
+			res, err := suite.app.Erc20Keeper.PausePair(sdk.WrapSDKContext(suite.ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(suite.ctx.BlockTime().Add(tc.duration), res.Expiry)
+
+				pause, paused := suite.app.Erc20Keeper.IsPairPaused(suite.ctx, pair.GetERC20Contract())
+				suite.Require().True(paused)
+				suite.Require().Equal("incident", pause.Reason)
+				suite.Require().Equal(guardian.String(), pause.Guardian)
+
+				err = suite.convertCoin(pair, 10)
+				suite.Require().ErrorIs(err, types.ErrPaused)
+			} else {
+				suite.Require().Error(err, tc.name)
+				_, paused := suite.app.Erc20Keeper.IsPairPaused(suite.ctx, pair.GetERC20Contract())
+				suite.Require().False(paused)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPauseModule() {
+	_, pair := suite.setupRegisterCoin()
+	guardian := suite.setupGuardian()
+
+	pause, err := suite.app.Erc20Keeper.PauseAllTokenPairs(suite.ctx, guardian, "incident", time.Hour)
+	suite.Require().NoError(err)
+	suite.Require().True(pause.IsModulePause())
+
+	err = suite.convertCoin(pair, 10)
+	suite.Require().ErrorIs(err, types.ErrPaused)
+}
+
+func (suite *KeeperTestSuite) TestRePause() {
+	_, pair := suite.setupRegisterCoin()
+	guardian := suite.setupGuardian()
+
+	_, pairPause, err := suite.app.Erc20Keeper.PauseTokenPair(suite.ctx, guardian, pair.Denom, "incident", time.Hour)
+	suite.Require().NoError(err)
+	modulePause, err := suite.app.Erc20Keeper.PauseAllTokenPairs(suite.ctx, guardian, "incident", time.Hour)
+	suite.Require().NoError(err)
+
+	// the guardian can't extend an active pause by pausing again before it
+	// expires
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(59 * time.Minute))
+	_, _, err = suite.app.Erc20Keeper.PauseTokenPair(suite.ctx, guardian, pair.Denom, "incident", time.Hour)
+	suite.Require().ErrorIs(err, types.ErrPaused)
+	_, err = suite.app.Erc20Keeper.PauseAllTokenPairs(suite.ctx, guardian, "incident", time.Hour)
+	suite.Require().ErrorIs(err, types.ErrPaused)
+
+	pause, found := suite.app.Erc20Keeper.GetPairPause(suite.ctx, pair.GetERC20Contract())
+	suite.Require().True(found)
+	suite.Require().Equal(pairPause.Expiry, pause.Expiry)
+	pause, found = suite.app.Erc20Keeper.GetModulePause(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(modulePause.Expiry, pause.Expiry)
+
+	// governance can extend it
+	_, err = suite.app.Erc20Keeper.ExtendPause(suite.ctx, pair.Denom, 2*time.Hour)
+	suite.Require().NoError(err)
+
+	// the guardian can pause again once the pause expired
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Minute))
+	_, err = suite.app.Erc20Keeper.PauseAllTokenPairs(suite.ctx, guardian, "incident", time.Hour)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestPauseExpiry() {
+	_, pair := suite.setupRegisterCoin()
+	guardian := suite.setupGuardian()
+
+	_, pause, err := suite.app.Erc20Keeper.PauseTokenPair(suite.ctx, guardian, pair.Denom, "incident", time.Hour)
+	suite.Require().NoError(err)
+	_, err = suite.app.Erc20Keeper.PauseAllTokenPairs(suite.ctx, guardian, "incident", time.Hour)
+	suite.Require().NoError(err)
+
+	// the pauses still apply right before the expiry
+	suite.ctx = suite.ctx.WithBlockTime(pause.Expiry.Add(-time.Second))
+	suite.app.Erc20Keeper.EndBlocker(suite.ctx)
+	suite.Require().Len(suite.app.Erc20Keeper.GetAllPauses(suite.ctx), 2)
+	suite.Require().ErrorIs(suite.convertCoin(pair, 10), types.ErrPaused)
+
+	// expired pauses are ignored before being pruned
+	suite.ctx = suite.ctx.WithBlockTime(pause.Expiry)
+	suite.Require().NoError(suite.convertCoin(pair, 10))
+
+	suite.app.Erc20Keeper.EndBlocker(suite.ctx)
+	suite.Require().Empty(suite.app.Erc20Keeper.GetAllPauses(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestExtendPause() {
+	testCases := []struct {
+		name     string
+		token    func(pair *types.TokenPair) string
+		duration time.Duration
+		expPass  bool
+	}{
+		{
+			"ok - extend pair pause",
+			func(pair *types.TokenPair) string { return pair.Erc20Address },
+			30 * 24 * time.Hour,
+			true,
+		},
+		{
+			"ok - extend module pause",
+			func(*types.TokenPair) string { return "" },
+			30 * 24 * time.Hour,
+			true,
+		},
+		{
+			"ok - lift pair pause",
+			func(pair *types.TokenPair) string { return pair.Denom },
+			0,
+			true,
+		},
+		{
+			"fail - token not registered",
+			func(*types.TokenPair) string { return "coin" },
+			time.Hour,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			_, pair := suite.setupRegisterCoin()
+			guardian := suite.setupGuardian()
+
+			_, _, err := suite.app.Erc20Keeper.PauseTokenPair(suite.ctx, guardian, pair.Denom, "incident", time.Hour)
+			suite.Require().NoError(err)
+			_, err = suite.app.Erc20Keeper.PauseAllTokenPairs(suite.ctx, guardian, "incident", time.Hour)
+			suite.Require().NoError(err)
+
+			token := tc.token(pair)
+			pause, err := suite.app.Erc20Keeper.ExtendPause(suite.ctx, token, tc.duration)
+
+			if !tc.expPass {
+				suite.Require().Error(err, tc.name)
+				return
+			}
+
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(suite.ctx.BlockTime().Add(tc.duration), pause.Expiry)
+
+			var paused bool
+			if token == "" {
+				_, paused = suite.app.Erc20Keeper.IsModulePaused(suite.ctx)
+			} else {
+				_, paused = suite.app.Erc20Keeper.IsPairPaused(suite.ctx, pair.GetERC20Contract())
+			}
+			suite.Require().Equal(tc.duration > 0, paused)
+
+			// a lifted pause can't be extended
+			if tc.duration == 0 {
+				_, err = suite.app.Erc20Keeper.ExtendPause(suite.ctx, token, time.Hour)
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPauses() {
+	_, pair := suite.setupRegisterCoin()
+	guardian := suite.setupGuardian()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.app.Erc20Keeper.Pauses(ctx, &types.QueryPausesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.ModulePause)
+	suite.Require().Empty(res.PairPauses)
+
+	_, err = suite.app.Erc20Keeper.Pause(ctx, &types.QueryPauseRequest{Token: pair.Denom})
+	suite.Require().Error(err)
+
+	_, pairPause, err := suite.app.Erc20Keeper.PauseTokenPair(suite.ctx, guardian, pair.Denom, "incident", time.Hour)
+	suite.Require().NoError(err)
+	modulePause, err := suite.app.Erc20Keeper.PauseAllTokenPairs(suite.ctx, guardian, "incident", time.Hour)
+	suite.Require().NoError(err)
+
+	res, err = suite.app.Erc20Keeper.Pauses(ctx, &types.QueryPausesRequest{
+		Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&modulePause, res.ModulePause)
+	suite.Require().Equal([]types.Pause{pairPause}, res.PairPauses)
+	suite.Require().Equal(uint64(1), res.Pagination.Total)
+
+	pauseRes, err := suite.app.Erc20Keeper.Pause(ctx, &types.QueryPauseRequest{Token: pair.Erc20Address})
+	suite.Require().NoError(err)
+	suite.Require().Equal(pairPause, pauseRes.Pause)
+
+	// expired pauses are not returned
+	ctx = sdk.WrapSDKContext(suite.ctx.WithBlockTime(pairPause.Expiry))
+	res, err = suite.app.Erc20Keeper.Pauses(ctx, &types.QueryPausesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.ModulePause)
+	suite.Require().Empty(res.PairPauses)
+}
+
+func (suite *KeeperTestSuite) TestPausedPairTransfers() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	suite.ensureHooksSet()
+
+	_, pair := suite.setupRegisterCoin()
+	guardian := suite.setupGuardian()
+	suite.Require().NoError(suite.convertCoin(pair, 10))
+	suite.Commit()
+
+	_, _, err := suite.app.Erc20Keeper.PauseTokenPair(suite.ctx, guardian, pair.Denom, "incident", time.Hour)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	// transfers between accounts aren't conversions and aren't paused
+	receiver := tests.GenerateAddress()
+	transferData, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("transfer", receiver, big.NewInt(4))
+	suite.Require().NoError(err)
+	_, res := suite.deliverTx(pair.GetERC20Contract(), suite.address, transferData)
+	suite.Require().Empty(res.VmError)
+	suite.Require().Equal(big.NewInt(4), suite.BalanceOf(pair.GetERC20Contract(), receiver))
+
+	// transfers to the module account are conversions and are paused
+	transferData, err = contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("transfer", types.ModuleAddress, big.NewInt(6))
+	suite.Require().NoError(err)
+	_, res = suite.deliverTx(pair.GetERC20Contract(), suite.address, transferData)
+	suite.Require().NotEmpty(res.VmError)
+	suite.Require().Equal(big.NewInt(6), suite.BalanceOf(pair.GetERC20Contract(), suite.address))
+
+	suite.mintFeeCollector = false
+}
@@ -2,9 +2,11 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -13,14 +15,24 @@ import (
 	"github.com/tharsis/evmos/x/erc20/types/contracts"
 )
 
-// RegisterCoin deploys an erc20 contract and creates the token pair for the existing cosmos coin
-func (k Keeper) RegisterCoin(ctx sdk.Context, coinMetadata banktypes.Metadata) (*types.TokenPair, error) {
+// RegisterCoin deploys an erc20 contract and creates the token pair for the
+// existing cosmos coin. A base unit of the coin maps to 10^scalingExponent
+// ERC20 token units.
+func (k Keeper) RegisterCoin(ctx sdk.Context, coinMetadata banktypes.Metadata, scalingExponent uint32, gasLimit uint64) (*types.TokenPair, error) {
 	// check if the conversion is globally enabled
 	params := k.GetParams(ctx)
 	if !params.EnableErc20 {
 		return nil, sdkerrors.Wrap(types.ErrInternalTokenPair, "intrarelaying is currently disabled by governance")
 	}
 
+	if err := types.ValidateScalingExponent(scalingExponent); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateGasLimit(gasLimit); err != nil {
+		return nil, err
+	}
+
 	// check if the denomination already registered
 	if k.IsDenomRegistered(ctx, coinMetadata.Name) {
 		return nil, sdkerrors.Wrapf(types.ErrInternalTokenPair, "coin denomination already registered: %s", coinMetadata.Name)
@@ -38,12 +50,14 @@ func (k Keeper) RegisterCoin(ctx sdk.Context, coinMetadata banktypes.Metadata) (
 		return nil, sdkerrors.Wrapf(types.ErrInternalTokenPair, "coin metadata is invalid %s", coinMetadata.Name)
 	}
 
-	addr, err := k.DeployERC20Contract(ctx, coinMetadata)
+	addr, err := k.DeployERC20Contract(ctx, coinMetadata, scalingExponent)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to create wrapped coin denom metadata for ERC20")
 	}
 
 	pair := types.NewTokenPair(addr, coinMetadata.Base, true, types.OWNER_MODULE)
+	pair.ScalingExponent = scalingExponent
+	pair.GasLimit = gasLimit
 	k.SetTokenPair(ctx, pair)
 	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
 	k.SetERC20Map(ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
@@ -63,12 +77,119 @@ func (k Keeper) verifyMetadata(ctx sdk.Context, coinMetadata banktypes.Metadata)
 }
 
 // DeployERC20Contract creates and deploys an ERC20 contract on the EVM with the
-// erc20 module account as owner.
+// erc20 module account as owner. The contract is a proxy pointing to the
+// current ERC20 implementation, initialized with the coin metadata and the
+// coin decimals plus the scaling exponent. It is deployed with CREATE2 using a
+// salt derived from the base denomination and a creation code without
+// parameters, so that its address only depends on the base denomination (see
+// ComputeERC20Address).
 func (k Keeper) DeployERC20Contract(
 	ctx sdk.Context,
 	coinMetadata banktypes.Metadata,
+	scalingExponent uint32,
 ) (common.Address, error) {
-	decimals := uint8(coinMetadata.DenomUnits[0].Exponent)
+	name := k.GetERC20Implementation(ctx)
+	implementation, found := contracts.GetERC20Implementation(name)
+	if !found {
+		return common.Address{}, sdkerrors.Wrapf(types.ErrInvalidImplementation, "implementation '%s' not found", name)
+	}
+
+	initializer, err := erc20ContractCreationCode(coinMetadata, scalingExponent)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	contractAddr := ComputeERC20Address(coinMetadata.Base)
+
+	// the contract of a deregistered token pair is not destroyed, so it can't be
+	// deployed again
+	if acc := k.evmKeeper.GetAccount(ctx, contractAddr); acc != nil && acc.IsContract() {
+		return common.Address{}, sdkerrors.Wrapf(
+			types.ErrInternalTokenPair,
+			"ERC20 contract for %s already deployed at %s", coinMetadata.Base, contractAddr,
+		)
+	}
+
+	implementationAddr, err := k.deployERC20Implementation(ctx, implementation)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	salt := types.CreateERC20Salt(coinMetadata.Base)
+	addr, err := k.CallEVMCreate2(ctx, types.ModuleAddress, salt, contracts.ERC20ProxyContract.Bin)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to deploy contract for %s", coinMetadata.Name)
+	}
+
+	if err := k.initializeERC20Proxy(ctx, addr, salt, initializer, implementationAddr); err != nil {
+		return common.Address{}, sdkerrors.Wrapf(err, "failed to initialize contract for %s", coinMetadata.Name)
+	}
+
+	return addr, nil
+}
+
+// initializeERC20Proxy runs the given initializer on the storage of a newly
+// deployed proxy and points the proxy to the implementation. The initializer
+// is deployed with CREATE2 using the salt of the proxy, and reused if it is
+// already deployed.
+func (k Keeper) initializeERC20Proxy(
+	ctx sdk.Context,
+	proxy common.Address,
+	salt common.Hash,
+	initializer []byte,
+	implementation common.Address,
+) error {
+	data, err := contracts.ERC20ProxyInitializerCreationCode(initializer)
+	if err != nil {
+		return err
+	}
+
+	initializerAddr := crypto.CreateAddress2(types.ModuleAddress, salt, crypto.Keccak256(data))
+	if acc := k.evmKeeper.GetAccount(ctx, initializerAddr); acc == nil || !acc.IsContract() {
+		if _, err := k.CallEVMCreate2(ctx, types.ModuleAddress, salt, data); err != nil {
+			return sdkerrors.Wrap(err, "failed to deploy initializer")
+		}
+	}
+
+	proxyABI := contracts.ERC20ProxyContract.ABI
+	if _, err := k.CallEVM(ctx, proxyABI, types.ModuleAddress, proxy, "upgradeTo", initializerAddr); err != nil {
+		return err
+	}
+
+	// an empty call of the admin is delegated to the initializer
+	if _, err := k.CallEVMWithPayload(ctx, types.ModuleAddress, &proxy, nil); err != nil {
+		return sdkerrors.Wrap(err, "failed to run initializer")
+	}
+
+	_, err = k.CallEVM(ctx, proxyABI, types.ModuleAddress, proxy, "upgradeTo", implementation)
+	return err
+}
+
+// ComputeERC20Address returns the address of the ERC20 contract deployed on the
+// registration of the Cosmos coin with the given base denomination. The
+// address only depends on the erc20 module account address and the base
+// denomination, so it is identical on every network and doesn't depend on the
+// coin metadata, the scaling exponent or the current ERC20 implementation.
+func ComputeERC20Address(denom string) common.Address {
+	salt := types.CreateERC20Salt(denom)
+	return crypto.CreateAddress2(types.ModuleAddress, salt, crypto.Keccak256(contracts.ERC20ProxyContract.Bin))
+}
+
+// erc20ContractCreationCode returns the creation code of the
+// ERC20MinterBurnerDecimals contract for the given coin metadata and scaling
+// exponent, including the constructor arguments. It is used to initialize the
+// storage of the proxies.
+func erc20ContractCreationCode(coinMetadata banktypes.Metadata, scalingExponent uint32) ([]byte, error) {
+	if len(coinMetadata.DenomUnits) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrInternalTokenPair, "coin metadata has no denom units %s", coinMetadata.Name)
+	}
+
+	exponent := coinMetadata.DenomUnits[0].Exponent + scalingExponent
+	if exponent > math.MaxUint8 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidScaling, "ERC20 decimals %d overflow for %s", exponent, coinMetadata.Name)
+	}
+
+	decimals := uint8(exponent)
 	ctorArgs, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack(
 		"",
 		coinMetadata.Name,
@@ -76,101 +197,151 @@ func (k Keeper) DeployERC20Contract(
 		decimals,
 	)
 	if err != nil {
-		return common.Address{}, sdkerrors.Wrapf(err, "coin metadata is invalid  %s", coinMetadata.Name)
+		return nil, sdkerrors.Wrapf(err, "coin metadata is invalid  %s", coinMetadata.Name)
 	}
 
 	data := make([]byte, len(contracts.ERC20MinterBurnerDecimalsContract.Bin)+len(ctorArgs))
 	copy(data[:len(contracts.ERC20MinterBurnerDecimalsContract.Bin)], contracts.ERC20MinterBurnerDecimalsContract.Bin)
 	copy(data[len(contracts.ERC20MinterBurnerDecimalsContract.Bin):], ctorArgs)
+	return data, nil
+}
 
-	nonce, err := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
-	if err != nil {
-		return common.Address{}, err
+// RegisterERC20 creates a cosmos coin and registers the token pair between the
+// coin and the ERC20 with the given transfer behaviour. A base unit of the coin
+// maps to 10^scalingExponent ERC20 token units. If the metadata isn't nil, it
+// overrides the coin metadata derived from the ERC20 details.
+func (k Keeper) RegisterERC20(
+	ctx sdk.Context,
+	contract common.Address,
+	behaviour types.TokenBehaviour,
+	scalingExponent uint32,
+	gasLimit uint64,
+	metadataOverride *banktypes.Metadata,
+) (*types.TokenPair, error) {
+	params := k.GetParams(ctx)
+	if !params.EnableErc20 {
+		return nil, sdkerrors.Wrap(types.ErrInternalTokenPair, "intrarelaying is currently disabled by governance")
 	}
 
-	contractAddr := crypto.CreateAddress(types.ModuleAddress, nonce)
-	_, err = k.CallEVMWithPayload(ctx, types.ModuleAddress, nil, data)
-	if err != nil {
-		return common.Address{}, fmt.Errorf("failed to deploy contract for %s", coinMetadata.Name)
+	if err := types.ValidateTokenBehaviour(behaviour); err != nil {
+		return nil, err
 	}
 
-	return contractAddr, nil
-}
+	if err := types.ValidateScalingExponent(scalingExponent); err != nil {
+		return nil, err
+	}
 
-// RegisterERC20 creates a cosmos coin and registers the token pair between the coin and the ERC20
-func (k Keeper) RegisterERC20(ctx sdk.Context, contract common.Address) (*types.TokenPair, error) {
-	params := k.GetParams(ctx)
-	if !params.EnableErc20 {
-		return nil, sdkerrors.Wrap(types.ErrInternalTokenPair, "intrarelaying is currently disabled by governance")
+	if err := types.ValidateGasLimit(gasLimit); err != nil {
+		return nil, err
 	}
 
 	if k.IsERC20Registered(ctx, contract) {
 		return nil, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token ERC20 contract already registered: %s", contract.String())
 	}
 
-	metadata, err := k.CreateCoinMetadata(ctx, contract)
+	metadata, err := k.CreateCoinMetadata(ctx, contract, scalingExponent, metadataOverride)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to create wrapped coin denom metadata for ERC20")
 	}
 
-	pair := types.NewTokenPair(contract, metadata.Name, true, types.OWNER_EXTERNAL)
+	pair := types.NewTokenPair(contract, metadata.Base, true, types.OWNER_EXTERNAL)
+	pair.Behaviour = behaviour
+	pair.ScalingExponent = scalingExponent
+	pair.GasLimit = gasLimit
 	k.SetTokenPair(ctx, pair)
 	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
 	k.SetERC20Map(ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
+	k.DeleteERC20Deregistered(ctx, contract)
 	return &pair, nil
 }
 
-// CreateCoinMetadata generates the metadata to represent the ERC20 token on evmos.
-func (k Keeper) CreateCoinMetadata(ctx sdk.Context, contract common.Address) (*banktypes.Metadata, error) {
+// CreateCoinMetadata generates the metadata to represent the ERC20 token on
+// evmos. The display unit of the coin has the ERC20 decimals minus the scaling
+// exponent. If the override isn't nil, it is used as the coin metadata instead
+// of the one derived from the ERC20 name and symbol, which aren't queried.
+func (k Keeper) CreateCoinMetadata(
+	ctx sdk.Context,
+	contract common.Address,
+	scalingExponent uint32,
+	override *banktypes.Metadata,
+) (*banktypes.Metadata, error) {
 	strContract := contract.String()
 
-	erc20Data, err := k.QueryERC20(ctx, contract)
+	var (
+		erc20Data types.ERC20Data
+		err       error
+	)
+
+	if override != nil {
+		erc20Data.Decimals, err = k.QueryERC20Decimals(ctx, contract)
+	} else {
+		erc20Data, err = k.QueryERC20(ctx, contract)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	_, found := k.bankKeeper.GetDenomMetaData(ctx, types.CreateDenom(strContract))
-	if found {
+	if uint32(erc20Data.Decimals) < scalingExponent {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidScaling,
+			"scaling exponent %d greater than the ERC20 decimals %d", scalingExponent, erc20Data.Decimals,
+		)
+	}
+	decimals := uint32(erc20Data.Decimals) - scalingExponent
+
+	// base denomination
+	base := types.CreateDenom(strContract)
+
+	// the metadata of a deregistered ERC20 can't be removed from the bank
+	// module, so it is overwritten
+	_, found := k.bankKeeper.GetDenomMetaData(ctx, base)
+	if found && !k.IsERC20Deregistered(ctx, contract) {
 		// metadata already exists; exit
 		return nil, sdkerrors.Wrapf(types.ErrInternalTokenPair, "coin denomination already registered")
 	}
 
-	if k.IsDenomRegistered(ctx, types.CreateDenom(strContract)) {
-		return nil, sdkerrors.Wrapf(types.ErrInternalTokenPair, "coin denomination already registered: %s", erc20Data.Name)
+	if k.IsDenomRegistered(ctx, base) {
+		return nil, sdkerrors.Wrapf(types.ErrInternalTokenPair, "coin denomination already registered: %s", base)
 	}
 
-	// base denomination
-	base := types.CreateDenom(strContract)
+	var metadata banktypes.Metadata
+	if override != nil {
+		if err := validateMetadataOverride(*override, contract, decimals); err != nil {
+			return nil, err
+		}
 
-	// create a bank denom metadata based on the ERC20 token ABI details
-	// metadata name is should always be the contract since it's the key
-	// to the bank store
-	metadata := banktypes.Metadata{
-		Description: types.CreateDenomDescription(strContract),
-		Base:        base,
-		// NOTE: Denom units MUST be increasing
-		DenomUnits: []*banktypes.DenomUnit{
-			{
-				Denom:    base,
-				Exponent: 0,
-			},
-		},
-		Name:    types.CreateDenom(strContract),
-		Symbol:  erc20Data.Symbol,
-		Display: base,
-	}
-
-	// only append metadata if decimals > 0, otherwise validation fails
-	if erc20Data.Decimals > 0 {
-		nameSanitized := types.SanitizeERC20Name(erc20Data.Name)
-		metadata.DenomUnits = append(
-			metadata.DenomUnits,
-			&banktypes.DenomUnit{
-				Denom:    nameSanitized,
-				Exponent: uint32(erc20Data.Decimals),
+		metadata = *override
+	} else {
+		// create a bank denom metadata based on the ERC20 token ABI details
+		// metadata name is should always be the contract since it's the key
+		// to the bank store
+		metadata = banktypes.Metadata{
+			Description: types.CreateDenomDescription(strContract),
+			Base:        base,
+			// NOTE: Denom units MUST be increasing
+			DenomUnits: []*banktypes.DenomUnit{
+				{
+					Denom:    base,
+					Exponent: 0,
+				},
 			},
-		)
-		metadata.Display = nameSanitized
+			Name:    base,
+			Symbol:  erc20Data.Symbol,
+			Display: base,
+		}
+
+		// only append metadata if decimals > 0, otherwise validation fails
+		if decimals > 0 {
+			nameSanitized := types.SanitizeERC20Name(erc20Data.Name)
+			metadata.DenomUnits = append(
+				metadata.DenomUnits,
+				&banktypes.DenomUnit{
+					Denom:    nameSanitized,
+					Exponent: decimals,
+				},
+			)
+			metadata.Display = nameSanitized
+		}
 	}
 
 	if err := metadata.Validate(); err != nil {
@@ -182,6 +353,35 @@ func (k Keeper) CreateCoinMetadata(ctx sdk.Context, contract common.Address) (*b
 	return &metadata, nil
 }
 
+// validateMetadataOverride checks that the coin metadata overriding the one
+// derived from the ERC20 details is valid and that its display unit has the
+// decimals of the ERC20 minus the scaling exponent, so that the coin and the
+// token are displayed with the same precision
+func validateMetadataOverride(metadata banktypes.Metadata, contract common.Address, decimals uint32) error {
+	if err := types.ValidateMetadataOverride(metadata, contract); err != nil {
+		return err
+	}
+
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Denom != metadata.Display {
+			continue
+		}
+
+		if denomUnit.Exponent != decimals {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidMetadataOverride,
+				"display unit %s exponent %d doesn't match the scaled ERC20 decimals %d", denomUnit.Denom, denomUnit.Exponent, decimals,
+			)
+		}
+
+		return nil
+	}
+
+	// NOTE: unreachable as the metadata validation checks that the display unit
+	// is present
+	return sdkerrors.Wrapf(types.ErrInvalidMetadataOverride, "display unit %s not found", metadata.Display)
+}
+
 // ToggleRelay toggles relaying for a given token pair
 func (k Keeper) ToggleRelay(ctx sdk.Context, token string) (types.TokenPair, error) {
 	id := k.GetTokenPairID(ctx, token)
@@ -195,6 +395,10 @@ func (k Keeper) ToggleRelay(ctx sdk.Context, token string) (types.TokenPair, err
 		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "not registered")
 	}
 
+	if k.IsRegistrationPending(ctx, pair.GetERC20Contract()) {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrPendingRegistration, "token %s is within its challenge period", token)
+	}
+
 	pair.Enabled = !pair.Enabled
 
 	k.SetTokenPair(ctx, pair)
@@ -213,6 +417,14 @@ func (k Keeper) UpdateTokenPairERC20(ctx sdk.Context, erc20Addr, newERC20Addr co
 		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "not registered")
 	}
 
+	if k.IsRegistrationPending(ctx, erc20Addr) {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrPendingRegistration, "token %s is within its challenge period", erc20Addr)
+	}
+
+	if k.IsWrappedEVMDenomPair(ctx, pair) {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "wrapped EVM denom contract %s can't be updated", erc20Addr)
+	}
+
 	// Get current stored metadata
 	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, pair.Denom)
 	if !found {
@@ -246,11 +458,12 @@ func (k Keeper) UpdateTokenPairERC20(ctx sdk.Context, erc20Addr, newERC20Addr co
 			continue
 		}
 
-		// once found, check it has the same exponent
-		if denomUnit.Exponent != uint32(erc20Data.Decimals) {
+		// once found, check it has the same exponent, scaled down by the pair
+		// scaling exponent
+		if denomUnit.Exponent+pair.ScalingExponent != uint32(erc20Data.Decimals) {
 			return types.TokenPair{}, sdkerrors.Wrapf(
 				types.ErrInternalTokenPair, "metadata denom unit exponent doesn't match the ERC20 details from %s, expected %d, got %d",
-				pair.Erc20Address, erc20Data.Decimals, denomUnit.Exponent,
+				pair.Erc20Address, int64(erc20Data.Decimals)-int64(pair.ScalingExponent), denomUnit.Exponent,
 			)
 		}
 
@@ -282,5 +495,435 @@ func (k Keeper) UpdateTokenPairERC20(ctx sdk.Context, erc20Addr, newERC20Addr co
 	k.DeleteERC20Map(ctx, erc20Addr)
 	// Add the new address
 	k.SetERC20Map(ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
+	// Move the rate limit to the new address
+	if rateLimit, found := k.GetRateLimit(ctx, erc20Addr); found {
+		k.DeleteRateLimit(ctx, erc20Addr)
+		rateLimit.Erc20Address = pair.Erc20Address
+		k.SetRateLimit(ctx, rateLimit)
+	}
+	// Move the pause to the new address
+	if pause, found := k.GetPairPause(ctx, erc20Addr); found {
+		k.DeletePairPause(ctx, erc20Addr)
+		pause.Erc20Address = pair.Erc20Address
+		k.SetPairPause(ctx, pause)
+	}
+	// Move the conversion fee to the new address
+	if conversionFee, found := k.GetPairConversionFee(ctx, erc20Addr); found {
+		k.DeleteConversionFee(ctx, erc20Addr)
+		conversionFee.Erc20Address = pair.Erc20Address
+		k.SetConversionFee(ctx, conversionFee)
+	}
+	// Move the safety policy to the new address
+	if policy, found := k.GetSafetyPolicy(ctx, erc20Addr); found {
+		k.DeleteSafetyPolicy(ctx, erc20Addr)
+		policy.Erc20Address = pair.Erc20Address
+		k.SetSafetyPolicy(ctx, policy)
+	}
+	// Move the mint cap to the new address
+	if mintCap, found := k.GetMintCap(ctx, erc20Addr); found {
+		k.DeleteMintCap(ctx, erc20Addr)
+		mintCap.Erc20Address = pair.Erc20Address
+		k.SetMintCap(ctx, mintCap)
+	}
+	// Move the conversion statistics to the new address
+	if stats, found := k.GetTokenPairStats(ctx, erc20Addr); found {
+		k.DeleteTokenPairStats(ctx, erc20Addr)
+		stats.Erc20Address = pair.Erc20Address
+		k.SetTokenPairStats(ctx, stats)
+	}
 	return pair, nil
 }
+
+// UpdateCoinMetadata updates the bank metadata of the Cosmos coin of a
+// registered token pair. The metadata must be consistent with the ERC20
+// details of the pair:
+//  - native Cosmos coin pair: the name and symbol of the ERC20 contract are
+//    updated to the metadata ones
+//  - native ERC20 pair: the name and description generated for the contract
+//    on registration can't be modified
+// In both cases the metadata must contain a denom unit with the ERC20 decimals
+// minus the scaling exponent of the pair. The metadata of the wrapped EVM denom
+// can't be updated, as the WEVMOS contract details are immutable.
+func (k Keeper) UpdateCoinMetadata(ctx sdk.Context, coinMetadata banktypes.Metadata) (types.TokenPair, error) {
+	id := k.GetDenomMap(ctx, coinMetadata.Base)
+	if len(id) == 0 {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "coin %s not registered", coinMetadata.Base)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "not registered")
+	}
+
+	if err := coinMetadata.Validate(); err != nil {
+		return types.TokenPair{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "coin metadata is invalid: %s", err)
+	}
+
+	contract := pair.GetERC20Contract()
+	erc20Data, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	if decimals := int64(erc20Data.Decimals) - int64(pair.ScalingExponent); decimals > 0 {
+		found = false
+		for _, denomUnit := range coinMetadata.DenomUnits {
+			if int64(denomUnit.Exponent) == decimals {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return types.TokenPair{}, sdkerrors.Wrapf(
+				types.ErrInternalTokenPair,
+				"metadata doesn't contain a denom unit with the scaled ERC20 decimals %d from %s", decimals, pair.Erc20Address,
+			)
+		}
+	}
+
+	switch {
+	case k.IsWrappedEVMDenomPair(ctx, pair):
+		return types.TokenPair{}, sdkerrors.Wrapf(
+			types.ErrInternalTokenPair,
+			"metadata of the wrapped EVM denom %s can't be modified", pair.Denom,
+		)
+	case pair.IsNativeCoin():
+		if err := k.setERC20Details(ctx, contract, coinMetadata.Name, coinMetadata.Symbol); err != nil {
+			return types.TokenPair{}, err
+		}
+	case pair.IsNativeERC20():
+		if coinMetadata.Name != types.CreateDenom(pair.Erc20Address) ||
+			coinMetadata.Description != types.CreateDenomDescription(pair.Erc20Address) {
+			return types.TokenPair{}, sdkerrors.Wrapf(
+				types.ErrInternalTokenPair,
+				"metadata name and description of ERC20 %s can't be modified", pair.Erc20Address,
+			)
+		}
+	default:
+		return types.TokenPair{}, types.ErrUndefinedOwner
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, coinMetadata)
+	return pair, nil
+}
+
+// setERC20Details sets the name and symbol of an ERC20 contract owned by the
+// module. The ERC20 proxy must point to an implementation with the setName and
+// setSymbol admin functions.
+func (k Keeper) setERC20Details(ctx sdk.Context, contract common.Address, name, symbol string) error {
+	erc20 := contracts.ERC20MetadataContract.ABI
+
+	if _, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "setName", name); err != nil {
+		return sdkerrors.Wrapf(err, "failed to set the name of ERC20 %s, its implementation might need to be upgraded", contract)
+	}
+
+	if _, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "setSymbol", symbol); err != nil {
+		return sdkerrors.Wrapf(err, "failed to set the symbol of ERC20 %s, its implementation might need to be upgraded", contract)
+	}
+
+	// check that the contract stored the details
+	erc20Data, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return err
+	}
+
+	if erc20Data.Name != name || erc20Data.Symbol != symbol {
+		return sdkerrors.Wrapf(
+			types.ErrInternalTokenPair,
+			"ERC20 %s details (%s, %s) don't match the expected ones (%s, %s)",
+			contract, erc20Data.Name, erc20Data.Symbol, name, symbol,
+		)
+	}
+
+	return nil
+}
+
+// DeregisterTokenPair removes a registered token pair along with its lookup
+// maps and settles the escrow of the pair:
+//  - native ERC20 pair: refund the escrowed ERC20 tokens to the given holders
+//    of the Cosmos coin representation, burning their coins. The remaining
+//    escrowed tokens keep backing the coins of the other accounts, which can
+//    be converted again if the contract is registered again.
+//  - native Cosmos coin pair: renounce the minting role of the module account
+//    on the ERC20 contract and tombstone the pair with a snapshot of the
+//    escrowed coins, which governance distributes to the ERC20 holders with a
+//    RecoverTokenPairEscrowProposal
+// The settlement is skipped if the ERC20 contract is suicided. The wrapped EVM
+// denom token pair can't be deregistered.
+func (k Keeper) DeregisterTokenPair(
+	ctx sdk.Context,
+	token string,
+	holders []sdk.AccAddress,
+) (types.TokenPair, types.TokenPairSettlement, error) {
+	settlement := types.NewTokenPairSettlement()
+
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, settlement, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token %s not registered", token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, settlement, sdkerrors.Wrapf(types.ErrInternalTokenPair, "not registered")
+	}
+
+	// pending registrations are rejected through a veto so that the deposit is
+	// burned
+	if k.IsRegistrationPending(ctx, pair.GetERC20Contract()) {
+		return types.TokenPair{}, settlement, sdkerrors.Wrapf(types.ErrPendingRegistration, "token %s is within its challenge period", token)
+	}
+
+	if k.IsWrappedEVMDenomPair(ctx, pair) {
+		return types.TokenPair{}, settlement, sdkerrors.Wrapf(types.ErrInternalTokenPair, "wrapped EVM denom token pair %s can't be deregistered", token)
+	}
+
+	// the balances of a destroyed contract can't be settled, so the escrow is
+	// recorded on a tombstone to be recovered through governance
+	if k.tombstoneSelfDestructed(ctx, pair) {
+		return pair, settlement, nil
+	}
+
+	var err error
+	switch {
+	case pair.IsNativeCoin():
+		settlement, err = k.settleNativeCoin(ctx, pair)
+	case pair.IsNativeERC20():
+		settlement, err = k.settleNativeERC20(ctx, pair, holders)
+	default:
+		err = types.ErrUndefinedOwner
+	}
+
+	if err != nil {
+		return types.TokenPair{}, settlement, sdkerrors.Wrapf(err, "failed to settle token pair %s", pair.Erc20Address)
+	}
+
+	// the ERC20 tokens of a native coin pair can no longer be converted back,
+	// so the coins escrowed for them are recorded on a tombstone
+	if pair.IsNativeCoin() {
+		tombstone := k.TombstoneTokenPair(ctx, pair, "token pair deregistered")
+		settlement.Escrowed = tombstone.Escrow
+		return pair, settlement, nil
+	}
+
+	k.removeTokenPairState(ctx, pair)
+	return pair, settlement, nil
+}
+
+// UpdateRateLimit sets the conversion rate limit of a registered token pair.
+// The rate limit of the pair is removed if all the limits are disabled. The
+// volumes tracked for the pair are reset if the window changes between blocks
+// and epochs.
+func (k Keeper) UpdateRateLimit(
+	ctx sdk.Context,
+	token string,
+	maxPerConversion, inflowCap, outflowCap sdk.Int,
+	window uint64,
+	epochIdentifier string,
+) (types.RateLimit, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.RateLimit{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token %s not registered", token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.RateLimit{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token %s not registered", token)
+	}
+
+	rateLimit := types.NewRateLimit(pair.GetERC20Contract(), maxPerConversion, inflowCap, outflowCap, window, epochIdentifier)
+	if rateLimit.IsEmpty() {
+		k.DeleteRateLimit(ctx, pair.GetERC20Contract())
+		return rateLimit, nil
+	}
+
+	if rateLimit.IsEpochWindow() {
+		if _, found := k.epochsKeeper.GetEpochInfo(ctx, rateLimit.EpochIdentifier); !found {
+			return types.RateLimit{}, sdkerrors.Wrapf(types.ErrInvalidRateLimit, "epoch %s not found", rateLimit.EpochIdentifier)
+		}
+	}
+
+	// the volumes are keyed by block height or epoch number depending on the
+	// window, so they can't be carried over if the unit changes
+	current, found := k.GetRateLimit(ctx, pair.GetERC20Contract())
+	if found && current.EpochIdentifier != rateLimit.EpochIdentifier {
+		k.deleteConversionVolumes(ctx, pair.GetERC20Contract())
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return rateLimit, nil
+}
+
+// UpdateConversionFee sets the conversion fee of a registered token pair. The
+// conversion fee of the pair is removed if it uses the global conversion fee
+// of the module parameters.
+func (k Keeper) UpdateConversionFee(
+	ctx sdk.Context,
+	token string,
+	rate sdk.Dec,
+	minFee sdk.Int,
+	useGlobalFee bool,
+) (types.ConversionFee, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.ConversionFee{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token %s not registered", token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.ConversionFee{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token %s not registered", token)
+	}
+
+	if useGlobalFee {
+		k.DeleteConversionFee(ctx, pair.GetERC20Contract())
+		conversionFee, _ := k.GetConversionFee(ctx, pair)
+		return conversionFee, nil
+	}
+
+	conversionFee := types.NewConversionFee(pair.GetERC20Contract(), rate, minFee)
+	k.SetConversionFee(ctx, conversionFee)
+	return conversionFee, nil
+}
+
+// UpdateMintCap sets the mint cap of a registered token pair owned by an
+// external ERC20 contract. The mint cap of the pair is removed if the cap is
+// zero.
+func (k Keeper) UpdateMintCap(ctx sdk.Context, token string, maxSupply sdk.Int) (types.MintCap, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.MintCap{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token %s not registered", token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.MintCap{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token %s not registered", token)
+	}
+
+	if !pair.IsNativeERC20() {
+		return types.MintCap{}, sdkerrors.Wrapf(
+			types.ErrUndefinedOwner,
+			"mint caps only apply to token pairs owned by an external contract, token pair %s is owned by the module", pair.Erc20Address,
+		)
+	}
+
+	mintCap := types.NewMintCap(pair.GetERC20Contract(), maxSupply)
+	if maxSupply.IsZero() {
+		k.DeleteMintCap(ctx, pair.GetERC20Contract())
+		return mintCap, nil
+	}
+
+	k.SetMintCap(ctx, mintCap)
+	return mintCap, nil
+}
+
+// UpdateSafetyPolicy sets the safety policy of a registered token pair. The
+// safety policy of the pair is removed if it uses the default policy.
+func (k Keeper) UpdateSafetyPolicy(
+	ctx sdk.Context,
+	token string,
+	allowedEventSignatures, allowedEmitters []string,
+	maxLogs uint32,
+	useDefaultPolicy bool,
+) (types.SafetyPolicy, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.SafetyPolicy{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token %s not registered", token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.SafetyPolicy{}, sdkerrors.Wrapf(types.ErrInternalTokenPair, "token %s not registered", token)
+	}
+
+	if useDefaultPolicy {
+		k.DeleteSafetyPolicy(ctx, pair.GetERC20Contract())
+		return types.DefaultSafetyPolicy(pair.GetERC20Contract()), nil
+	}
+
+	policy := types.NewSafetyPolicy(pair.GetERC20Contract(), allowedEventSignatures, allowedEmitters, maxLogs)
+	k.SetSafetyPolicy(ctx, policy)
+	return policy, nil
+}
+
+// settleNativeCoin renounces the minting role of the module account on the
+// ERC20 contract of a native Cosmos coin token pair. The Cosmos coins backing
+// the ERC20 supply remain escrowed on the module account.
+func (k Keeper) settleNativeCoin(ctx sdk.Context, pair types.TokenPair) (types.TokenPairSettlement, error) {
+	settlement := types.NewTokenPairSettlement()
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+	minterRole := crypto.Keccak256Hash([]byte("MINTER_ROLE"))
+
+	_, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "renounceRole", minterRole, types.ModuleAddress)
+	return settlement, err
+}
+
+// settleNativeERC20 refunds the escrowed ERC20 tokens of a native ERC20 token
+// pair to the given holders of its Cosmos coin representation and burns their
+// coins. The coins of module accounts and blocked addresses, e.g. the
+// community pool, are not settled so that the accounting of other modules is
+// kept. The remaining escrowed tokens stay on the module account.
+func (k Keeper) settleNativeERC20(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	holders []sdk.AccAddress,
+) (types.TokenPairSettlement, error) {
+	settlement := types.NewTokenPairSettlement()
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+
+	for _, holder := range holders {
+		if k.bankKeeper.BlockedAddr(holder) || k.isModuleAccount(ctx, holder) {
+			continue
+		}
+
+		balance := k.bankKeeper.GetBalance(ctx, holder, pair.Denom)
+		if !balance.IsPositive() {
+			continue
+		}
+
+		coins := sdk.Coins{balance}
+
+		// Escrow and burn the Cosmos coins of the holder
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, coins); err != nil {
+			return settlement, sdkerrors.Wrap(err, "failed to escrow coins")
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return settlement, sdkerrors.Wrap(err, "failed to burn coins")
+		}
+
+		// Unescrow Tokens and send to holder
+		tokens := pair.CoinsToTokens(balance.Amount)
+		res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, "transfer", common.BytesToAddress(holder.Bytes()), tokens.BigInt())
+		if err != nil {
+			return settlement, err
+		}
+
+		ok, err := transferSucceeded(pair, erc20, res)
+		if err != nil {
+			return settlement, err
+		}
+
+		if !ok {
+			return settlement, sdkerrors.Wrap(sdkerrors.ErrLogic, "failed to execute unescrow tokens to holder")
+		}
+
+		settlement.Refunded = settlement.Refunded.Add(tokens)
+		settlement.Holders++
+	}
+
+	if remaining := k.balanceOf(ctx, erc20, contract, types.ModuleAddress); remaining != nil {
+		settlement.Escrowed = sdk.NewIntFromBigInt(remaining)
+	}
+
+	return settlement, nil
+}
+
+// isModuleAccount returns true if the address belongs to a module account
+func (k Keeper) isModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := k.accountKeeper.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return ok
+}
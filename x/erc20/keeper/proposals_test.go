@@ -2,15 +2,20 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/tharsis/ethermint/tests"
 
 	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
 	inflationtypes "github.com/tharsis/evmos/x/inflation/types"
 )
 
@@ -18,6 +23,8 @@ const (
 	contractMinterBurner = iota + 1
 	contractDirectBalanceManipulation
 	contractMaliciousDelayed
+	contractNoReturn
+	contractRebasing
 )
 
 const (
@@ -32,6 +39,12 @@ const (
 )
 
 func (suite *KeeperTestSuite) setupRegisterERC20Pair(contractType int) common.Address {
+	contractAddr, err := suite.setupRegisterERC20PairWithBehaviour(contractType, types.TOKEN_BEHAVIOUR_STANDARD)
+	suite.Require().NoError(err)
+	return contractAddr
+}
+
+func (suite *KeeperTestSuite) setupRegisterERC20PairWithBehaviour(contractType int, behaviour types.TokenBehaviour) (common.Address, error) {
 	suite.SetupTest()
 
 	var contractAddr common.Address
@@ -41,14 +54,17 @@ func (suite *KeeperTestSuite) setupRegisterERC20Pair(contractType int) common.Ad
 		contractAddr = suite.DeployContractDirectBalanceManipulation(erc20Name, erc20Symbol)
 	case contractMaliciousDelayed:
 		contractAddr = suite.DeployContractMaliciousDelayed(erc20Name, erc20Symbol)
+	case contractNoReturn:
+		contractAddr = suite.DeployContractQuirky(contracts.ERC20NoReturnContract)
+	case contractRebasing:
+		contractAddr = suite.DeployContractQuirky(contracts.ERC20RebasingContract)
 	default:
 		contractAddr = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
 	}
 	suite.Commit()
 
-	_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
-	suite.Require().NoError(err)
-	return contractAddr
+	_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, behaviour, 0, 0, nil)
+	return contractAddr, err
 }
 
 func (suite *KeeperTestSuite) setupRegisterCoin() (banktypes.Metadata, *types.TokenPair) {
@@ -76,7 +92,7 @@ func (suite *KeeperTestSuite) setupRegisterCoin() (banktypes.Metadata, *types.To
 	suite.Require().NoError(err)
 
 	// pair := types.NewTokenPair(contractAddr, cosmosTokenBase, true, types.OWNER_MODULE)
-	pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, validMetadata)
+	pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, validMetadata, 0, 0)
 	suite.Require().NoError(err)
 	suite.Commit()
 	return validMetadata, pair
@@ -160,6 +176,16 @@ func (suite KeeperTestSuite) TestRegisterCoin() {
 			},
 			false,
 		},
+		{
+			"contract already deployed",
+			func() {
+				err := suite.app.BankKeeper.MintCoins(suite.ctx, inflationtypes.ModuleName, sdk.Coins{sdk.NewInt64Coin(metadata.Base, 1)})
+				suite.Require().NoError(err)
+				_, err = suite.app.Erc20Keeper.DeployERC20Contract(suite.ctx, metadata, 0)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
 		{
 			"ok",
 			func() {
@@ -168,6 +194,18 @@ func (suite KeeperTestSuite) TestRegisterCoin() {
 			},
 			true,
 		},
+		{
+			"ok - address doesn't depend on the module account nonce",
+			func() {
+				err := suite.app.BankKeeper.MintCoins(suite.ctx, inflationtypes.ModuleName, sdk.Coins{sdk.NewInt64Coin(metadata.Base, 1)})
+				suite.Require().NoError(err)
+				contractAddr := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.Commit()
+				_, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOUR_STANDARD, 0, 0, nil)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -175,11 +213,11 @@ func (suite KeeperTestSuite) TestRegisterCoin() {
 
 			tc.malleate()
 
-			pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, metadata)
+			pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, metadata, 0, 0)
 			suite.Commit()
 
 			expPair := &types.TokenPair{
-				Erc20Address:  "0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd",
+				Erc20Address:  "0xe6E8EA4c8A1933ee1f30f88a9D4484ECe0A1e073",
 				Denom:         "acoin",
 				Enabled:       true,
 				ContractOwner: 1,
@@ -231,7 +269,7 @@ func (suite KeeperTestSuite) TestRegisterERC20() {
 		{
 			"meta data already stored",
 			func() {
-				suite.app.Erc20Keeper.CreateCoinMetadata(suite.ctx, contractAddr)
+				suite.app.Erc20Keeper.CreateCoinMetadata(suite.ctx, contractAddr, 0, nil)
 			},
 			false,
 		},
@@ -252,7 +290,7 @@ func (suite KeeperTestSuite) TestRegisterERC20() {
 
 			tc.malleate()
 
-			_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+			_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOUR_STANDARD, 0, 0, nil)
 			metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, coinName)
 			if tc.expPass {
 				suite.Require().NoError(err, tc.name)
@@ -276,6 +314,139 @@ func (suite KeeperTestSuite) TestRegisterERC20() {
 	}
 }
 
+func (suite KeeperTestSuite) TestRegisterERC20MetadataOverride() {
+	var (
+		contractAddr common.Address
+		metadata     banktypes.Metadata
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"ok",
+			func() {},
+			true,
+		},
+		{
+			"ok - bytes32 name and symbol",
+			func() {
+				contractAddr = suite.DeployContractQuirky(contracts.ERC20Bytes32Contract)
+				suite.Commit()
+				metadata.Base = types.CreateDenom(contractAddr.String())
+				metadata.DenomUnits[0].Denom = metadata.Base
+				metadata.DenomUnits[1].Exponent = 18
+			},
+			true,
+		},
+		{
+			"fail - base is not the erc20 denomination",
+			func() {
+				metadata.Base = "weth"
+				metadata.DenomUnits[0].Denom = "weth"
+			},
+			false,
+		},
+		{
+			"fail - display exponent doesn't match the ERC20 decimals",
+			func() {
+				metadata.DenomUnits[1].Exponent = 18
+			},
+			false,
+		},
+		{
+			"fail - invalid metadata",
+			func() {
+				metadata.Display = "unknown"
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			contractAddr = suite.DeployContract(erc20Name, erc20Symbol, cosmosDecimals)
+			suite.Commit()
+			coinName := types.CreateDenom(contractAddr.String())
+			metadata = banktypes.Metadata{
+				Description: "Wrapped Ether bridged from Polygon",
+				Base:        coinName,
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: coinName, Exponent: 0},
+					{Denom: "weth", Exponent: uint32(cosmosDecimals)},
+				},
+				Name:    "Wrapped Ether (PoS)",
+				Symbol:  "WETH",
+				Display: "weth",
+			}
+
+			tc.malleate()
+
+			pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOUR_STANDARD, 0, 0, &metadata)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(metadata.Base, pair.Denom)
+
+				res, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, pair.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(metadata, res)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidMetadataOverride, tc.name)
+				suite.Require().False(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, contractAddr))
+			}
+		})
+	}
+}
+
+func (suite KeeperTestSuite) TestRegisterERC20Bytes32() {
+	suite.SetupTest()
+
+	contractAddr := suite.DeployContractQuirky(contracts.ERC20Bytes32Contract)
+	suite.Commit()
+
+	pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOUR_STANDARD, 0, 0, nil)
+	suite.Require().NoError(err)
+
+	metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, pair.Denom)
+	suite.Require().True(found)
+	suite.Require().Equal("MKR", metadata.Symbol)
+	suite.Require().Equal("maker", metadata.Display)
+	suite.Require().Equal(uint32(18), metadata.DenomUnits[1].Exponent)
+}
+
+func (suite KeeperTestSuite) TestRegisterERC20Behaviour() {
+	testCases := []struct {
+		name         string
+		contractType int
+		behaviour    types.TokenBehaviour
+		expPass      bool
+	}{
+		{"ok - standard", contractMinterBurner, types.TOKEN_BEHAVIOUR_STANDARD, true},
+		{"ok - safe transfer", contractNoReturn, types.TOKEN_BEHAVIOUR_SAFE_TRANSFER, true},
+		{"ok - fee on transfer", contractDirectBalanceManipulation, types.TOKEN_BEHAVIOUR_FEE_ON_TRANSFER, true},
+		{"fail - rebasing", contractRebasing, types.TOKEN_BEHAVIOUR_REBASING, false},
+		{"fail - unknown behaviour", contractMinterBurner, types.TokenBehaviour(10), false},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			contractAddr, err := suite.setupRegisterERC20PairWithBehaviour(tc.contractType, tc.behaviour)
+			id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contractAddr.String())
+			pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.behaviour, pair.Behaviour)
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
 func (suite KeeperTestSuite) TestToggleRelay() {
 	var (
 		contractAddr common.Address
@@ -519,3 +690,417 @@ func (suite KeeperTestSuite) TestUpdateTokenPairERC20() {
 		})
 	}
 }
+
+func (suite KeeperTestSuite) TestUpdateCoinMetadata() {
+	var (
+		metadata banktypes.Metadata
+		contract common.Address
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"coin not registered",
+			func() {
+				metadata, _ = suite.setupRegisterCoin()
+				metadata.Base = "aother"
+				metadata.DenomUnits = []*banktypes.DenomUnit{{Denom: "aother"}}
+				metadata.Display = "aother"
+			},
+			false,
+		},
+		{
+			"invalid metadata",
+			func() {
+				metadata, _ = suite.setupRegisterCoin()
+				metadata.Symbol = ""
+			},
+			false,
+		},
+		{
+			"native coin - implementation without admin functions",
+			func() {
+				var pair *types.TokenPair
+				metadata, pair = suite.setupRegisterCoin()
+				contract = pair.GetERC20Contract()
+				_, err := suite.app.Erc20Keeper.UpgradeERC20Implementation(suite.ctx, contracts.ERC20PermitImplementation, nil)
+				suite.Require().NoError(err)
+				metadata.Name = "Coin Token Renamed"
+			},
+			false,
+		},
+		{
+			"native coin - ok",
+			func() {
+				var pair *types.TokenPair
+				metadata, pair = suite.setupRegisterCoin()
+				contract = pair.GetERC20Contract()
+				metadata.Name = "Coin Token Renamed"
+				metadata.Symbol = "RCTKN"
+			},
+			true,
+		},
+		{
+			"native coin - ok with long name",
+			func() {
+				var pair *types.TokenPair
+				metadata, pair = suite.setupRegisterCoin()
+				contract = pair.GetERC20Contract()
+				metadata.Name = strings.Repeat("Coin Token Renamed ", 5)
+			},
+			true,
+		},
+		{
+			"native ERC20 - no denom unit with the ERC20 decimals",
+			func() {
+				contract = suite.setupRegisterERC20Pair(contractMinterBurner)
+				metadata, _ = suite.app.BankKeeper.GetDenomMetaData(suite.ctx, types.CreateDenom(contract.String()))
+				metadata.DenomUnits = metadata.DenomUnits[:1]
+				metadata.Display = metadata.Base
+			},
+			false,
+		},
+		{
+			"native ERC20 - name modified",
+			func() {
+				contract = suite.setupRegisterERC20Pair(contractMinterBurner)
+				metadata, _ = suite.app.BankKeeper.GetDenomMetaData(suite.ctx, types.CreateDenom(contract.String()))
+				metadata.Name = erc20Name
+			},
+			false,
+		},
+		{
+			"native ERC20 - ok",
+			func() {
+				contract = suite.setupRegisterERC20Pair(contractMinterBurner)
+				metadata, _ = suite.app.BankKeeper.GetDenomMetaData(suite.ctx, types.CreateDenom(contract.String()))
+				metadata.Symbol = "RCTKN"
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			prevMetadata, _ := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, metadata.Base)
+
+			pair, err := suite.app.Erc20Keeper.UpdateCoinMetadata(suite.ctx, metadata)
+			storedMetadata, _ := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, metadata.Base)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(metadata, storedMetadata)
+
+				erc20Data, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, contract)
+				suite.Require().NoError(err)
+				if pair.IsNativeCoin() {
+					suite.Require().Equal(metadata.Name, erc20Data.Name)
+					suite.Require().Equal(metadata.Symbol, erc20Data.Symbol)
+				} else {
+					suite.Require().Equal(erc20Name, erc20Data.Name)
+					suite.Require().Equal(erc20Symbol, erc20Data.Symbol)
+				}
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Equal(prevMetadata, storedMetadata)
+			}
+		})
+	}
+}
+
+func (suite KeeperTestSuite) TestUpdateCoinMetadataShorterName() {
+	metadata, pair := suite.setupRegisterCoin()
+
+	// a short string must replace a long one
+	metadata.Name = strings.Repeat("Coin Token Renamed ", 5)
+	_, err := suite.app.Erc20Keeper.UpdateCoinMetadata(suite.ctx, metadata)
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata.Name, suite.NameOf(pair.GetERC20Contract()))
+
+	metadata.Name = "Coin"
+	_, err = suite.app.Erc20Keeper.UpdateCoinMetadata(suite.ctx, metadata)
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata.Name, suite.NameOf(pair.GetERC20Contract()))
+}
+
+func (suite KeeperTestSuite) TestDeregisterTokenPair() {
+	var (
+		contractAddr common.Address
+		pair         types.TokenPair
+		sender       sdk.AccAddress
+		holders      []sdk.AccAddress
+	)
+
+	minterRole := crypto.Keccak256Hash([]byte("MINTER_ROLE"))
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		expPass       bool
+		expSettlement func() types.TokenPairSettlement
+		postCheck     func()
+	}{
+		{
+			"token not registered",
+			func() {
+				contractAddr = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+				suite.Commit()
+				pair = types.NewTokenPair(contractAddr, cosmosTokenBase, true, types.OWNER_MODULE)
+			},
+			false,
+			nil,
+			func() {},
+		},
+		{
+			"ok - native coin, minting role renounced",
+			func() {
+				_, p := suite.setupRegisterCoin()
+				pair = *p
+				contractAddr = pair.GetERC20Contract()
+				sender = sdk.AccAddress(suite.address.Bytes())
+
+				coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 100))
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+				suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+
+				msg := types.NewMsgConvertCoin(sdk.NewInt64Coin(cosmosTokenBase, 10), suite.address, sender)
+				_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+				suite.Commit()
+			},
+			true,
+			func() types.TokenPairSettlement {
+				settlement := types.NewTokenPairSettlement()
+				settlement.Escrowed = sdk.NewInt(10)
+				return settlement
+			},
+			func() {
+				erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+				res, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, types.ModuleAddress, contractAddr, "hasRole", minterRole, types.ModuleAddress)
+				suite.Require().NoError(err)
+
+				var hasRole types.ERC20BoolResponse
+				suite.Require().NoError(erc20.UnpackIntoInterface(&hasRole, "hasRole", res.Ret))
+				suite.Require().False(hasRole.Value)
+
+				// ERC20 holders keep their tokens
+				balance := suite.BalanceOf(contractAddr, suite.address)
+				suite.Require().Equal(int64(10), balance.(*big.Int).Int64())
+
+				// the escrow is recoverable from the tombstone of the pair
+				tombstone, found := suite.app.Erc20Keeper.GetTokenPairTombstone(suite.ctx, contractAddr)
+				suite.Require().True(found)
+				suite.Require().Equal(sdk.NewInt(10), tombstone.Escrow)
+				suite.Require().False(tombstone.Recovered)
+
+				_, err = suite.app.Erc20Keeper.ConvertCoin(
+					sdk.WrapSDKContext(suite.ctx),
+					types.NewMsgConvertCoin(sdk.NewInt64Coin(cosmosTokenBase, 10), suite.address, sender),
+				)
+				suite.Require().ErrorIs(err, types.ErrTokenPairTombstone)
+
+				shares := []types.EscrowRecoveryShare{{Address: suite.address.Hex(), Amount: sdk.NewInt(10)}}
+				_, _, err = suite.app.Erc20Keeper.RecoverTokenPairEscrow(suite.ctx, contractAddr, shares)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewInt(100), suite.app.BankKeeper.GetBalance(suite.ctx, sender, cosmosTokenBase).Amount)
+			},
+		},
+		{
+			"ok - native ERC20, escrow refunded to the listed coin holders",
+			func() {
+				contractAddr = suite.setupRegisterERC20Pair(contractMinterBurner)
+				id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contractAddr.String())
+				pair, _ = suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+				sender = sdk.AccAddress(suite.address.Bytes())
+
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+				suite.Commit()
+
+				msg := types.NewMsgConvertERC20(sdk.NewInt(10), sender, contractAddr, suite.address)
+				_, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+
+				// coins held by the community pool are not settled
+				err = suite.app.DistrKeeper.FundCommunityPool(suite.ctx, sdk.NewCoins(sdk.NewInt64Coin(pair.Denom, 3)), sender)
+				suite.Require().NoError(err)
+
+				// escrow tokens sent directly to the module account stay escrowed
+				// NOTE: the keeper EVM call doesn't trigger the EVM hook
+				erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+				_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, erc20, suite.address, contractAddr, "transfer", types.ModuleAddress, big.NewInt(5))
+				suite.Require().NoError(err)
+				suite.Commit()
+
+				holders = []sdk.AccAddress{
+					sender,
+					suite.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName),
+					sdk.AccAddress(tests.GenerateAddress().Bytes()),
+				}
+			},
+			true,
+			func() types.TokenPairSettlement {
+				settlement := types.NewTokenPairSettlement()
+				settlement.Refunded = sdk.NewInt(7)
+				settlement.Holders = 1
+				settlement.Escrowed = sdk.NewInt(8)
+				return settlement
+			},
+			func() {
+				balance := suite.BalanceOf(contractAddr, suite.address)
+				suite.Require().Equal(int64(92), balance.(*big.Int).Int64())
+				balance = suite.BalanceOf(contractAddr, types.ModuleAddress)
+				suite.Require().Equal(int64(8), balance.(*big.Int).Int64())
+
+				coin := suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom)
+				suite.Require().True(coin.IsZero())
+
+				// the community pool is kept in sync with the distribution
+				// module balance
+				distrAddr := suite.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+				suite.Require().Equal(sdk.NewInt(3), suite.app.BankKeeper.GetBalance(suite.ctx, distrAddr, pair.Denom).Amount)
+				suite.Require().Equal(sdk.NewDec(3), suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(pair.Denom))
+				suite.Require().Equal(sdk.NewInt(3), suite.app.BankKeeper.GetSupply(suite.ctx, pair.Denom).Amount)
+			},
+		},
+		{
+			"ok - suicided contract, settlement skipped",
+			func() {
+				contractAddr = suite.setupRegisterERC20Pair(contractMinterBurner)
+				id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contractAddr.String())
+				pair, _ = suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+
+				stateDb := suite.StateDB()
+				ok := stateDb.Suicide(contractAddr)
+				suite.Require().True(ok)
+				suite.Require().NoError(stateDb.Commit())
+			},
+			true,
+			types.NewTokenPairSettlement,
+			func() {},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+			holders = nil
+
+			tc.malleate()
+
+			deregistered, settlement, err := suite.app.Erc20Keeper.DeregisterTokenPair(suite.ctx, contractAddr.String(), holders)
+			suite.Commit()
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(pair, deregistered)
+				expSettlement := tc.expSettlement()
+				suite.Require().Equal(expSettlement.Refunded.String(), settlement.Refunded.String())
+				suite.Require().Equal(expSettlement.Holders, settlement.Holders)
+				suite.Require().Equal(expSettlement.Escrowed.String(), settlement.Escrowed.String())
+
+				suite.Require().False(suite.app.Erc20Keeper.IsTokenPairRegistered(suite.ctx, pair.GetID()))
+				suite.Require().False(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, contractAddr))
+				suite.Require().False(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, pair.Denom))
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+
+			tc.postCheck()
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestUpdateRateLimit() {
+	var pair *types.TokenPair
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		maxPerConv int64
+		expFound   bool
+		expPass    bool
+	}{
+		{
+			"token not registered",
+			func() {
+				pair.Denom = "unregistered"
+			},
+			10,
+			false,
+			false,
+		},
+		{
+			"ok - set rate limit",
+			func() {},
+			10,
+			true,
+			true,
+		},
+		{
+			"ok - remove rate limit",
+			func() {
+				_, err := suite.app.Erc20Keeper.UpdateRateLimit(suite.ctx, pair.Denom, sdk.NewInt(10), sdk.ZeroInt(), sdk.ZeroInt(), 0, "")
+				suite.Require().NoError(err)
+			},
+			0,
+			false,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			_, pair = suite.setupRegisterCoin()
+			suite.Require().NotNil(pair)
+			contract := pair.GetERC20Contract()
+
+			tc.malleate()
+
+			rateLimit, err := suite.app.Erc20Keeper.UpdateRateLimit(suite.ctx, pair.Denom, sdk.NewInt(tc.maxPerConv), sdk.ZeroInt(), sdk.ZeroInt(), 0, "")
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(contract.String(), rateLimit.Erc20Address)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+
+			stored, found := suite.app.Erc20Keeper.GetRateLimit(suite.ctx, contract)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(tc.maxPerConv, stored.MaxPerConversion.Int64())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateRateLimitEpochWindow() {
+	suite.SetupTest()
+	_, pair := suite.setupRegisterCoin()
+	contract := pair.GetERC20Contract()
+
+	rateLimit, err := suite.app.Erc20Keeper.UpdateRateLimit(suite.ctx, pair.Denom, sdk.ZeroInt(), sdk.NewInt(100), sdk.ZeroInt(), 10, "")
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, *pair, sdk.NewInt(50), types.ConversionInflow))
+
+	// the epoch must exist to measure the window
+	_, err = suite.app.Erc20Keeper.UpdateRateLimit(suite.ctx, pair.Denom, sdk.ZeroInt(), sdk.NewInt(100), sdk.ZeroInt(), 2, "hour")
+	suite.Require().ErrorIs(err, types.ErrInvalidRateLimit)
+	suite.Require().Equal(int64(50), suite.app.Erc20Keeper.GetConversionVolume(suite.ctx, rateLimit).Inflow.Int64())
+
+	// the volumes tracked by block are reset
+	suite.setEpoch("hour", 1)
+	rateLimit, err = suite.app.Erc20Keeper.UpdateRateLimit(suite.ctx, pair.Denom, sdk.ZeroInt(), sdk.NewInt(100), sdk.ZeroInt(), 2, "hour")
+	suite.Require().NoError(err)
+	suite.Require().Equal("hour", rateLimit.EpochIdentifier)
+	suite.Require().True(suite.app.Erc20Keeper.GetConversionVolume(suite.ctx, rateLimit).Inflow.IsZero())
+
+	stored, found := suite.app.Erc20Keeper.GetRateLimit(suite.ctx, contract)
+	suite.Require().True(found)
+	suite.Require().Equal(rateLimit, stored)
+}
@@ -0,0 +1,257 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// GetAllRateLimits returns all the conversion rate limits stored
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRateLimit)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+// GetRateLimit returns the conversion rate limit of the token pair with the
+// given ERC20 contract
+func (k Keeper) GetRateLimit(ctx sdk.Context, contract common.Address) (types.RateLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	var rateLimit types.RateLimit
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.RateLimit{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// SetRateLimit stores the conversion rate limit of a token pair
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	store.Set(rateLimit.GetERC20Contract().Bytes(), bz)
+}
+
+// DeleteRateLimit removes the conversion rate limit of the token pair with the
+// given ERC20 contract, together with its tracked conversion volumes
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	store.Delete(contract.Bytes())
+
+	k.deleteConversionVolumes(ctx, contract)
+}
+
+// deleteConversionVolumes removes the tracked conversion volumes of the token
+// pair with the given ERC20 contract
+func (k Keeper) deleteConversionVolumes(ctx sdk.Context, contract common.Address) {
+	volumeStore := k.conversionVolumeStore(ctx, contract)
+	iterator := volumeStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		volumeStore.Delete(key)
+	}
+}
+
+// GetConversionVolume returns the amounts converted for the token pair of the
+// given rate limit within the window of blocks or epochs that ends on the
+// current one
+func (k Keeper) GetConversionVolume(ctx sdk.Context, rateLimit types.RateLimit) types.ConversionVolume {
+	volume := types.NewConversionVolume()
+
+	store := k.conversionVolumeStore(ctx, rateLimit.GetERC20Contract())
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(k.windowStart(ctx, rateLimit)), nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var blockVolume types.ConversionVolume
+		k.cdc.MustUnmarshal(iterator.Value(), &blockVolume)
+
+		volume.Inflow = volume.Inflow.Add(blockVolume.Inflow)
+		volume.Outflow = volume.Outflow.Add(blockVolume.Outflow)
+	}
+
+	return volume
+}
+
+// GetRateLimitCapacity returns the volume converted within the current window
+// and the remaining capacity of the given rate limit
+func (k Keeper) GetRateLimitCapacity(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimitCapacity {
+	volume := types.NewConversionVolume()
+	if rateLimit.Window > 0 {
+		volume = k.GetConversionVolume(ctx, rateLimit)
+	}
+
+	return types.NewRateLimitCapacity(rateLimit, volume)
+}
+
+// ConsumeRateLimit checks that the conversion of the given amount on the given
+// direction doesn't exceed the rate limit of the token pair and tracks the
+// amount converted within the current window. It's a no-op if the token pair
+// has no rate limit.
+func (k Keeper) ConsumeRateLimit(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	amount sdk.Int,
+	direction types.ConversionDirection,
+) error {
+	contract := pair.GetERC20Contract()
+
+	rateLimit, found := k.GetRateLimit(ctx, contract)
+	if !found {
+		return nil
+	}
+
+	if !rateLimit.MaxPerConversion.IsZero() && amount.GT(rateLimit.MaxPerConversion) {
+		return sdkerrors.Wrapf(
+			types.ErrRateLimitExceeded,
+			"amount %s is greater than the maximum per conversion %s of token pair %s",
+			amount, rateLimit.MaxPerConversion, pair.Erc20Address,
+		)
+	}
+
+	if rateLimit.Window == 0 {
+		return nil
+	}
+
+	k.pruneConversionVolumes(ctx, rateLimit)
+
+	volume := k.GetConversionVolume(ctx, rateLimit).Add(amount, direction)
+
+	switch {
+	case direction == types.ConversionInflow && !rateLimit.InflowCap.IsZero() && volume.Inflow.GT(rateLimit.InflowCap):
+		return sdkerrors.Wrapf(
+			types.ErrRateLimitExceeded,
+			"inflow %s is greater than the inflow cap %s of token pair %s within %s",
+			volume.Inflow, rateLimit.InflowCap, pair.Erc20Address, rateLimit.WindowString(),
+		)
+	case direction == types.ConversionOutflow && !rateLimit.OutflowCap.IsZero() && volume.Outflow.GT(rateLimit.OutflowCap):
+		return sdkerrors.Wrapf(
+			types.ErrRateLimitExceeded,
+			"outflow %s is greater than the outflow cap %s of token pair %s within %s",
+			volume.Outflow, rateLimit.OutflowCap, pair.Erc20Address, rateLimit.WindowString(),
+		)
+	}
+
+	period := k.currentPeriod(ctx, rateLimit)
+	periodVolume := k.GetPeriodConversionVolume(ctx, contract, period).Add(amount, direction)
+	k.SetPeriodConversionVolume(ctx, contract, period, periodVolume)
+
+	return nil
+}
+
+// GetAllPeriodConversionVolumes returns the amounts converted for the rate
+// limited token pairs on each tracked block height or epoch number
+func (k Keeper) GetAllPeriodConversionVolumes(ctx sdk.Context) []types.PeriodConversionVolume {
+	volumes := []types.PeriodConversionVolume{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixConversionVolume)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var volume types.ConversionVolume
+		k.cdc.MustUnmarshal(iterator.Value(), &volume)
+
+		// the key is {contract}{period}
+		key := iterator.Key()[len(types.KeyPrefixConversionVolume):]
+		contract := common.BytesToAddress(key[:common.AddressLength])
+		period := sdk.BigEndianToUint64(key[common.AddressLength:])
+
+		volumes = append(volumes, types.NewPeriodConversionVolume(contract, period, volume))
+	}
+
+	return volumes
+}
+
+// GetPeriodConversionVolume returns the amounts converted for the token pair
+// with the given ERC20 contract on the given block height or epoch number
+func (k Keeper) GetPeriodConversionVolume(ctx sdk.Context, contract common.Address, period uint64) types.ConversionVolume {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionVolume)
+	bz := store.Get(types.ConversionVolumeKey(contract, period))
+	if len(bz) == 0 {
+		return types.NewConversionVolume()
+	}
+
+	var volume types.ConversionVolume
+	k.cdc.MustUnmarshal(bz, &volume)
+	return volume
+}
+
+// SetPeriodConversionVolume stores the amounts converted for the token pair
+// with the given ERC20 contract on the given block height or epoch number
+func (k Keeper) SetPeriodConversionVolume(ctx sdk.Context, contract common.Address, period uint64, volume types.ConversionVolume) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixConversionVolume)
+	bz := k.cdc.MustMarshal(&volume)
+	store.Set(types.ConversionVolumeKey(contract, period), bz)
+}
+
+// pruneConversionVolumes removes the conversion volumes of the token pair of
+// the given rate limit that are outside of the current window
+func (k Keeper) pruneConversionVolumes(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := k.conversionVolumeStore(ctx, rateLimit.GetERC20Contract())
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(k.windowStart(ctx, rateLimit)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// conversionVolumeStore returns the store of the conversion volumes of the
+// token pair with the given ERC20 contract, keyed by block height or epoch
+// number
+func (k Keeper) conversionVolumeStore(ctx sdk.Context, contract common.Address) prefix.Store {
+	return prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefixConversionVolume, contract.Bytes()...),
+	)
+}
+
+// currentPeriod returns the current block height, or the current epoch number
+// if the window of the rate limit is measured in epochs
+func (k Keeper) currentPeriod(ctx sdk.Context, rateLimit types.RateLimit) uint64 {
+	if rateLimit.IsEpochWindow() {
+		epoch, _ := k.epochsKeeper.GetEpochInfo(ctx, rateLimit.EpochIdentifier)
+		return uint64(epoch.CurrentEpoch)
+	}
+
+	return uint64(ctx.BlockHeight())
+}
+
+// windowStart returns the first block height or epoch number of the window of
+// the rate limit that ends on the current one
+func (k Keeper) windowStart(ctx sdk.Context, rateLimit types.RateLimit) uint64 {
+	period := k.currentPeriod(ctx, rateLimit)
+	if period < rateLimit.Window {
+		return 0
+	}
+
+	return period - rateLimit.Window + 1
+}
@@ -0,0 +1,412 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/tharsis/ethermint/tests"
+
+	epochstypes "github.com/tharsis/evmos/x/epochs/types"
+	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
+)
+
+func (suite *KeeperTestSuite) TestGetAllRateLimits() {
+	suite.SetupTest()
+
+	rateLimits := suite.app.Erc20Keeper.GetAllRateLimits(suite.ctx)
+	suite.Require().Empty(rateLimits)
+
+	rl := types.NewRateLimit(tests.GenerateAddress(), sdk.NewInt(10), sdk.NewInt(100), sdk.NewInt(100), 10, "")
+	rl2 := types.NewRateLimit(tests.GenerateAddress(), sdk.NewInt(20), sdk.NewInt(200), sdk.NewInt(200), 20, "")
+	suite.app.Erc20Keeper.SetRateLimit(suite.ctx, rl)
+	suite.app.Erc20Keeper.SetRateLimit(suite.ctx, rl2)
+
+	rateLimits = suite.app.Erc20Keeper.GetAllRateLimits(suite.ctx)
+	suite.Require().Len(rateLimits, 2)
+
+	suite.app.Erc20Keeper.DeleteRateLimit(suite.ctx, rl.GetERC20Contract())
+	rateLimits = suite.app.Erc20Keeper.GetAllRateLimits(suite.ctx)
+	suite.Require().Len(rateLimits, 1)
+	suite.Require().True(rl2.Equal(rateLimits[0]))
+}
+
+func (suite *KeeperTestSuite) TestConsumeRateLimit() {
+	var pair types.TokenPair
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		amount    int64
+		direction types.ConversionDirection
+		expPass   bool
+	}{
+		{
+			"ok - no rate limit",
+			func() {},
+			1000,
+			types.ConversionInflow,
+			true,
+		},
+		{
+			"ok - max per conversion",
+			func() {
+				rl := types.NewRateLimit(pair.GetERC20Contract(), sdk.NewInt(10), sdk.ZeroInt(), sdk.ZeroInt(), 0, "")
+				suite.app.Erc20Keeper.SetRateLimit(suite.ctx, rl)
+			},
+			10,
+			types.ConversionInflow,
+			true,
+		},
+		{
+			"fail - max per conversion exceeded",
+			func() {
+				rl := types.NewRateLimit(pair.GetERC20Contract(), sdk.NewInt(10), sdk.ZeroInt(), sdk.ZeroInt(), 0, "")
+				suite.app.Erc20Keeper.SetRateLimit(suite.ctx, rl)
+			},
+			11,
+			types.ConversionOutflow,
+			false,
+		},
+		{
+			"ok - inflow cap with previous conversions",
+			func() {
+				rl := types.NewRateLimit(pair.GetERC20Contract(), sdk.ZeroInt(), sdk.NewInt(100), sdk.ZeroInt(), 10, "")
+				suite.app.Erc20Keeper.SetRateLimit(suite.ctx, rl)
+				err := suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, pair, sdk.NewInt(60), types.ConversionInflow)
+				suite.Require().NoError(err)
+			},
+			40,
+			types.ConversionInflow,
+			true,
+		},
+		{
+			"fail - inflow cap exceeded",
+			func() {
+				rl := types.NewRateLimit(pair.GetERC20Contract(), sdk.ZeroInt(), sdk.NewInt(100), sdk.ZeroInt(), 10, "")
+				suite.app.Erc20Keeper.SetRateLimit(suite.ctx, rl)
+				err := suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, pair, sdk.NewInt(60), types.ConversionInflow)
+				suite.Require().NoError(err)
+			},
+			41,
+			types.ConversionInflow,
+			false,
+		},
+		{
+			"ok - inflow doesn't count towards the outflow cap",
+			func() {
+				rl := types.NewRateLimit(pair.GetERC20Contract(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(100), 10, "")
+				suite.app.Erc20Keeper.SetRateLimit(suite.ctx, rl)
+				err := suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, pair, sdk.NewInt(1000), types.ConversionInflow)
+				suite.Require().NoError(err)
+			},
+			100,
+			types.ConversionOutflow,
+			true,
+		},
+		{
+			"fail - outflow cap exceeded within the window",
+			func() {
+				rl := types.NewRateLimit(pair.GetERC20Contract(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(100), 3, "")
+				suite.app.Erc20Keeper.SetRateLimit(suite.ctx, rl)
+				err := suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, pair, sdk.NewInt(60), types.ConversionOutflow)
+				suite.Require().NoError(err)
+				suite.Commit()
+				suite.Commit()
+			},
+			41,
+			types.ConversionOutflow,
+			false,
+		},
+		{
+			"ok - outflow cap reset after the window",
+			func() {
+				rl := types.NewRateLimit(pair.GetERC20Contract(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(100), 3, "")
+				suite.app.Erc20Keeper.SetRateLimit(suite.ctx, rl)
+				err := suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, pair, sdk.NewInt(60), types.ConversionOutflow)
+				suite.Require().NoError(err)
+				suite.Commit()
+				suite.Commit()
+				suite.Commit()
+			},
+			100,
+			types.ConversionOutflow,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			pair = types.NewTokenPair(tests.GenerateAddress(), "coin", true, types.OWNER_MODULE)
+
+			tc.malleate()
+
+			err := suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, pair, sdk.NewInt(tc.amount), tc.direction)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetConversionVolume() {
+	suite.SetupTest()
+	pair := types.NewTokenPair(tests.GenerateAddress(), "coin", true, types.OWNER_MODULE)
+	contract := pair.GetERC20Contract()
+
+	rl := types.NewRateLimit(contract, sdk.ZeroInt(), sdk.NewInt(1000), sdk.NewInt(1000), 2, "")
+	suite.app.Erc20Keeper.SetRateLimit(suite.ctx, rl)
+
+	err := suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, pair, sdk.NewInt(10), types.ConversionInflow)
+	suite.Require().NoError(err)
+	err = suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, pair, sdk.NewInt(5), types.ConversionOutflow)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	err = suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, pair, sdk.NewInt(20), types.ConversionInflow)
+	suite.Require().NoError(err)
+
+	volume := suite.app.Erc20Keeper.GetConversionVolume(suite.ctx, rl)
+	suite.Require().Equal(int64(30), volume.Inflow.Int64())
+	suite.Require().Equal(int64(5), volume.Outflow.Int64())
+
+	capacity := suite.app.Erc20Keeper.GetRateLimitCapacity(suite.ctx, rl)
+	suite.Require().Equal(int64(970), capacity.RemainingInflow.Int64())
+	suite.Require().Equal(int64(995), capacity.RemainingOutflow.Int64())
+
+	// first block falls outside of the window
+	suite.Commit()
+	volume = suite.app.Erc20Keeper.GetConversionVolume(suite.ctx, rl)
+	suite.Require().Equal(int64(20), volume.Inflow.Int64())
+	suite.Require().Equal(int64(0), volume.Outflow.Int64())
+
+	// volumes are removed together with the rate limit
+	suite.app.Erc20Keeper.DeleteRateLimit(suite.ctx, contract)
+	volume = suite.app.Erc20Keeper.GetConversionVolume(suite.ctx, rl)
+	suite.Require().True(volume.Inflow.IsZero())
+}
+
+// setEpoch sets the current number of the epoch with the given identifier
+func (suite *KeeperTestSuite) setEpoch(identifier string, number int64) {
+	suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, epochstypes.EpochInfo{
+		Identifier:            identifier,
+		StartTime:             suite.ctx.BlockTime(),
+		Duration:              time.Hour,
+		CurrentEpoch:          number,
+		CurrentEpochStartTime: suite.ctx.BlockTime(),
+		EpochCountingStarted:  true,
+	})
+}
+
+func (suite *KeeperTestSuite) TestGetConversionVolumeEpochWindow() {
+	suite.SetupTest()
+	pair := types.NewTokenPair(tests.GenerateAddress(), "coin", true, types.OWNER_MODULE)
+
+	suite.setEpoch("hour", 1)
+
+	rl := types.NewRateLimit(pair.GetERC20Contract(), sdk.ZeroInt(), sdk.NewInt(100), sdk.ZeroInt(), 2, "hour")
+	suite.app.Erc20Keeper.SetRateLimit(suite.ctx, rl)
+
+	err := suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, pair, sdk.NewInt(60), types.ConversionInflow)
+	suite.Require().NoError(err)
+
+	// the window isn't measured in blocks
+	suite.Commit()
+	suite.Commit()
+	err = suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, pair, sdk.NewInt(30), types.ConversionInflow)
+	suite.Require().NoError(err)
+	err = suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, pair, sdk.NewInt(30), types.ConversionInflow)
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	// the first epoch is still within the window
+	suite.setEpoch("hour", 2)
+	volume := suite.app.Erc20Keeper.GetConversionVolume(suite.ctx, rl)
+	suite.Require().Equal(int64(90), volume.Inflow.Int64())
+
+	// the first epoch falls outside of the window
+	suite.setEpoch("hour", 3)
+	err = suite.app.Erc20Keeper.ConsumeRateLimit(suite.ctx, pair, sdk.NewInt(70), types.ConversionInflow)
+	suite.Require().NoError(err)
+
+	capacity := suite.app.Erc20Keeper.GetRateLimitCapacity(suite.ctx, rl)
+	suite.Require().Equal(int64(70), capacity.Inflow.Int64())
+	suite.Require().Equal(int64(30), capacity.RemainingInflow.Int64())
+	suite.Require().Nil(capacity.RemainingOutflow)
+}
+
+func (suite *KeeperTestSuite) TestConvertCoinRateLimit() {
+	testCases := []struct {
+		name    string
+		convert int64
+		expPass bool
+	}{
+		{"ok - within the rate limit", 10, true},
+		{"fail - max per conversion exceeded", 11, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			_, pair := suite.setupRegisterCoin()
+			suite.Require().NotNil(pair)
+
+			_, err := suite.app.Erc20Keeper.UpdateRateLimit(suite.ctx, pair.Denom, sdk.NewInt(10), sdk.ZeroInt(), sdk.ZeroInt(), 0, "")
+			suite.Require().NoError(err)
+
+			coins := sdk.NewCoins(sdk.NewCoin(cosmosTokenBase, sdk.NewInt(100)))
+			sender := sdk.AccAddress(suite.address.Bytes())
+			suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins)
+			suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins)
+
+			msg := types.NewMsgConvertCoin(
+				sdk.NewCoin(cosmosTokenBase, sdk.NewInt(tc.convert)),
+				suite.address,
+				sender,
+			)
+
+			_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Commit()
+			balance := suite.BalanceOf(common.HexToAddress(pair.Erc20Address), suite.address)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(big.NewInt(tc.convert).Int64(), balance.(*big.Int).Int64())
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Equal(int64(0), balance.(*big.Int).Int64())
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20RateLimit() {
+	testCases := []struct {
+		name    string
+		convert int64
+		expPass bool
+	}{
+		{"ok - within the outflow cap", 50, true},
+		{"fail - outflow cap exceeded", 51, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+
+			_, err := suite.app.Erc20Keeper.UpdateRateLimit(suite.ctx, contractAddr.String(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(100), 10, "")
+			suite.Require().NoError(err)
+
+			suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			coinName := types.CreateDenom(contractAddr.String())
+
+			// first conversion consumes half of the outflow cap
+			msg := types.NewMsgConvertERC20(sdk.NewInt(50), sender, contractAddr, suite.address)
+			_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			msg = types.NewMsgConvertERC20(sdk.NewInt(tc.convert), sender, contractAddr, suite.address)
+			_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Commit()
+			cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, coinName)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(int64(50+tc.convert), cosmosBalance.Amount.Int64())
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Equal(int64(50), cosmosBalance.Amount.Int64())
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestEvmHooksRateLimit() {
+	testCases := []struct {
+		name     string
+		transfer int64
+		expPass  bool
+	}{
+		{"ok - within the rate limit", 10, true},
+		{"fail - max per conversion exceeded", 11, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			suite.ensureHooksSet()
+
+			contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+
+			_, err := suite.app.Erc20Keeper.UpdateRateLimit(suite.ctx, contractAddr.String(), sdk.NewInt(10), sdk.ZeroInt(), sdk.ZeroInt(), 0, "")
+			suite.Require().NoError(err)
+
+			suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+
+			// transfer the tokens to the module address to convert them through
+			// the EVM hook
+			transferData, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("transfer", types.ModuleAddress, big.NewInt(tc.transfer))
+			suite.Require().NoError(err)
+			_, rsp := suite.deliverTx(contractAddr, suite.address, transferData)
+			suite.Commit()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			cosmosBalance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, types.CreateDenom(contractAddr.String()))
+			balance := suite.BalanceOf(contractAddr, suite.address)
+			if tc.expPass {
+				suite.Require().Empty(rsp.VmError)
+				suite.Require().Equal(tc.transfer, cosmosBalance.Amount.Int64())
+				suite.Require().Equal(100-tc.transfer, balance.(*big.Int).Int64())
+			} else {
+				suite.Require().NotEmpty(rsp.VmError)
+				suite.Require().True(cosmosBalance.Amount.IsZero())
+				suite.Require().Equal(int64(100), balance.(*big.Int).Int64())
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestEvmHooksFailedConversionRateLimit() {
+	suite.SetupTest()
+	_, pair := suite.setupRegisterCoin()
+
+	_, err := suite.app.Erc20Keeper.UpdateRateLimit(suite.ctx, pair.Erc20Address, sdk.ZeroInt(), sdk.NewInt(100), sdk.NewInt(100), 10, "")
+	suite.Require().NoError(err)
+
+	// log a transfer to the module account of tokens it doesn't hold, so that
+	// burning them fails after the rate limit is consumed
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	data, err := erc20.Events[types.ERC20EventTransfer].Inputs.NonIndexed().Pack(big.NewInt(10))
+	suite.Require().NoError(err)
+	receipt := &ethtypes.Receipt{
+		Logs: []*ethtypes.Log{{
+			Address: pair.GetERC20Contract(),
+			Topics: []common.Hash{
+				erc20.Events[types.ERC20EventTransfer].ID,
+				common.BytesToHash(suite.address.Bytes()),
+				common.BytesToHash(types.ModuleAddress.Bytes()),
+			},
+			Data: data,
+		}},
+	}
+
+	err = suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, suite.address, nil, receipt)
+	suite.Require().NoError(err)
+
+	volume := suite.app.Erc20Keeper.GetConversionVolume(suite.ctx, types.NewRateLimit(pair.GetERC20Contract(), sdk.ZeroInt(), sdk.NewInt(100), sdk.NewInt(100), 10, ""))
+	suite.Require().True(volume.Outflow.IsZero())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(suite.address.Bytes()), pair.Denom).IsZero())
+}
@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// GetAllPendingRegistrations returns all the pending token pair registrations
+func (k Keeper) GetAllPendingRegistrations(ctx sdk.Context) []types.PendingRegistration {
+	registrations := []types.PendingRegistration{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPendingRegistration)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var registration types.PendingRegistration
+		k.cdc.MustUnmarshal(iterator.Value(), &registration)
+
+		registrations = append(registrations, registration)
+	}
+
+	return registrations
+}
+
+// GetPendingRegistration returns the pending registration of the token pair
+// with the given ERC20 contract
+func (k Keeper) GetPendingRegistration(ctx sdk.Context, contract common.Address) (types.PendingRegistration, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRegistration)
+	var registration types.PendingRegistration
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.PendingRegistration{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &registration)
+	return registration, true
+}
+
+// SetPendingRegistration stores the pending registration of a token pair,
+// queues it by activation time and adds its deposit to the pending deposits.
+// A previous registration of the same token pair is replaced.
+func (k Keeper) SetPendingRegistration(ctx sdk.Context, registration types.PendingRegistration) {
+	contract := registration.GetERC20Contract()
+	k.DeletePendingRegistration(ctx, contract)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRegistration)
+	bz := k.cdc.MustMarshal(&registration)
+	store.Set(contract.Bytes(), bz)
+
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRegistrationQueue)
+	queue.Set(types.PendingRegistrationQueueKey(registration.ActivationTime, contract), contract.Bytes())
+
+	for _, deposit := range registration.Deposit {
+		k.setPendingDeposit(ctx, deposit.Denom, k.getPendingDeposit(ctx, deposit.Denom).Add(deposit.Amount))
+	}
+}
+
+// DeletePendingRegistration removes the pending registration of the token pair
+// with the given ERC20 contract from the store and the activation queue and
+// subtracts its deposit from the pending deposits
+func (k Keeper) DeletePendingRegistration(ctx sdk.Context, contract common.Address) {
+	registration, found := k.GetPendingRegistration(ctx, contract)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRegistration)
+	store.Delete(contract.Bytes())
+
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRegistrationQueue)
+	queue.Delete(types.PendingRegistrationQueueKey(registration.ActivationTime, contract))
+
+	for _, deposit := range registration.Deposit {
+		k.setPendingDeposit(ctx, deposit.Denom, k.getPendingDeposit(ctx, deposit.Denom).Sub(deposit.Amount))
+	}
+}
+
+// GetActivatedRegistrations returns the pending registrations whose
+// activation time is not after the given block time, ordered by activation
+// time
+func (k Keeper) GetActivatedRegistrations(ctx sdk.Context, blockTime time.Time) []types.PendingRegistration {
+	registrations := []types.PendingRegistration{}
+
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(append(types.KeyPrefixPendingRegistrationQueue, sdk.FormatTimeBytes(blockTime)...))
+	iterator := store.Iterator(types.KeyPrefixPendingRegistrationQueue, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		registration, found := k.GetPendingRegistration(ctx, common.BytesToAddress(iterator.Value()))
+		if !found {
+			continue
+		}
+
+		registrations = append(registrations, registration)
+	}
+
+	return registrations
+}
+
+// IsRegistrationPending returns true if the token pair with the given ERC20
+// contract is within its challenge period
+func (k Keeper) IsRegistrationPending(ctx sdk.Context, contract common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRegistration)
+	return store.Has(contract.Bytes())
+}
+
+// GetPendingDeposits returns the total registration deposits held by the
+// module account
+func (k Keeper) GetPendingDeposits(ctx sdk.Context) sdk.Coins {
+	deposits := sdk.Coins{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPendingDeposit)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(types.KeyPrefixPendingDeposit):])
+		deposits = deposits.Add(sdk.NewCoin(denom, k.getPendingDeposit(ctx, denom)))
+	}
+
+	return deposits
+}
+
+// getPendingDeposit returns the total registration deposits of the given
+// denomination
+func (k Keeper) getPendingDeposit(ctx sdk.Context, denom string) sdk.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingDeposit)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("unable to unmarshal amount value %v", err))
+	}
+	return amount
+}
+
+// setPendingDeposit stores the total registration deposits of the given
+// denomination. The total is removed if it is zero.
+func (k Keeper) setPendingDeposit(ctx sdk.Context, denom string, amount sdk.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingDeposit)
+	if amount.IsZero() {
+		store.Delete([]byte(denom))
+		return
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("unable to marshal amount value %v", err))
+	}
+	store.Set([]byte(denom), bz)
+}
+
+// SubmitERC20Registration registers a token pair for the given ERC20 contract
+// on behalf of the registrant, who locks the registration deposit on the module
+// account. The token pair is disabled until the end of the challenge period.
+// The token pair is registered with a standard behaviour, no scaling and no gas
+// limit, as these parameters are only chosen by governance through a
+// RegisterERC20Proposal.
+func (k Keeper) SubmitERC20Registration(
+	ctx sdk.Context,
+	registrant sdk.AccAddress,
+	contract common.Address,
+) (*types.TokenPair, types.PendingRegistration, error) {
+	params := k.GetParams(ctx)
+
+	pair, err := k.RegisterERC20(ctx, contract, types.TOKEN_BEHAVIOUR_STANDARD, 0, 0, nil)
+	if err != nil {
+		return nil, types.PendingRegistration{}, err
+	}
+
+	// the token pair can't be converted during the challenge period
+	pair.Enabled = false
+	k.SetTokenPair(ctx, *pair)
+
+	deposit := params.RegistrationDeposit
+	if !deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, registrant, types.ModuleName, deposit); err != nil {
+			return nil, types.PendingRegistration{}, sdkerrors.Wrap(err, "failed to lock registration deposit")
+		}
+	}
+
+	registration := types.NewPendingRegistration(
+		contract, registrant, deposit,
+		ctx.BlockTime().Add(params.RegistrationChallengePeriod),
+	)
+	k.SetPendingRegistration(ctx, registration)
+
+	return pair, registration, nil
+}
+
+// ActivateRegistrations enables the token pairs whose challenge period is over
+// and refunds their registration deposit. Only the registrations queued up to
+// the block time are iterated.
+func (k Keeper) ActivateRegistrations(ctx sdk.Context) {
+	for _, registration := range k.GetActivatedRegistrations(ctx, ctx.BlockTime()) {
+		k.activateRegistration(ctx, registration)
+	}
+}
+
+// activateRegistration enables the token pair of the given registration and
+// refunds its deposit. The deposit is burned if it can't be refunded, so that
+// the module account only holds the deposits of the pending registrations.
+func (k Keeper) activateRegistration(ctx sdk.Context, registration types.PendingRegistration) {
+	contract := registration.GetERC20Contract()
+	k.DeletePendingRegistration(ctx, contract)
+
+	pair, found := k.GetTokenPair(ctx, k.GetERC20Map(ctx, contract))
+	if found {
+		pair.Enabled = true
+		k.SetTokenPair(ctx, pair)
+	}
+
+	if !registration.Deposit.IsZero() {
+		registrant, err := sdk.AccAddressFromBech32(registration.Registrant)
+		if err == nil {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, registrant, registration.Deposit)
+		}
+
+		if err != nil {
+			k.Logger(ctx).Error(
+				"failed to refund registration deposit, burning it",
+				"contract", registration.Erc20Address, "registrant", registration.Registrant,
+				"deposit", registration.Deposit.String(), "error", err.Error(),
+			)
+
+			if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, registration.Deposit); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeActivateRegistration,
+			sdk.NewAttribute(types.AttributeKeyERC20Token, registration.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyRegistrant, registration.Registrant),
+			sdk.NewAttribute(types.AttributeKeyDeposit, registration.Deposit.String()),
+		),
+	)
+}
+
+// VetoERC20Registration rejects the pending registration of the token pair
+// with the given ERC20 contract. The token pair is removed and the
+// registration deposit is burned. The contract can be registered again.
+func (k Keeper) VetoERC20Registration(ctx sdk.Context, contract common.Address) (types.PendingRegistration, error) {
+	registration, found := k.GetPendingRegistration(ctx, contract)
+	if !found {
+		return types.PendingRegistration{}, sdkerrors.Wrapf(types.ErrPendingRegistration, "no pending registration for token %s", contract)
+	}
+
+	if !registration.Deposit.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, registration.Deposit); err != nil {
+			return types.PendingRegistration{}, sdkerrors.Wrap(err, "failed to burn registration deposit")
+		}
+	}
+
+	if pair, found := k.GetTokenPair(ctx, k.GetERC20Map(ctx, contract)); found {
+		k.removeTokenPairState(ctx, pair)
+	}
+
+	k.DeletePendingRegistration(ctx, contract)
+	return registration, nil
+}
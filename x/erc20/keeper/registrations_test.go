@@ -0,0 +1,234 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/tharsis/evmos/x/erc20/keeper"
+	"github.com/tharsis/evmos/x/erc20/types"
+	inflationtypes "github.com/tharsis/evmos/x/inflation/types"
+)
+
+// setupSubmitERC20Registration deploys an ERC20 contract and funds the
+// registrant with the given coins
+func (suite *KeeperTestSuite) setupSubmitERC20Registration(funds sdk.Coins) (common.Address, sdk.AccAddress) {
+	suite.SetupTest()
+	contractAddr := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	suite.Commit()
+
+	registrant := sdk.AccAddress(suite.address.Bytes())
+	if !funds.IsZero() {
+		suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, inflationtypes.ModuleName, funds))
+		suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, inflationtypes.ModuleName, registrant, funds))
+	}
+
+	return contractAddr, registrant
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20Permissionless() {
+	deposit := types.DefaultRegistrationDeposit
+
+	testCases := []struct {
+		name     string
+		funds    sdk.Coins
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"ok",
+			deposit,
+			func() {},
+			true,
+		},
+		{
+			"ok - no registration deposit",
+			sdk.Coins{},
+			func() {
+				params := types.DefaultParams()
+				params.RegistrationDeposit = sdk.Coins{}
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			true,
+		},
+		{
+			"fail - insufficient funds for the deposit",
+			sdk.Coins{},
+			func() {},
+			false,
+		},
+		{
+			"fail - ERC20 module disabled",
+			deposit,
+			func() {
+				params := types.DefaultParams()
+				params.EnableErc20 = false
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			contractAddr, registrant := suite.setupSubmitERC20Registration(tc.funds)
+			tc.malleate()
+
+			params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+			msg := types.NewMsgRegisterERC20(contractAddr, registrant)
+			res, err := suite.app.Erc20Keeper.RegisterERC20Permissionless(sdk.WrapSDKContext(suite.ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(types.CreateDenom(contractAddr.String()), res.Denom)
+				suite.Require().Equal(suite.ctx.BlockTime().Add(params.RegistrationChallengePeriod), res.ActivationTime)
+
+				pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, suite.app.Erc20Keeper.GetERC20Map(suite.ctx, contractAddr))
+				suite.Require().True(found)
+				suite.Require().False(pair.Enabled)
+
+				// the pair parameters are only chosen by governance
+				suite.Require().Equal(types.TOKEN_BEHAVIOUR_STANDARD, pair.Behaviour)
+				suite.Require().Equal(uint32(0), pair.ScalingExponent)
+				suite.Require().Equal(uint64(0), pair.GasLimit)
+
+				registration, found := suite.app.Erc20Keeper.GetPendingRegistration(suite.ctx, contractAddr)
+				suite.Require().True(found)
+				suite.Require().Equal(registrant.String(), registration.Registrant)
+				suite.Require().Equal(params.RegistrationDeposit, registration.Deposit)
+				suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, registrant).IsZero())
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().False(suite.app.Erc20Keeper.IsRegistrationPending(suite.ctx, contractAddr))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestActivateRegistrations() {
+	deposit := types.DefaultRegistrationDeposit
+	contractAddr, registrant := suite.setupSubmitERC20Registration(deposit)
+
+	_, registration, err := suite.app.Erc20Keeper.SubmitERC20Registration(suite.ctx, registrant, contractAddr)
+	suite.Require().NoError(err)
+
+	// the challenge period is not over
+	suite.ctx = suite.ctx.WithBlockTime(registration.ActivationTime.Add(-time.Second))
+	suite.app.Erc20Keeper.EndBlocker(suite.ctx)
+	suite.Require().True(suite.app.Erc20Keeper.IsRegistrationPending(suite.ctx, contractAddr))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, registrant).IsZero())
+
+	suite.ctx = suite.ctx.WithBlockTime(registration.ActivationTime)
+	suite.app.Erc20Keeper.EndBlocker(suite.ctx)
+	suite.Require().False(suite.app.Erc20Keeper.IsRegistrationPending(suite.ctx, contractAddr))
+	suite.Require().Equal(deposit, suite.app.BankKeeper.GetAllBalances(suite.ctx, registrant))
+
+	pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, suite.app.Erc20Keeper.GetERC20Map(suite.ctx, contractAddr))
+	suite.Require().True(found)
+	suite.Require().True(pair.Enabled)
+}
+
+func (suite *KeeperTestSuite) TestPendingRegistrationQueue() {
+	suite.SetupTest()
+	blockTime := suite.ctx.BlockTime()
+	registrant := sdk.AccAddress(suite.address.Bytes())
+	first, second, third := tests.GenerateAddress(), tests.GenerateAddress(), tests.GenerateAddress()
+
+	suite.app.Erc20Keeper.SetPendingRegistration(suite.ctx, types.NewPendingRegistration(first, registrant, sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)), blockTime.Add(time.Hour)))
+	suite.app.Erc20Keeper.SetPendingRegistration(suite.ctx, types.NewPendingRegistration(second, registrant, sdk.NewCoins(sdk.NewInt64Coin("aevmos", 200), sdk.NewInt64Coin("uatom", 10)), blockTime))
+	suite.app.Erc20Keeper.SetPendingRegistration(suite.ctx, types.NewPendingRegistration(third, registrant, sdk.NewCoins(sdk.NewInt64Coin("aevmos", 300)), blockTime.Add(2*time.Hour)))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("aevmos", 600), sdk.NewInt64Coin("uatom", 10)), suite.app.Erc20Keeper.GetPendingDeposits(suite.ctx))
+
+	// registrations are returned in activation order up to the block time
+	suite.Require().Empty(suite.app.Erc20Keeper.GetActivatedRegistrations(suite.ctx, blockTime.Add(-time.Second)))
+	activated := suite.app.Erc20Keeper.GetActivatedRegistrations(suite.ctx, blockTime.Add(time.Hour))
+	suite.Require().Len(activated, 2)
+	suite.Require().Equal(second.String(), activated[0].Erc20Address)
+	suite.Require().Equal(first.String(), activated[1].Erc20Address)
+
+	// replacing a registration moves it on the queue and updates the deposits
+	suite.app.Erc20Keeper.SetPendingRegistration(suite.ctx, types.NewPendingRegistration(third, registrant, sdk.NewCoins(sdk.NewInt64Coin("aevmos", 50)), blockTime))
+	suite.Require().Len(suite.app.Erc20Keeper.GetActivatedRegistrations(suite.ctx, blockTime), 2)
+	suite.Require().Len(suite.app.Erc20Keeper.GetActivatedRegistrations(suite.ctx, blockTime.Add(2*time.Hour)), 3)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("aevmos", 350), sdk.NewInt64Coin("uatom", 10)), suite.app.Erc20Keeper.GetPendingDeposits(suite.ctx))
+
+	suite.app.Erc20Keeper.DeletePendingRegistration(suite.ctx, second)
+	suite.app.Erc20Keeper.DeletePendingRegistration(suite.ctx, second)
+	suite.Require().Len(suite.app.Erc20Keeper.GetActivatedRegistrations(suite.ctx, blockTime.Add(2*time.Hour)), 2)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("aevmos", 150)), suite.app.Erc20Keeper.GetPendingDeposits(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestVetoERC20Registration() {
+	deposit := types.DefaultRegistrationDeposit
+	contractAddr, registrant := suite.setupSubmitERC20Registration(deposit)
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, deposit[0].Denom)
+
+	pair, _, err := suite.app.Erc20Keeper.SubmitERC20Registration(suite.ctx, registrant, contractAddr)
+	suite.Require().NoError(err)
+
+	// pending registrations can only be rejected through a veto
+	_, err = suite.app.Erc20Keeper.ToggleRelay(suite.ctx, contractAddr.String())
+	suite.Require().ErrorIs(err, types.ErrPendingRegistration)
+	_, _, err = suite.app.Erc20Keeper.DeregisterTokenPair(suite.ctx, contractAddr.String(), nil)
+	suite.Require().ErrorIs(err, types.ErrPendingRegistration)
+
+	suite.app.Erc20Keeper.SetMintCap(suite.ctx, types.NewMintCap(contractAddr, sdk.NewInt(100)))
+	suite.app.Erc20Keeper.SetSafetyPolicy(suite.ctx, types.NewSafetyPolicy(contractAddr, nil, nil, 10))
+
+	registration, err := suite.app.Erc20Keeper.VetoERC20Registration(suite.ctx, contractAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(deposit, registration.Deposit)
+
+	suite.Require().False(suite.app.Erc20Keeper.IsRegistrationPending(suite.ctx, contractAddr))
+	suite.Require().False(suite.app.Erc20Keeper.IsTokenPairRegistered(suite.ctx, pair.GetID()))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, registrant).IsZero())
+	suite.Require().True(supply.Sub(deposit[0]).IsEqual(suite.app.BankKeeper.GetSupply(suite.ctx, deposit[0].Denom)))
+
+	_, found := suite.app.Erc20Keeper.GetMintCap(suite.ctx, contractAddr)
+	suite.Require().False(found)
+	_, found = suite.app.Erc20Keeper.GetSafetyPolicy(suite.ctx, contractAddr)
+	suite.Require().False(found)
+
+	// the registration can't be vetoed twice
+	_, err = suite.app.Erc20Keeper.VetoERC20Registration(suite.ctx, contractAddr)
+	suite.Require().ErrorIs(err, types.ErrPendingRegistration)
+
+	// the contract can be registered again, overwriting its coin metadata
+	suite.Require().True(suite.app.Erc20Keeper.IsERC20Deregistered(suite.ctx, contractAddr))
+	_, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOUR_STANDARD, 2, 0, nil)
+	suite.Require().NoError(err)
+	suite.Require().False(suite.app.Erc20Keeper.IsERC20Deregistered(suite.ctx, contractAddr))
+
+	metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, pair.Denom)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(erc20Decimals-2), metadata.DenomUnits[1].Exponent)
+}
+
+func (suite *KeeperTestSuite) TestNativeCoinEscrowInvariantPendingDeposit() {
+	suite.mintFeeCollector = true
+	_, pair := suite.setupRegisterCoin()
+	suite.mintFeeCollector = false
+
+	// the deposit is held by the module account on the same denom as the
+	// escrow of the native coin pair
+	deposit := sdk.NewCoins(sdk.NewCoin(pair.Denom, sdk.NewInt(100)))
+	params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+	params.RegistrationDeposit = deposit
+	suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+
+	contractAddr := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	suite.Commit()
+
+	registrant := sdk.AccAddress(suite.address.Bytes())
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, inflationtypes.ModuleName, deposit))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, inflationtypes.ModuleName, registrant, deposit))
+
+	_, _, err := suite.app.Erc20Keeper.SubmitERC20Registration(suite.ctx, registrant, contractAddr)
+	suite.Require().NoError(err)
+
+	msg, broken := keeper.NativeCoinEscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// GetAllSafetyPolicies returns all the safety policies of the token pairs
+// stored
+func (k Keeper) GetAllSafetyPolicies(ctx sdk.Context) []types.SafetyPolicy {
+	policies := []types.SafetyPolicy{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixSafetyPolicy)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var policy types.SafetyPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+
+		policies = append(policies, policy)
+	}
+
+	return policies
+}
+
+// GetSafetyPolicy returns the safety policy set by governance for the token
+// pair with the given ERC20 contract
+func (k Keeper) GetSafetyPolicy(ctx sdk.Context, contract common.Address) (types.SafetyPolicy, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSafetyPolicy)
+	var policy types.SafetyPolicy
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.SafetyPolicy{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+// GetPairSafetyPolicy returns the safety policy that applies to the
+// conversions of the token pair, which is the default policy if governance
+// hasn't set one. The boolean is true if the policy was set by governance.
+func (k Keeper) GetPairSafetyPolicy(ctx sdk.Context, pair types.TokenPair) (types.SafetyPolicy, bool) {
+	if policy, found := k.GetSafetyPolicy(ctx, pair.GetERC20Contract()); found {
+		return policy, true
+	}
+
+	return types.DefaultSafetyPolicy(pair.GetERC20Contract()), false
+}
+
+// SetSafetyPolicy stores the safety policy of a token pair
+func (k Keeper) SetSafetyPolicy(ctx sdk.Context, policy types.SafetyPolicy) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSafetyPolicy)
+	bz := k.cdc.MustMarshal(&policy)
+	store.Set(policy.GetERC20Contract().Bytes(), bz)
+}
+
+// DeleteSafetyPolicy removes the safety policy of the token pair with the
+// given ERC20 contract
+func (k Keeper) DeleteSafetyPolicy(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSafetyPolicy)
+	store.Delete(contract.Bytes())
+}
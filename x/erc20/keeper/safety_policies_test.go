@@ -0,0 +1,221 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/x/erc20/keeper"
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+const (
+	transferEventSig = "Transfer(address,address,uint256)"
+	approvalEventSig = "Approval(address,address,uint256)"
+)
+
+func (suite *KeeperTestSuite) TestGetAllSafetyPolicies() {
+	suite.SetupTest()
+
+	policies := suite.app.Erc20Keeper.GetAllSafetyPolicies(suite.ctx)
+	suite.Require().Empty(policies)
+
+	sp := types.NewSafetyPolicy(tests.GenerateAddress(), []string{transferEventSig}, nil, 1)
+	sp2 := types.NewSafetyPolicy(tests.GenerateAddress(), nil, []string{tests.GenerateAddress().Hex()}, 0)
+	suite.app.Erc20Keeper.SetSafetyPolicy(suite.ctx, sp)
+	suite.app.Erc20Keeper.SetSafetyPolicy(suite.ctx, sp2)
+
+	policies = suite.app.Erc20Keeper.GetAllSafetyPolicies(suite.ctx)
+	suite.Require().Len(policies, 2)
+
+	res, found := suite.app.Erc20Keeper.GetSafetyPolicy(suite.ctx, sp.GetERC20Contract())
+	suite.Require().True(found)
+	suite.Require().Equal(sp, res)
+
+	suite.app.Erc20Keeper.DeleteSafetyPolicy(suite.ctx, sp.GetERC20Contract())
+	policies = suite.app.Erc20Keeper.GetAllSafetyPolicies(suite.ctx)
+	suite.Require().Len(policies, 1)
+	suite.Require().Equal(sp2, policies[0])
+}
+
+func (suite *KeeperTestSuite) TestUpdateSafetyPolicy() {
+	suite.SetupTest()
+	contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+	id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contractAddr.String())
+	pair, _ := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+
+	_, err := suite.app.Erc20Keeper.UpdateSafetyPolicy(suite.ctx, "unregistered", nil, nil, 1, false)
+	suite.Require().Error(err)
+
+	policy, pairPolicy := suite.app.Erc20Keeper.GetPairSafetyPolicy(suite.ctx, pair)
+	suite.Require().False(pairPolicy)
+	suite.Require().Equal(types.DefaultSafetyPolicy(contractAddr), policy)
+
+	policy, err = suite.app.Erc20Keeper.UpdateSafetyPolicy(suite.ctx, contractAddr.String(), []string{transferEventSig}, nil, 1, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(contractAddr.String(), policy.Erc20Address)
+
+	res, pairPolicy := suite.app.Erc20Keeper.GetPairSafetyPolicy(suite.ctx, pair)
+	suite.Require().True(pairPolicy)
+	suite.Require().Equal(policy, res)
+
+	// the default policy removes the safety policy of the token pair
+	_, err = suite.app.Erc20Keeper.UpdateSafetyPolicy(suite.ctx, pair.Denom, nil, nil, 0, true)
+	suite.Require().NoError(err)
+	_, found := suite.app.Erc20Keeper.GetSafetyPolicy(suite.ctx, contractAddr)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSafetyRules() {
+	erc20 := tests.GenerateAddress()
+	other := tests.GenerateAddress()
+	transferTopic := crypto.Keccak256Hash([]byte(transferEventSig)).Hex()
+	approvalTopic := crypto.Keccak256Hash([]byte(approvalEventSig)).Hex()
+	addressTopic := common.BytesToHash(tests.GenerateAddress().Bytes()).Hex()
+
+	transferLog := &evmtypes.Log{Address: erc20.Hex(), Topics: []string{transferTopic, addressTopic, addressTopic}}
+	approvalLog := &evmtypes.Log{Address: erc20.Hex(), Topics: []string{approvalTopic, addressTopic, addressTopic}}
+	otherLog := &evmtypes.Log{Address: other.Hex(), Topics: []string{transferTopic, addressTopic, addressTopic}}
+	anonymousLog := &evmtypes.Log{Address: erc20.Hex()}
+
+	testCases := []struct {
+		name       string
+		policy     types.SafetyPolicy
+		pairPolicy bool
+		logs       []*evmtypes.Log
+		expRule    string
+	}{
+		{"default policy - no logs", types.DefaultSafetyPolicy(erc20), false, nil, ""},
+		{"default policy - transfer from any emitter", types.DefaultSafetyPolicy(erc20), false, []*evmtypes.Log{transferLog, otherLog, anonymousLog}, ""},
+		{"default policy - unexpected approval", types.DefaultSafetyPolicy(erc20), false, []*evmtypes.Log{transferLog, approvalLog}, keeper.SafetyRuleApproval},
+		{"max logs - within the limit", types.NewSafetyPolicy(erc20, nil, nil, 2), true, []*evmtypes.Log{transferLog, transferLog}, ""},
+		{"max logs - limit exceeded", types.NewSafetyPolicy(erc20, nil, nil, 1), true, []*evmtypes.Log{transferLog, transferLog}, keeper.SafetyRuleMaxLogs},
+		{"emitters - disallowed emitter", types.NewSafetyPolicy(erc20, nil, nil, 0), true, []*evmtypes.Log{transferLog, otherLog}, keeper.SafetyRuleAllowedEmitters},
+		{"emitters - allowed emitter", types.NewSafetyPolicy(erc20, nil, []string{other.Hex()}, 0), true, []*evmtypes.Log{transferLog, otherLog}, ""},
+		{"events - allowed event", types.NewSafetyPolicy(erc20, []string{transferEventSig}, nil, 0), true, []*evmtypes.Log{transferLog}, ""},
+		{"events - disallowed event", types.NewSafetyPolicy(erc20, []string{approvalEventSig}, nil, 0), true, []*evmtypes.Log{transferLog}, keeper.SafetyRuleAllowedEvents},
+		{"events - anonymous event", types.NewSafetyPolicy(erc20, []string{transferEventSig}, nil, 0), true, []*evmtypes.Log{anonymousLog}, keeper.SafetyRuleAllowedEvents},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			call := keeper.ConversionCall{
+				Pair:       types.NewTokenPair(erc20, "coin", true, types.OWNER_EXTERNAL),
+				Policy:     tc.policy,
+				PairPolicy: tc.pairPolicy,
+				Logs:       tc.logs,
+			}
+
+			rule := ""
+			for _, r := range keeper.DefaultSafetyRules() {
+				if violation := r.Check(call); violation != nil {
+					rule = r.Name()
+					break
+				}
+			}
+			suite.Require().Equal(tc.expRule, rule)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20SafetyPolicy() {
+	testCases := []struct {
+		name          string
+		allowedEvents []string
+		maxLogs       uint32
+		expPass       bool
+	}{
+		{"ok - default policy", nil, 0, true},
+		{"ok - transfer allowed", []string{transferEventSig}, 1, true},
+		{"fail - transfer not allowed", []string{approvalEventSig}, 0, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+
+			if tc.allowedEvents != nil {
+				_, err := suite.app.Erc20Keeper.UpdateSafetyPolicy(suite.ctx, contractAddr.String(), tc.allowedEvents, nil, tc.maxLogs, false)
+				suite.Require().NoError(err)
+			}
+
+			suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			msg := types.NewMsgConvertERC20(sdk.NewInt(10), sender, contractAddr, suite.address)
+			_, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrUnexpectedEvent, tc.name)
+
+				events := suite.ctx.EventManager().Events()
+				suite.Require().Equal(types.EventTypeSafetyViolation, events[len(events)-1].Type)
+
+				// the rule and the offending log are reported on the ABCI log
+				var violation *keeper.SafetyViolation
+				suite.Require().True(errors.As(err, &violation))
+				suite.Require().Equal(keeper.SafetyRuleAllowedEvents, violation.Rule)
+				suite.Require().Equal(contractAddr, common.HexToAddress(violation.Emitter))
+
+				codespace, code, log := sdkerrors.ABCIInfo(err, false)
+				suite.Require().Equal(types.ModuleName, codespace)
+				suite.Require().Equal(types.ErrUnexpectedEvent.ABCICode(), code)
+				suite.Require().Contains(log, keeper.SafetyRuleAllowedEvents)
+				suite.Require().Contains(log, violation.Emitter)
+				suite.Require().Contains(log, violation.Topic)
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+type rejectAllRule struct{}
+
+func (rejectAllRule) Name() string { return "reject_all" }
+
+func (rejectAllRule) Check(call keeper.ConversionCall) *keeper.SafetyViolation {
+	return &keeper.SafetyViolation{Reason: "rejected"}
+}
+
+func (suite *KeeperTestSuite) TestSetSafetyRules() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+
+	suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+	suite.Commit()
+
+	suite.app.Erc20Keeper.SetSafetyRules(rejectAllRule{})
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	msg := types.NewMsgConvertERC20(sdk.NewInt(10), sender, contractAddr, suite.address)
+	_, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrUnexpectedEvent)
+	suite.Require().Contains(err.Error(), "reject_all")
+
+	suite.app.Erc20Keeper.SetSafetyRules(keeper.DefaultSafetyRules()...)
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestSafetyPolicyDeregisterTokenPair() {
+	suite.SetupTest()
+	contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+
+	_, err := suite.app.Erc20Keeper.UpdateSafetyPolicy(suite.ctx, contractAddr.String(), nil, nil, 1, false)
+	suite.Require().NoError(err)
+
+	_, _, err = suite.app.Erc20Keeper.DeregisterTokenPair(suite.ctx, contractAddr.String(), nil)
+	suite.Require().NoError(err)
+
+	_, found := suite.app.Erc20Keeper.GetSafetyPolicy(suite.ctx, contractAddr)
+	suite.Require().False(found)
+}
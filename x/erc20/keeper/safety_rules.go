@@ -0,0 +1,306 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// Names of the default conversion safety rules
+const (
+	SafetyRuleApproval        = "approval"
+	SafetyRuleMaxLogs         = "max_logs"
+	SafetyRuleAllowedEmitters = "allowed_emitters"
+	SafetyRuleAllowedEvents   = "allowed_events"
+)
+
+var logApprovalSigHash = crypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))
+
+// SafetyRule defines a check on the logs emitted by an EVM call made during a
+// conversion. A conversion is rejected if any of the rules of the keeper
+// reports a violation.
+type SafetyRule interface {
+	// Name returns the identifier of the rule reported on violations
+	Name() string
+	// Check returns a violation if the logs of the call break the rule, or nil
+	// otherwise
+	Check(call ConversionCall) *SafetyViolation
+}
+
+// ConversionCall holds the logs of an EVM call made during a conversion and
+// the safety policy of the token pair being converted.
+type ConversionCall struct {
+	Pair   types.TokenPair
+	Policy types.SafetyPolicy
+	// PairPolicy is true if the policy was set by governance
+	PairPolicy bool
+	Logs       []*evmtypes.Log
+
+	expected []approvalEvent
+}
+
+// IsExpectedApproval returns true if the log is an Approval event the module
+// expects the call to emit, e.g. the approval of a permit.
+func (c ConversionCall) IsExpectedApproval(log *evmtypes.Log) bool {
+	for _, approval := range c.expected {
+		if approval.matches(log) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SafetyViolation describes the log that broke a safety rule. It is returned
+// as the error of the rejected conversion, so that the rule and the offending
+// log are reported on the ABCI log of the failed transaction, which discards
+// the violation event. The error is an ErrUnexpectedEvent.
+type SafetyViolation struct {
+	// Rule is the name of the broken rule, set by the keeper
+	Rule    string
+	Reason  string
+	Emitter string
+	Topic   string
+}
+
+// Error implements the error interface
+func (v *SafetyViolation) Error() string {
+	msg := fmt.Sprintf("rule %s: %s", v.Rule, v.Reason)
+	if v.Emitter != "" {
+		msg += fmt.Sprintf(" (emitter: %s, topic: %s)", v.Emitter, v.Topic)
+	}
+
+	return fmt.Sprintf("%s: %s", msg, types.ErrUnexpectedEvent)
+}
+
+// Cause returns ErrUnexpectedEvent, which sets the ABCI code and codespace of
+// the error
+func (v *SafetyViolation) Cause() error {
+	return types.ErrUnexpectedEvent
+}
+
+// Unwrap returns ErrUnexpectedEvent
+func (v *SafetyViolation) Unwrap() error {
+	return types.ErrUnexpectedEvent
+}
+
+// newSafetyViolation creates a new SafetyViolation for the given log
+func newSafetyViolation(log *evmtypes.Log, format string, args ...interface{}) *SafetyViolation {
+	violation := &SafetyViolation{Reason: fmt.Sprintf(format, args...)}
+	if log != nil {
+		violation.Emitter = log.Address
+		if len(log.Topics) > 0 {
+			violation.Topic = log.Topics[0]
+		}
+	}
+
+	return violation
+}
+
+// DefaultSafetyRules returns the rules checked on the logs of the conversions
+// of every token pair. Only the approval rule applies to token pairs without a
+// safety policy set by governance.
+func DefaultSafetyRules() []SafetyRule {
+	return []SafetyRule{
+		approvalRule{},
+		maxLogsRule{},
+		allowedEmittersRule{},
+		allowedEventsRule{},
+	}
+}
+
+// SetSafetyRules replaces the rules checked on the logs of the conversions
+func (k *Keeper) SetSafetyRules(rules ...SafetyRule) *Keeper {
+	k.safetyRules = rules
+	return k
+}
+
+// checkConversionSafety checks the logs of an EVM call made during the
+// conversion of the token pair against the safety rules. Each violation emits
+// an event, is logged, increments a telemetry counter and is returned as the
+// error. As the events of a failed transaction are discarded, the returned
+// violation, the logs and the telemetry counter are the reliable signals for
+// monitoring.
+//
+// NOTE: the rules only apply to the calls made by the module. The conversions
+// through the EVM hook are not checked, as the logs of the receipt belong to an
+// arbitrary transaction signed by the user, e.g. a swap emitting logs from
+// several contracts, rather than to a call of the token contract, and the
+// tokens are already transferred to the module account when the hook runs.
+func (k Keeper) checkConversionSafety(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	res *evmtypes.MsgEthereumTxResponse,
+	expected ...approvalEvent,
+) error {
+	if res == nil {
+		return nil
+	}
+
+	policy, pairPolicy := k.GetPairSafetyPolicy(ctx, pair)
+	call := ConversionCall{
+		Pair:       pair,
+		Policy:     policy,
+		PairPolicy: pairPolicy,
+		Logs:       res.Logs,
+		expected:   expected,
+	}
+
+	for _, rule := range k.safetyRules {
+		violation := rule.Check(call)
+		if violation == nil {
+			continue
+		}
+		violation.Rule = rule.Name()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSafetyViolation,
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyRule, rule.Name()),
+				sdk.NewAttribute(types.AttributeKeyReason, violation.Reason),
+				sdk.NewAttribute(types.AttributeKeyEmitter, violation.Emitter),
+				sdk.NewAttribute(types.AttributeKeyEventTopic, violation.Topic),
+			),
+		)
+
+		k.Logger(ctx).Error(
+			"conversion safety violation",
+			"erc20-token", pair.Erc20Address,
+			"rule", rule.Name(),
+			"reason", violation.Reason,
+			"emitter", violation.Emitter,
+			"event-topic", violation.Topic,
+			"tx-hash", res.Hash,
+		)
+
+		telemetry.IncrCounter(1, types.ModuleName, "conversion_safety_violation", rule.Name())
+
+		return violation
+	}
+
+	return nil
+}
+
+// approvalRule rejects the Approval events other than the expected ones
+type approvalRule struct{}
+
+func (approvalRule) Name() string { return SafetyRuleApproval }
+
+func (approvalRule) Check(call ConversionCall) *SafetyViolation {
+	for _, log := range call.Logs {
+		if len(log.Topics) == 0 || common.HexToHash(log.Topics[0]) != logApprovalSigHash {
+			continue
+		}
+
+		if !call.IsExpectedApproval(log) {
+			return newSafetyViolation(log, "unexpected approval event")
+		}
+	}
+
+	return nil
+}
+
+// maxLogsRule rejects the calls that emit more logs than the maximum of the
+// safety policy
+type maxLogsRule struct{}
+
+func (maxLogsRule) Name() string { return SafetyRuleMaxLogs }
+
+func (maxLogsRule) Check(call ConversionCall) *SafetyViolation {
+	maxLogs := call.Policy.MaxLogs
+	if maxLogs == 0 || len(call.Logs) <= int(maxLogs) {
+		return nil
+	}
+
+	return newSafetyViolation(nil, "%d logs emitted, maximum is %d", len(call.Logs), maxLogs)
+}
+
+// allowedEmittersRule rejects the logs emitted by contracts other than the
+// ERC20 contract of the token pair and the allowed emitters of the safety
+// policy. It only applies to token pairs with a safety policy set by
+// governance.
+type allowedEmittersRule struct{}
+
+func (allowedEmittersRule) Name() string { return SafetyRuleAllowedEmitters }
+
+func (allowedEmittersRule) Check(call ConversionCall) *SafetyViolation {
+	if !call.PairPolicy {
+		return nil
+	}
+
+	for _, log := range call.Logs {
+		if !call.Policy.AllowsEmitter(common.HexToAddress(log.Address)) {
+			return newSafetyViolation(log, "log emitted by disallowed contract %s", log.Address)
+		}
+	}
+
+	return nil
+}
+
+// allowedEventsRule rejects the events that are not allowed by the safety
+// policy, except for the expected Approval events. Anonymous events are
+// rejected if the policy restricts the allowed events.
+type allowedEventsRule struct{}
+
+func (allowedEventsRule) Name() string { return SafetyRuleAllowedEvents }
+
+func (allowedEventsRule) Check(call ConversionCall) *SafetyViolation {
+	if len(call.Policy.AllowedEventSignatures) == 0 {
+		return nil
+	}
+
+	for _, log := range call.Logs {
+		if call.IsExpectedApproval(log) {
+			continue
+		}
+
+		if len(log.Topics) == 0 {
+			return newSafetyViolation(log, "anonymous event not allowed")
+		}
+
+		if !call.Policy.AllowsEvent(common.HexToHash(log.Topics[0])) {
+			return newSafetyViolation(log, "event %s not allowed", log.Topics[0])
+		}
+	}
+
+	return nil
+}
+
+// approvalEvent defines an `Approval(owner, spender, value)` event of an ERC20
+// contract
+type approvalEvent struct {
+	contract common.Address
+	owner    common.Hash
+	spender  common.Hash
+	value    *big.Int
+}
+
+// newApprovalEvent creates a new approvalEvent instance
+func newApprovalEvent(contract, owner, spender common.Address, value *big.Int) approvalEvent {
+	return approvalEvent{
+		contract: contract,
+		owner:    common.BytesToHash(owner.Bytes()),
+		spender:  common.BytesToHash(spender.Bytes()),
+		value:    value,
+	}
+}
+
+// matches returns true if the log is the approval event
+func (a approvalEvent) matches(log *evmtypes.Log) bool {
+	return len(log.Topics) == 3 &&
+		common.HexToHash(log.Topics[0]) == logApprovalSigHash &&
+		common.HexToAddress(log.Address) == a.contract &&
+		common.HexToHash(log.Topics[1]) == a.owner &&
+		common.HexToHash(log.Topics[2]) == a.spender &&
+		len(log.Data) == common.HashLength &&
+		new(big.Int).SetBytes(log.Data).Cmp(a.value) == 0
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
+)
+
+// tokensToCoins returns the amount of Cosmos coins that the given amount of
+// ERC20 tokens of the token pair converts to, along with the remainder of
+// tokens that can't be converted. It fails if the tokens don't cover a base
+// unit of the Cosmos coin.
+func tokensToCoins(pair types.TokenPair, tokens sdk.Int) (sdk.Int, sdk.Int, error) {
+	amount, remainder := pair.TokensToCoins(tokens)
+	if !amount.IsPositive() {
+		return sdk.Int{}, sdk.Int{}, sdkerrors.Wrapf(
+			types.ErrInvalidScaling,
+			"amount %s lower than the scaling factor %s of token pair %s", tokens, pair.ScalingFactor(), pair.Erc20Address,
+		)
+	}
+
+	return amount, remainder, nil
+}
+
+// refundTokenRemainder transfers the ERC20 tokens held by the module account
+// that can't be converted into a whole base unit of the Cosmos coin back to
+// the given account
+func (k Keeper) refundTokenRemainder(ctx sdk.Context, pair types.TokenPair, to common.Address, remainder sdk.Int) error {
+	if !remainder.IsPositive() {
+		return nil
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, pair.GetERC20Contract(), "transfer", to, remainder.BigInt())
+	if err != nil {
+		return err
+	}
+
+	ok, err := transferSucceeded(pair, erc20, res)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "failed to refund the remainder of the converted tokens")
+	}
+
+	return nil
+}
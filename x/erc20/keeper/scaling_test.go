@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	inflationtypes "github.com/tharsis/evmos/x/inflation/types"
+
+	"github.com/tharsis/evmos/x/erc20/keeper"
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+const scalingExponent = uint32(12)
+
+// setupRegisterScaledERC20Pair deploys an ERC20 contract and registers it
+// with a token pair that maps a base unit of the coin to 10^12 token units
+func (suite *KeeperTestSuite) setupRegisterScaledERC20Pair() (common.Address, types.TokenPair) {
+	suite.SetupTest()
+	contractAddr := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	suite.Commit()
+
+	pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOUR_STANDARD, scalingExponent, 0, nil)
+	suite.Require().NoError(err)
+	return contractAddr, *pair
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20Scaling() {
+	_, pair := suite.setupRegisterScaledERC20Pair()
+	suite.Require().Equal(scalingExponent, pair.ScalingExponent)
+
+	metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, pair.Denom)
+	suite.Require().True(found)
+	suite.Require().Len(metadata.DenomUnits, 2)
+	suite.Require().Equal(uint32(erc20Decimals)-scalingExponent, metadata.DenomUnits[1].Exponent)
+
+	// scaling exponent greater than the contract decimals
+	contractAddr := suite.DeployContract(erc20Name, erc20Symbol, uint8(6))
+	suite.Commit()
+	_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr, types.TOKEN_BEHAVIOUR_STANDARD, scalingExponent, 0, nil)
+	suite.Require().ErrorIs(err, types.ErrInvalidScaling)
+}
+
+func (suite *KeeperTestSuite) TestRegisterCoinScaling() {
+	suite.SetupTest()
+	metadata := banktypes.Metadata{
+		Description: "description of the token",
+		Base:        cosmosTokenBase,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: cosmosTokenBase, Exponent: 0},
+			{Denom: cosmosTokenBase[1:], Exponent: 6},
+		},
+		Name:    cosmosTokenBase,
+		Symbol:  erc20Symbol,
+		Display: cosmosTokenBase,
+	}
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, inflationtypes.ModuleName, sdk.Coins{sdk.NewInt64Coin(metadata.Base, 1)})
+	suite.Require().NoError(err)
+
+	pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, metadata, scalingExponent, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(scalingExponent, pair.ScalingExponent)
+
+	erc20Data, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, pair.GetERC20Contract())
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint8(scalingExponent), erc20Data.Decimals)
+
+	// convert coins to scaled ERC20 tokens and back
+	suite.Require().NoError(suite.convertCoin(pair, 5))
+	balance := suite.BalanceOf(pair.GetERC20Contract(), suite.address)
+	suite.Require().Equal(pair.CoinsToTokens(sdk.NewInt(5)).BigInt().String(), balance.(*big.Int).String())
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	tokens := pair.CoinsToTokens(sdk.NewInt(2)).AddRaw(42)
+	msg := types.NewMsgConvertERC20(tokens, sender, pair.GetERC20Contract(), suite.address)
+	_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	// the remainder is not burned
+	balance = suite.BalanceOf(pair.GetERC20Contract(), suite.address)
+	suite.Require().Equal(pair.CoinsToTokens(sdk.NewInt(3)).BigInt().String(), balance.(*big.Int).String())
+	suite.Require().Equal(int64(2), suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom).Amount.Int64())
+
+	_, broken := keeper.NativeCoinEscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20Scaling() {
+	testCases := []struct {
+		name         string
+		amount       sdk.Int
+		expCoins     int64
+		expRemainder int64
+		expPass      bool
+	}{
+		{"ok - without remainder", sdk.NewIntWithDecimal(3, int(scalingExponent)), 3, 0, true},
+		{"ok - remainder refunded", sdk.NewIntWithDecimal(3, int(scalingExponent)).AddRaw(42), 3, 42, true},
+		{"fail - amount lower than the scaling factor", sdk.NewInt(999), 0, 0, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			contractAddr, pair := suite.setupRegisterScaledERC20Pair()
+			sender := sdk.AccAddress(suite.address.Bytes())
+
+			suite.MintERC20Token(contractAddr, suite.address, suite.address, tc.amount.BigInt())
+			suite.Commit()
+
+			msg := types.NewMsgConvertERC20(tc.amount, sender, contractAddr, suite.address)
+			_, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().ErrorIs(err, types.ErrInvalidScaling)
+				return
+			}
+			suite.Require().NoError(err)
+
+			balance := suite.BalanceOf(contractAddr, suite.address)
+			suite.Require().Equal(big.NewInt(tc.expRemainder).String(), balance.(*big.Int).String())
+
+			escrowed := suite.BalanceOf(contractAddr, types.ModuleAddress)
+			suite.Require().Equal(pair.CoinsToTokens(sdk.NewInt(tc.expCoins)).BigInt().String(), escrowed.(*big.Int).String())
+
+			coins := suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom)
+			suite.Require().Equal(tc.expCoins, coins.Amount.Int64())
+
+			_, broken := keeper.NativeERC20EscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+			suite.Require().False(broken)
+
+			// convert a coin back to scaled ERC20 tokens
+			coinMsg := types.NewMsgConvertCoin(sdk.NewInt64Coin(pair.Denom, 1), suite.address, sender)
+			_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), coinMsg)
+			suite.Require().NoError(err)
+
+			balance = suite.BalanceOf(contractAddr, suite.address)
+			suite.Require().Equal(pair.CoinsToTokens(sdk.OneInt()).AddRaw(tc.expRemainder).BigInt().String(), balance.(*big.Int).String())
+
+			_, broken = keeper.NativeERC20EscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+			suite.Require().False(broken)
+		})
+	}
+}
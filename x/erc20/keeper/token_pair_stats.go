@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// GetAllTokenPairStats returns the conversion statistics of all the token
+// pairs stored
+func (k Keeper) GetAllTokenPairStats(ctx sdk.Context) []types.TokenPairStats {
+	allStats := []types.TokenPairStats{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixTokenPairStats)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.TokenPairStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+
+		allStats = append(allStats, stats)
+	}
+
+	return allStats
+}
+
+// GetTokenPairStats returns the conversion statistics of the token pair with
+// the given ERC20 contract, if it has been converted
+func (k Keeper) GetTokenPairStats(ctx sdk.Context, contract common.Address) (types.TokenPairStats, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairStats)
+	var stats types.TokenPairStats
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.TokenPairStats{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// SetTokenPairStats stores the conversion statistics of a token pair
+func (k Keeper) SetTokenPairStats(ctx sdk.Context, stats types.TokenPairStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairStats)
+	bz := k.cdc.MustMarshal(&stats)
+	store.Set(stats.GetERC20Contract().Bytes(), bz)
+}
+
+// DeleteTokenPairStats removes the conversion statistics of the token pair
+// with the given ERC20 contract
+func (k Keeper) DeleteTokenPairStats(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairStats)
+	store.Delete(contract.Bytes())
+}
+
+// recordConversion adds a conversion of the amount on the given direction to
+// the statistics of the token pair
+func (k Keeper) recordConversion(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	amount sdk.Int,
+	direction types.ConversionDirection,
+	evmHook bool,
+) {
+	stats, found := k.GetTokenPairStats(ctx, pair.GetERC20Contract())
+	if !found {
+		stats = types.NewTokenPairStats(pair.GetERC20Contract())
+	}
+
+	k.SetTokenPairStats(ctx, stats.Add(amount, direction, ctx.BlockHeight(), evmHook))
+}
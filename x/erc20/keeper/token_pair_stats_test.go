@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
+)
+
+func (suite *KeeperTestSuite) TestTokenPairStatsNativeCoin() {
+	_, pair := suite.setupRegisterCoin()
+
+	_, found := suite.app.Erc20Keeper.GetTokenPairStats(suite.ctx, pair.GetERC20Contract())
+	suite.Require().False(found)
+
+	suite.Require().NoError(suite.convertCoin(pair, 100))
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	msg := types.NewMsgConvertERC20(sdk.NewInt(40), sender, pair.GetERC20Contract(), suite.address)
+	_, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	stats, found := suite.app.Erc20Keeper.GetTokenPairStats(suite.ctx, pair.GetERC20Contract())
+	suite.Require().True(found)
+	suite.Require().True(sdk.NewInt(100).Equal(stats.TotalInflow))
+	suite.Require().True(sdk.NewInt(40).Equal(stats.TotalOutflow))
+	suite.Require().Equal(uint64(1), stats.InflowConversions)
+	suite.Require().Equal(uint64(1), stats.OutflowConversions)
+	suite.Require().Equal(suite.ctx.BlockHeight(), stats.LastConversionHeight)
+	suite.Require().True(stats.EvmHookVolume.IsZero())
+	suite.Require().True(sdk.NewInt(140).Equal(stats.MsgVolume))
+
+	// failed conversions aren't recorded
+	suite.Require().Error(suite.convertCoin(&types.TokenPair{Denom: "unregistered"}, 10))
+	failedStats, _ := suite.app.Erc20Keeper.GetTokenPairStats(suite.ctx, pair.GetERC20Contract())
+	suite.Require().True(stats.Equal(failedStats))
+
+	// the statistics are removed with the token pair
+	_, _, err = suite.app.Erc20Keeper.DeregisterTokenPair(suite.ctx, pair.Denom, nil)
+	suite.Require().NoError(err)
+	_, found = suite.app.Erc20Keeper.GetTokenPairStats(suite.ctx, pair.GetERC20Contract())
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestTokenPairStatsEvmHook() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	suite.ensureHooksSet()
+
+	contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+
+	suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+	suite.Commit()
+
+	// transfer the tokens to the module address to convert them through the
+	// EVM hook
+	transferData, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Pack("transfer", types.ModuleAddress, big.NewInt(30))
+	suite.Require().NoError(err)
+	_, rsp := suite.deliverTx(contractAddr, suite.address, transferData)
+	suite.Require().Empty(rsp.VmError)
+	suite.Commit()
+
+	stats, found := suite.app.Erc20Keeper.GetTokenPairStats(suite.ctx, contractAddr)
+	suite.Require().True(found)
+	suite.Require().True(stats.TotalInflow.IsZero())
+	suite.Require().True(sdk.NewInt(30).Equal(stats.TotalOutflow))
+	suite.Require().Equal(uint64(1), stats.OutflowConversions)
+	suite.Require().True(sdk.NewInt(30).Equal(stats.EvmHookVolume))
+	suite.Require().True(stats.MsgVolume.IsZero())
+	suite.Require().NoError(stats.Validate())
+
+	suite.mintFeeCollector = false
+}
@@ -97,6 +97,78 @@ func (k Keeper) SetDenomMap(ctx sdk.Context, denom string, id []byte) {
 	store.Set([]byte(denom), id)
 }
 
+// DeleteDenomMap deletes the token pair id for the given denomination
+func (k Keeper) DeleteDenomMap(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByDenom)
+	store.Delete([]byte(denom))
+}
+
+// RemoveTokenPair removes a token pair along with its ERC20 and denomination
+// lookup maps.
+func (k Keeper) RemoveTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
+	k.DeleteTokenPair(ctx, tokenPair)
+	k.DeleteERC20Map(ctx, tokenPair.GetERC20Contract())
+	k.DeleteDenomMap(ctx, tokenPair.Denom)
+}
+
+// removeTokenPairState removes a token pair along with its lookup maps and the
+// rate limit, pause, conversion fee, statistics, mint cap and safety policy
+// stored for it. The ERC20 contract of a native ERC20 pair is flagged as
+// deregistered so that it can be registered again.
+func (k Keeper) removeTokenPairState(ctx sdk.Context, tokenPair types.TokenPair) {
+	contract := tokenPair.GetERC20Contract()
+
+	k.RemoveTokenPair(ctx, tokenPair)
+	k.DeleteRateLimit(ctx, contract)
+	k.DeletePairPause(ctx, contract)
+	k.DeleteConversionFee(ctx, contract)
+	k.DeleteTokenPairStats(ctx, contract)
+	k.DeleteMintCap(ctx, contract)
+	k.DeleteSafetyPolicy(ctx, contract)
+
+	if tokenPair.IsNativeERC20() {
+		k.SetERC20Deregistered(ctx, contract)
+	}
+}
+
+// GetAllDeregisteredERC20s returns the ERC20 contracts of the removed native
+// ERC20 token pairs that haven't been registered again
+func (k Keeper) GetAllDeregisteredERC20s(ctx sdk.Context) []common.Address {
+	contracts := []common.Address{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixDeregisteredERC20)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contract := common.BytesToAddress(iterator.Key()[len(types.KeyPrefixDeregisteredERC20):])
+		contracts = append(contracts, contract)
+	}
+
+	return contracts
+}
+
+// SetERC20Deregistered flags the ERC20 contract of a removed token pair. The
+// bank metadata of its coin is kept, so it is overwritten if the contract is
+// registered again.
+func (k Keeper) SetERC20Deregistered(ctx sdk.Context, erc20 common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeregisteredERC20)
+	store.Set(erc20.Bytes(), []byte{1})
+}
+
+// DeleteERC20Deregistered removes the deregistered flag of the ERC20 contract
+func (k Keeper) DeleteERC20Deregistered(ctx sdk.Context, erc20 common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeregisteredERC20)
+	store.Delete(erc20.Bytes())
+}
+
+// IsERC20Deregistered returns true if the ERC20 contract belonged to a removed
+// token pair
+func (k Keeper) IsERC20Deregistered(ctx sdk.Context, erc20 common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeregisteredERC20)
+	return store.Has(erc20.Bytes())
+}
+
 // IsTokenPairRegistered - check if registered token tokenPair is registered
 func (k Keeper) IsTokenPairRegistered(ctx sdk.Context, id []byte) bool {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
@@ -0,0 +1,333 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
+)
+
+// GetAllTokenPairTombstones returns all the token pair tombstones stored
+func (k Keeper) GetAllTokenPairTombstones(ctx sdk.Context) []types.TokenPairTombstone {
+	tombstones := []types.TokenPairTombstone{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixTokenPairTombstone)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tombstone types.TokenPairTombstone
+		k.cdc.MustUnmarshal(iterator.Value(), &tombstone)
+
+		tombstones = append(tombstones, tombstone)
+	}
+
+	return tombstones
+}
+
+// GetTokenPairTombstone returns the tombstone of the token pair with the given
+// ERC20 contract
+func (k Keeper) GetTokenPairTombstone(ctx sdk.Context, contract common.Address) (types.TokenPairTombstone, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairTombstone)
+	var tombstone types.TokenPairTombstone
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.TokenPairTombstone{}, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &tombstone)
+	return tombstone, true
+}
+
+// SetTokenPairTombstone stores the tombstone of a token pair
+func (k Keeper) SetTokenPairTombstone(ctx sdk.Context, tombstone types.TokenPairTombstone) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairTombstone)
+	bz := k.cdc.MustMarshal(&tombstone)
+	store.Set(tombstone.GetERC20Contract().Bytes(), bz)
+}
+
+// IsTokenTombstoned returns true if the token pair of the given token, either
+// the hex contract address of the ERC20 or the Cosmos coin denomination, was
+// tombstoned and not registered again
+func (k Keeper) IsTokenTombstoned(ctx sdk.Context, token string) bool {
+	if common.IsHexAddress(token) {
+		_, found := k.GetTokenPairTombstone(ctx, common.HexToAddress(token))
+		return found
+	}
+
+	for _, tombstone := range k.GetAllTokenPairTombstones(ctx) {
+		if tombstone.Denom == token {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetTombstonedEscrow returns the amount of Cosmos coins of the given
+// denomination stranded on the module account by tombstoned token pairs and
+// not recovered yet
+func (k Keeper) GetTombstonedEscrow(ctx sdk.Context, denom string) sdk.Int {
+	escrow := sdk.ZeroInt()
+	for _, tombstone := range k.GetAllTokenPairTombstones(ctx) {
+		if tombstone.Denom == denom {
+			escrow = escrow.Add(tombstone.UnrecoveredEscrow())
+		}
+	}
+	return escrow
+}
+
+// escrowedCoins returns the Cosmos coins escrowed on the module account for a
+// token pair owned by the module. The registration deposits and the escrow
+// stranded by tombstoned token pairs are not part of it.
+func (k Keeper) escrowedCoins(ctx sdk.Context, pair types.TokenPair) sdk.Int {
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	balance := k.bankKeeper.GetBalance(ctx, moduleAddr, pair.Denom).Amount
+	deposits := k.GetPendingDeposits(ctx).AmountOf(pair.Denom)
+	return balance.Sub(deposits).Sub(k.GetTombstonedEscrow(ctx, pair.Denom))
+}
+
+// TombstoneTokenPair removes a token pair whose ERC20 contract no longer has
+// code, or a deregistered native coin pair, and records a tombstone with a snapshot of the Cosmos coins that
+// remained escrowed for it, so that governance can recover them with a
+// RecoverTokenPairEscrowProposal.
+func (k Keeper) TombstoneTokenPair(ctx sdk.Context, pair types.TokenPair, reason string) types.TokenPairTombstone {
+	escrow := sdk.ZeroInt()
+
+	// the wrapped EVM denom is escrowed on its contract and not on the module
+	// account
+	if pair.IsNativeCoin() && !k.IsWrappedEVMDenomPair(ctx, pair) {
+		escrow = sdk.MaxInt(k.escrowedCoins(ctx, pair), sdk.ZeroInt())
+	}
+
+	// a contract address can be reused, e.g. with CREATE2, so the escrow of a
+	// previous tombstone that hasn't been recovered is kept
+	if previous, found := k.GetTokenPairTombstone(ctx, pair.GetERC20Contract()); found {
+		escrow = escrow.Add(previous.UnrecoveredEscrow())
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, pair.Denom).Amount
+	tombstone := types.NewTokenPairTombstone(pair, ctx.BlockHeight(), reason, escrow, supply)
+
+	k.SetTokenPairTombstone(ctx, tombstone)
+	k.removeTokenPairState(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTombstoneTokenPair,
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyEscrowed, escrow.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+
+	k.Logger(ctx).Info(
+		"tombstoned token pair",
+		"contract", pair.Erc20Address,
+		"denom", pair.Denom,
+		"escrow", escrow.String(),
+		"reason", reason,
+	)
+
+	return tombstone
+}
+
+// TombstoneSelfDestructedPairs tombstones the token pairs whose ERC20 contract
+// no longer has code. It runs at the end of every block, so that the contracts
+// destroyed by any transaction are tombstoned regardless of the messages that
+// use them, whose state changes are reverted when they fail.
+func (k Keeper) TombstoneSelfDestructedPairs(ctx sdk.Context) {
+	for _, pair := range k.GetAllTokenPairs(ctx) {
+		k.tombstoneSelfDestructed(ctx, pair)
+	}
+}
+
+// tombstoneSelfDestructed tombstones the token pair if its ERC20 contract no
+// longer has code and returns true if it did
+func (k Keeper) tombstoneSelfDestructed(ctx sdk.Context, pair types.TokenPair) bool {
+	if k.isContract(ctx, pair) {
+		return false
+	}
+
+	k.TombstoneTokenPair(ctx, pair, "ERC20 contract has no code")
+	return true
+}
+
+// RecoverTokenPairEscrow distributes the Cosmos coins stranded on the module
+// account by a tombstoned token pair. The escrow is distributed pro-rata to
+// the balances of the shares, rounded down, and the remainder is sent to the
+// community pool. Without shares, the whole escrow is sent to the community
+// pool. It returns the updated tombstone and the coins sent to the community
+// pool.
+func (k Keeper) RecoverTokenPairEscrow(
+	ctx sdk.Context,
+	contract common.Address,
+	shares []types.EscrowRecoveryShare,
+) (types.TokenPairTombstone, sdk.Coins, error) {
+	tombstone, found := k.GetTokenPairTombstone(ctx, contract)
+	if !found {
+		return types.TokenPairTombstone{}, nil, sdkerrors.Wrapf(types.ErrTokenPairTombstone, "token pair %s is not tombstoned", contract)
+	}
+
+	if tombstone.Recovered {
+		return types.TokenPairTombstone{}, nil, sdkerrors.Wrapf(types.ErrTokenPairTombstone, "escrow of token pair %s already recovered", contract)
+	}
+
+	if !tombstone.Escrow.IsPositive() {
+		return types.TokenPairTombstone{}, nil, sdkerrors.Wrapf(types.ErrTokenPairTombstone, "token pair %s has no escrow to recover", contract)
+	}
+
+	total := sdk.ZeroInt()
+	for _, share := range shares {
+		total = total.Add(share.Amount)
+	}
+
+	remaining := tombstone.Escrow
+	for _, share := range shares {
+		amount := tombstone.Escrow.Mul(share.Amount).Quo(total)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		recipient, err := share.GetAccAddress()
+		if err != nil {
+			return types.TokenPairTombstone{}, nil, err
+		}
+
+		coins := sdk.Coins{{Denom: tombstone.Denom, Amount: amount}}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+			return types.TokenPairTombstone{}, nil, sdkerrors.Wrapf(err, "failed to send the escrow share of %s", share.Address)
+		}
+
+		remaining = remaining.Sub(amount)
+	}
+
+	communityPool := sdk.Coins{}
+	if remaining.IsPositive() {
+		communityPool = sdk.Coins{{Denom: tombstone.Denom, Amount: remaining}}
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPool, moduleAddr); err != nil {
+			return types.TokenPairTombstone{}, nil, sdkerrors.Wrap(err, "failed to fund the community pool with the escrow")
+		}
+	}
+
+	tombstone.Recovered = true
+	k.SetTokenPairTombstone(ctx, tombstone)
+
+	return tombstone, communityPool, nil
+}
+
+// GetTokenPairHealth checks that the ERC20 contract of a token pair has code,
+// that its total supply and decimals can be queried, that its decimals match
+// the coin metadata and that the escrow of the pair backs its supply.
+func (k Keeper) GetTokenPairHealth(ctx sdk.Context, pair types.TokenPair) types.TokenPairHealth {
+	health := types.TokenPairHealth{
+		Erc20Address: pair.Erc20Address,
+		Denom:        pair.Denom,
+		TotalSupply:  sdk.ZeroInt(),
+		Escrow:       sdk.ZeroInt(),
+		Supply:       k.bankKeeper.GetSupply(ctx, pair.Denom).Amount,
+	}
+
+	// EVM calls modify the module account nonce, so the state transitions are
+	// discarded to keep the checks side-effect free
+	cacheCtx, _ := ctx.CacheContext()
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+
+	acc := k.evmKeeper.GetAccountWithoutBalance(cacheCtx, contract)
+	if acc != nil {
+		health.CodeHash = common.BytesToHash(acc.CodeHash).Hex()
+	}
+
+	health.ContractExists = acc != nil && acc.IsContract()
+	if !health.ContractExists {
+		if pair.IsNativeCoin() {
+			health.Escrow = k.escrowedCoins(ctx, pair)
+		}
+
+		health.Issues = append(health.Issues, "ERC20 contract has no code")
+		return health
+	}
+
+	totalSupply := k.totalSupply(cacheCtx, erc20, pair)
+	if totalSupply == nil {
+		health.Issues = append(health.Issues, "failed to query the ERC20 total supply")
+	} else {
+		health.TotalSupply = sdk.NewIntFromBigInt(totalSupply)
+	}
+
+	if issue := k.checkDecimals(cacheCtx, &health, pair); issue != "" {
+		health.Issues = append(health.Issues, issue)
+	}
+
+	switch {
+	case k.IsWrappedEVMDenomPair(ctx, pair):
+		health.Escrow = k.bankKeeper.GetBalance(ctx, contract.Bytes(), pair.Denom).Amount
+		if totalSupply != nil && health.Escrow.LT(health.TotalSupply) {
+			health.Issues = append(health.Issues, fmt.Sprintf("escrowed coins %s don't cover the ERC20 total supply %s", health.Escrow, health.TotalSupply))
+		}
+	case pair.IsNativeCoin():
+		health.Escrow = k.escrowedCoins(ctx, pair)
+		if totalSupply != nil && !pair.CoinsToTokens(health.Escrow).Equal(health.TotalSupply) {
+			health.Issues = append(health.Issues, fmt.Sprintf("escrowed coins %s don't match the ERC20 total supply %s", health.Escrow, health.TotalSupply))
+		}
+	case pair.IsNativeERC20():
+		escrowed := k.balanceOf(cacheCtx, erc20, contract, types.ModuleAddress)
+		if escrowed == nil {
+			health.Issues = append(health.Issues, "failed to query the ERC20 balance of the module account")
+			break
+		}
+
+		health.Escrow = sdk.NewIntFromBigInt(escrowed)
+		if !pair.CoinsToTokens(health.Supply).Equal(health.Escrow) {
+			health.Issues = append(health.Issues, fmt.Sprintf("coin supply %s doesn't match the escrowed ERC20 balance %s", health.Supply, health.Escrow))
+		}
+	}
+
+	health.Healthy = len(health.Issues) == 0
+	return health
+}
+
+// checkDecimals queries the ERC20 decimals of the token pair and checks that
+// the coin metadata contains a denom unit with the decimals scaled down by the
+// pair scaling exponent. It returns the issue found, if any.
+func (k Keeper) checkDecimals(ctx sdk.Context, health *types.TokenPairHealth, pair types.TokenPair) string {
+	var decimalRes types.ERC20Uint8Response
+
+	erc20 := contracts.ERC20BurnableContract.ABI
+	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, pair.GetERC20Contract(), "decimals")
+	if err != nil {
+		return "failed to query the ERC20 decimals"
+	}
+
+	if err := erc20.UnpackIntoInterface(&decimalRes, "decimals", res.Ret); err != nil {
+		return "failed to unpack the ERC20 decimals"
+	}
+
+	health.Decimals = uint32(decimalRes.Value)
+
+	decimals := int64(health.Decimals) - int64(pair.ScalingExponent)
+	if decimals < 0 {
+		return fmt.Sprintf("ERC20 decimals %d lower than the scaling exponent %d", health.Decimals, pair.ScalingExponent)
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, pair.Denom)
+	if !found {
+		return "coin metadata not found"
+	}
+
+	for _, denomUnit := range metadata.DenomUnits {
+		if int64(denomUnit.Exponent) == decimals {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("coin metadata has no denom unit with the scaled ERC20 decimals %d", decimals)
+}
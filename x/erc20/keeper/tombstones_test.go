@@ -0,0 +1,342 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tharsis/ethermint/tests"
+
+	"github.com/tharsis/evmos/x/erc20"
+	"github.com/tharsis/evmos/x/erc20/keeper"
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// setupTombstonedCoinPair registers a Cosmos coin, converts the given amount
+// into ERC20 tokens, destroys the ERC20 contract and tombstones the token pair
+// at the end of the block
+func (suite *KeeperTestSuite) setupTombstonedCoinPair(amount int64) types.TokenPair {
+	_, pair := suite.setupRegisterCoin()
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, amount+10))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+
+	msg := types.NewMsgConvertCoin(sdk.NewInt64Coin(cosmosTokenBase, amount), suite.address, sender)
+	_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	stateDb := suite.StateDB()
+	suite.Require().True(stateDb.Suicide(pair.GetERC20Contract()))
+	suite.Require().NoError(stateDb.Commit())
+	suite.Commit()
+
+	suite.app.Erc20Keeper.EndBlocker(suite.ctx)
+	suite.Commit()
+
+	msg = types.NewMsgConvertCoin(sdk.NewInt64Coin(cosmosTokenBase, 10), suite.address, sender)
+
+	// the conversion fails on the tombstoned pair
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrTokenPairTombstone)
+
+	return *pair
+}
+
+func (suite *KeeperTestSuite) TestGetAllTokenPairTombstones() {
+	suite.SetupTest()
+
+	tombstones := suite.app.Erc20Keeper.GetAllTokenPairTombstones(suite.ctx)
+	suite.Require().Empty(tombstones)
+
+	pair := types.NewTokenPair(tests.GenerateAddress(), "coin", true, types.OWNER_MODULE)
+	pair2 := types.NewTokenPair(tests.GenerateAddress(), "coin", true, types.OWNER_MODULE)
+	tombstone := types.NewTokenPairTombstone(pair, 1, "destroyed", sdk.NewInt(100), sdk.NewInt(100))
+	tombstone2 := types.NewTokenPairTombstone(pair2, 1, "destroyed", sdk.NewInt(50), sdk.NewInt(100))
+	suite.app.Erc20Keeper.SetTokenPairTombstone(suite.ctx, tombstone)
+	suite.app.Erc20Keeper.SetTokenPairTombstone(suite.ctx, tombstone2)
+
+	tombstones = suite.app.Erc20Keeper.GetAllTokenPairTombstones(suite.ctx)
+	suite.Require().Len(tombstones, 2)
+
+	res, found := suite.app.Erc20Keeper.GetTokenPairTombstone(suite.ctx, pair.GetERC20Contract())
+	suite.Require().True(found)
+	suite.Require().Equal(tombstone, res)
+
+	suite.Require().Equal(sdk.NewInt(150), suite.app.Erc20Keeper.GetTombstonedEscrow(suite.ctx, "coin"))
+
+	tombstone.Recovered = true
+	suite.app.Erc20Keeper.SetTokenPairTombstone(suite.ctx, tombstone)
+	suite.Require().Equal(sdk.NewInt(50), suite.app.Erc20Keeper.GetTombstonedEscrow(suite.ctx, "coin"))
+}
+
+func (suite *KeeperTestSuite) TestTombstoneSelfDestructedPair() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	pair := suite.setupTombstonedCoinPair(100)
+	suite.mintFeeCollector = false
+
+	id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, pair.Erc20Address)
+	suite.Require().Nil(id)
+	suite.Require().False(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, pair.Denom))
+
+	tombstone, found := suite.app.Erc20Keeper.GetTokenPairTombstone(suite.ctx, pair.GetERC20Contract())
+	suite.Require().True(found)
+	suite.Require().Equal(pair.Denom, tombstone.Denom)
+	suite.Require().Equal(types.OWNER_MODULE, tombstone.ContractOwner)
+	suite.Require().Equal(sdk.NewInt(100), tombstone.Escrow)
+	suite.Require().False(tombstone.Recovered)
+
+	// the escrow stranded on the module account doesn't break the invariant
+	msg, broken := keeper.NativeCoinEscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
+
+func (suite *KeeperTestSuite) TestTombstoneSelfDestructedPairs() {
+	suite.SetupTest()
+	_, pair := suite.setupRegisterCoin()
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	contract := suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	suite.Commit()
+	other, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract, types.TOKEN_BEHAVIOUR_STANDARD, 0, 0, nil)
+	suite.Require().NoError(err)
+
+	stateDb := suite.StateDB()
+	suite.Require().True(stateDb.Suicide(pair.GetERC20Contract()))
+	suite.Require().NoError(stateDb.Commit())
+	suite.Commit()
+
+	// the conversions fail without writing the tombstone
+	coin := sdk.NewInt64Coin(pair.Denom, 10)
+	msgs := []sdk.Msg{
+		types.NewMsgConvertCoin(coin, suite.address, sender),
+		types.NewMsgConvertCoins(sdk.NewCoins(coin), suite.address, sender),
+		types.NewMsgConvertERC20S([]types.ERC20Amount{{ContractAddress: pair.Erc20Address, Amount: sdk.NewInt(10)}}, sender, suite.address),
+		types.NewMsgTransferERC20(sdk.NewInt(10), pair.GetERC20Contract(), suite.address, "transfer", "channel-0", sender.String(), clienttypes.NewHeight(0, 100), 0),
+	}
+	handler := erc20.NewHandler(suite.app.Erc20Keeper)
+	for _, msg := range msgs {
+		_, err := handler(suite.ctx, msg)
+		suite.Require().ErrorIs(err, types.ErrTokenPairTombstone, sdk.MsgTypeURL(msg))
+	}
+
+	_, found := suite.app.Erc20Keeper.GetTokenPairTombstone(suite.ctx, pair.GetERC20Contract())
+	suite.Require().False(found)
+
+	suite.app.Erc20Keeper.EndBlocker(suite.ctx)
+
+	_, found = suite.app.Erc20Keeper.GetTokenPairTombstone(suite.ctx, pair.GetERC20Contract())
+	suite.Require().True(found)
+	suite.Require().False(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, pair.Denom))
+
+	// the token pairs with code are kept
+	suite.Require().True(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, other.Denom))
+}
+
+func (suite *KeeperTestSuite) TestRecoverTokenPairEscrow() {
+	var (
+		contract common.Address
+		shares   []types.EscrowRecoveryShare
+	)
+
+	holder := tests.GenerateAddress()
+	holder2 := tests.GenerateAddress()
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expHolder    int64
+		expHolder2   int64
+		expCommunity int64
+		expPass      bool
+	}{
+		{
+			"fail - token pair not tombstoned",
+			func() {
+				contract = tests.GenerateAddress()
+			},
+			0, 0, 0,
+			false,
+		},
+		{
+			"fail - no escrow to recover",
+			func() {
+				pair := types.NewTokenPair(tests.GenerateAddress(), cosmosTokenBase, true, types.OWNER_EXTERNAL)
+				suite.app.Erc20Keeper.SetTokenPairTombstone(suite.ctx, types.NewTokenPairTombstone(pair, 1, "destroyed", sdk.ZeroInt(), sdk.ZeroInt()))
+				contract = pair.GetERC20Contract()
+			},
+			0, 0, 0,
+			false,
+		},
+		{
+			"fail - escrow already recovered",
+			func() {
+				pair := suite.setupTombstonedCoinPair(100)
+				contract = pair.GetERC20Contract()
+				_, _, err := suite.app.Erc20Keeper.RecoverTokenPairEscrow(suite.ctx, contract, nil)
+				suite.Require().NoError(err)
+			},
+			0, 0, 0,
+			false,
+		},
+		{
+			"ok - community pool",
+			func() {
+				pair := suite.setupTombstonedCoinPair(100)
+				contract = pair.GetERC20Contract()
+				shares = nil
+			},
+			0, 0, 100,
+			true,
+		},
+		{
+			"ok - pro-rata with remainder",
+			func() {
+				pair := suite.setupTombstonedCoinPair(100)
+				contract = pair.GetERC20Contract()
+				shares = []types.EscrowRecoveryShare{
+					{Address: holder.String(), Amount: sdk.NewInt(2)},
+					{Address: sdk.AccAddress(holder2.Bytes()).String(), Amount: sdk.NewInt(1)},
+				}
+			},
+			66, 33, 1,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			shares = nil
+			tc.malleate()
+			suite.mintFeeCollector = false
+
+			communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+
+			tombstone, recovered, err := suite.app.Erc20Keeper.RecoverTokenPairEscrow(suite.ctx, contract, shares)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(tombstone.Recovered)
+				suite.Require().Equal(sdk.NewInt(tc.expCommunity), recovered.AmountOf(cosmosTokenBase))
+
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, holder.Bytes(), cosmosTokenBase)
+				suite.Require().Equal(tc.expHolder, balance.Amount.Int64())
+				balance = suite.app.BankKeeper.GetBalance(suite.ctx, holder2.Bytes(), cosmosTokenBase)
+				suite.Require().Equal(tc.expHolder2, balance.Amount.Int64())
+
+				funded := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).Sub(communityPool)
+				suite.Require().Equal(int64(tc.expCommunity), funded.AmountOf(cosmosTokenBase).TruncateInt64())
+
+				suite.Require().True(suite.app.Erc20Keeper.GetTombstonedEscrow(suite.ctx, cosmosTokenBase).IsZero())
+				msg, broken := keeper.NativeCoinEscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+				suite.Require().False(broken, msg)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrTokenPairTombstone)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetTokenPairHealth() {
+	var pair types.TokenPair
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expDecimals uint32
+		expHealthy  bool
+		expExists   bool
+	}{
+		{
+			"ok - native coin",
+			func() {
+				_, coinPair := suite.setupRegisterCoin()
+				pair = *coinPair
+
+				sender := sdk.AccAddress(suite.address.Bytes())
+				coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 100))
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+				suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sender, coins))
+
+				msg := types.NewMsgConvertCoin(sdk.NewInt64Coin(cosmosTokenBase, 100), suite.address, sender)
+				_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			0,
+			true,
+			true,
+		},
+		{
+			"fail - native coin escrow doesn't match the total supply",
+			func() {
+				_, coinPair := suite.setupRegisterCoin()
+				pair = *coinPair
+
+				coins := sdk.NewCoins(sdk.NewInt64Coin(cosmosTokenBase, 100))
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+			},
+			0,
+			false,
+			true,
+		},
+		{
+			"fail - contract has no code",
+			func() {
+				_, coinPair := suite.setupRegisterCoin()
+				pair = *coinPair
+
+				stateDb := suite.StateDB()
+				suite.Require().True(stateDb.Suicide(pair.GetERC20Contract()))
+				suite.Require().NoError(stateDb.Commit())
+			},
+			0,
+			false,
+			false,
+		},
+		{
+			"ok - native ERC20",
+			func() {
+				contractAddr := suite.setupRegisterERC20Pair(contractMinterBurner)
+				id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, contractAddr.String())
+				pair, _ = suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+				suite.Commit()
+
+				msg := types.NewMsgConvertERC20(sdk.NewInt(100), sdk.AccAddress(suite.address.Bytes()), contractAddr, suite.address)
+				_, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			uint32(erc20Decimals),
+			true,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			tc.malleate()
+			suite.Commit()
+			suite.mintFeeCollector = false
+
+			health := suite.app.Erc20Keeper.GetTokenPairHealth(suite.ctx, pair)
+			suite.Require().Equal(tc.expHealthy, health.Healthy, health.Issues)
+			suite.Require().Equal(tc.expExists, health.ContractExists)
+			suite.Require().Equal(pair.Erc20Address, health.Erc20Address)
+			if tc.expHealthy {
+				suite.Require().Empty(health.Issues)
+				suite.Require().Equal(tc.expDecimals, health.Decimals)
+				suite.Require().Equal(sdk.NewInt(100), health.TotalSupply)
+				suite.Require().Equal(sdk.NewInt(100), health.Escrow)
+			} else {
+				suite.Require().NotEmpty(health.Issues)
+			}
+		})
+	}
+}
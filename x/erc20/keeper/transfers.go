@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+)
+
+// GetAllERC20Transfers returns the ERC20 transfers whose packet is not
+// acknowledged or timed out yet
+func (k Keeper) GetAllERC20Transfers(ctx sdk.Context) []types.ERC20Transfer {
+	transfers := []types.ERC20Transfer{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixERC20Transfer)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is {portID}/{channelID}/{sequence}, as the identifiers can't
+		// contain a slash
+		key := iterator.Key()[len(types.KeyPrefixERC20Transfer):]
+		ids := strings.Split(string(key[:len(key)-8]), "/")
+		sequence := sdk.BigEndianToUint64(key[len(key)-8:])
+
+		transfers = append(transfers, types.NewERC20Transfer(ids[0], ids[1], sequence, common.BytesToAddress(iterator.Value())))
+	}
+
+	return transfers
+}
+
+// GetERC20Transfer returns the ERC20 contract address of the tokens sent on
+// the packet with the given source port, channel and sequence.
+func (k Keeper) GetERC20Transfer(ctx sdk.Context, portID, channelID string, sequence uint64) (common.Address, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixERC20Transfer)
+	bz := store.Get(types.ERC20TransferKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(bz), true
+}
+
+// SetERC20Transfer stores the ERC20 contract address of the tokens sent on
+// the packet with the given source port, channel and sequence.
+func (k Keeper) SetERC20Transfer(ctx sdk.Context, portID, channelID string, sequence uint64, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixERC20Transfer)
+	store.Set(types.ERC20TransferKey(portID, channelID, sequence), contract.Bytes())
+}
+
+// DeleteERC20Transfer removes the ERC20 transfer sent on the packet with the
+// given source port, channel and sequence.
+func (k Keeper) DeleteERC20Transfer(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixERC20Transfer)
+	store.Delete(types.ERC20TransferKey(portID, channelID, sequence))
+}
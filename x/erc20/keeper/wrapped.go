@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
+)
+
+// GetWrappedEVMDenomContract returns the address of the ERC20 contract
+// wrapping the EVM denomination, if registered
+func (k Keeper) GetWrappedEVMDenomContract(ctx sdk.Context) (common.Address, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyWrappedEVMDenom)
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
+
+// SetWrappedEVMDenomContract stores the address of the ERC20 contract wrapping
+// the EVM denomination
+func (k Keeper) SetWrappedEVMDenomContract(ctx sdk.Context, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyWrappedEVMDenom, contract.Bytes())
+}
+
+// IsWrappedEVMDenomPair returns true if the token pair is the wrapped EVM
+// denomination token pair
+func (k Keeper) IsWrappedEVMDenomPair(ctx sdk.Context, pair types.TokenPair) bool {
+	contract, found := k.GetWrappedEVMDenomContract(ctx)
+	return found && contract == pair.GetERC20Contract()
+}
+
+// RegisterWrappedEVMDenom deploys the WEVMOS contract and registers it as the
+// canonical token pair of the EVM denomination, owned by the module. The
+// contract address is derived from the EVM denomination, so that it is the
+// same on every chain registering it on genesis or on an upgrade.
+func (k Keeper) RegisterWrappedEVMDenom(ctx sdk.Context) (*types.TokenPair, error) {
+	params := k.GetParams(ctx)
+	if !params.EnableErc20 {
+		return nil, sdkerrors.Wrap(types.ErrInternalTokenPair, "intrarelaying is currently disabled by governance")
+	}
+
+	if contract, found := k.GetWrappedEVMDenomContract(ctx); found {
+		return nil, sdkerrors.Wrapf(types.ErrInternalTokenPair, "wrapped EVM denom already registered: %s", contract)
+	}
+
+	denom := k.evmKeeper.GetParams(ctx).EvmDenom
+	if k.IsDenomRegistered(ctx, denom) {
+		return nil, sdkerrors.Wrapf(types.ErrInternalTokenPair, "coin denomination already registered: %s", denom)
+	}
+
+	salt := types.CreateERC20Salt(denom)
+	addr, err := k.CallEVMCreate2(ctx, types.ModuleAddress, salt, contracts.WEVMOSCreationCode(types.ModuleAddress))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to deploy the wrapped EVM denom contract")
+	}
+
+	pair := types.NewTokenPair(addr, denom, true, types.OWNER_MODULE)
+	k.SetTokenPair(ctx, pair)
+	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
+	k.SetERC20Map(ctx, addr, pair.GetID())
+	k.SetWrappedEVMDenomContract(ctx, addr)
+
+	return &pair, nil
+}
+
+// escrowWrappedEVMDenom moves the escrowed coins backing the tokens minted on
+// the wrapped EVM denomination contract from the module account to the
+// contract, so that every token can be withdrawn from the contract. It is a
+// no-op for any other token pair.
+func (k Keeper) escrowWrappedEVMDenom(ctx sdk.Context, pair types.TokenPair, amount sdk.Int) error {
+	if !amount.IsPositive() || !k.IsWrappedEVMDenomPair(ctx, pair) {
+		return nil
+	}
+
+	coins := sdk.Coins{{Denom: pair.Denom, Amount: amount}}
+	contract := sdk.AccAddress(pair.GetERC20Contract().Bytes())
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contract, coins); err != nil {
+		return sdkerrors.Wrap(err, "failed to escrow coins on the wrapped EVM denom contract")
+	}
+	return nil
+}
+
+// unescrowWrappedEVMDenom moves the coins backing the tokens burned on the
+// wrapped EVM denomination contract from the contract back to the module
+// account. It is a no-op for any other token pair.
+func (k Keeper) unescrowWrappedEVMDenom(ctx sdk.Context, pair types.TokenPair, amount sdk.Int) error {
+	if !amount.IsPositive() || !k.IsWrappedEVMDenomPair(ctx, pair) {
+		return nil
+	}
+
+	coins := sdk.Coins{{Denom: pair.Denom, Amount: amount}}
+	contract := sdk.AccAddress(pair.GetERC20Contract().Bytes())
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, contract, types.ModuleName, coins); err != nil {
+		return sdkerrors.Wrap(err, "failed to unescrow coins from the wrapped EVM denom contract")
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/tharsis/evmos/x/erc20"
+	"github.com/tharsis/evmos/x/erc20/keeper"
+	"github.com/tharsis/evmos/x/erc20/types"
+	"github.com/tharsis/evmos/x/erc20/types/contracts"
+)
+
+// fundEVMDenom mints the given amount of the EVM denom to the test account
+func (suite *KeeperTestSuite) fundEVMDenom(amount int64) sdk.Coin {
+	coin := sdk.NewCoin(suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom, sdk.NewInt(amount))
+	err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.Coins{coin})
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(), sdk.Coins{coin})
+	suite.Require().NoError(err)
+	return coin
+}
+
+// requireWrappedEVMDenomBacked checks that the EVM denom balance of the WEVMOS
+// contract equals its total supply
+func (suite *KeeperTestSuite) requireWrappedEVMDenomBacked(pair types.TokenPair, expSupply int64) {
+	escrowed := suite.app.BankKeeper.GetBalance(suite.ctx, pair.GetERC20Contract().Bytes(), pair.Denom)
+	suite.Require().Equal(sdk.NewInt(expSupply), escrowed.Amount)
+
+	wevmos := contracts.WEVMOSContract.ABI
+	cacheCtx, _ := suite.ctx.CacheContext()
+	res, err := suite.app.Erc20Keeper.CallEVM(cacheCtx, wevmos, types.ModuleAddress, pair.GetERC20Contract(), "totalSupply")
+	suite.Require().NoError(err)
+	totalSupply, err := wevmos.Unpack("totalSupply", res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expSupply, totalSupply[0].(*big.Int).Int64())
+
+	msg, broken := keeper.NativeCoinEscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
+
+func (suite *KeeperTestSuite) TestRegisterWrappedEVMDenom() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"ok",
+			func() {},
+			true,
+		},
+		{
+			"conversion is disabled globally",
+			func() {
+				params := types.DefaultParams()
+				params.EnableErc20 = false
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			false,
+		},
+		{
+			"wrapped evm denom already registered",
+			func() {
+				_, err := suite.app.Erc20Keeper.RegisterWrappedEVMDenom(suite.ctx)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"evm denom already registered",
+			func() {
+				denom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+				suite.app.Erc20Keeper.SetDenomMap(suite.ctx, denom, []byte("id"))
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			pair, err := suite.app.Erc20Keeper.RegisterWrappedEVMDenom(suite.ctx)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom, pair.Denom)
+			suite.Require().True(pair.IsNativeCoin())
+			suite.Require().True(pair.Enabled)
+
+			contract, found := suite.app.Erc20Keeper.GetWrappedEVMDenomContract(suite.ctx)
+			suite.Require().True(found)
+			suite.Require().Equal(pair.GetERC20Contract(), contract)
+			suite.Require().True(suite.app.Erc20Keeper.IsWrappedEVMDenomPair(suite.ctx, *pair))
+			suite.Require().Equal(pair.GetID(), suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, pair.Denom))
+
+			erc20, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, contract)
+			suite.Require().NoError(err)
+			suite.Require().Equal("Wrapped Evmos", erc20.Name)
+			suite.Require().Equal("WEVMOS", erc20.Symbol)
+			suite.Require().Equal(uint8(18), erc20.Decimals)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWrappedEVMDenomDepositWithdraw() {
+	suite.SetupTest()
+	pair, err := suite.app.Erc20Keeper.RegisterWrappedEVMDenom(suite.ctx)
+	suite.Require().NoError(err)
+	contract := pair.GetERC20Contract()
+	wevmos := contracts.WEVMOSContract.ABI
+	suite.fundEVMDenom(1000)
+
+	// deposit through the deposit function and the receive function
+	deposit, err := wevmos.Pack("deposit")
+	suite.Require().NoError(err)
+	_, res := suite.deliverTxWithValue(contract, suite.address, big.NewInt(300), deposit)
+	suite.Require().Empty(res.VmError)
+	_, res = suite.deliverTxWithValue(contract, suite.address, big.NewInt(200), nil)
+	suite.Require().Empty(res.VmError)
+	suite.Commit()
+
+	suite.Require().Equal(big.NewInt(500), suite.BalanceOf(contract, suite.address).(*big.Int))
+	suite.requireWrappedEVMDenomBacked(*pair, 500)
+
+	// withdraw more than the balance
+	_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, wevmos, suite.address, contract, "withdraw", big.NewInt(501))
+	suite.Require().Error(err)
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), pair.Denom)
+	withdraw, err := wevmos.Pack("withdraw", big.NewInt(120))
+	suite.Require().NoError(err)
+	_, res = suite.deliverTx(contract, suite.address, withdraw)
+	suite.Require().Empty(res.VmError)
+	suite.Commit()
+
+	// the balance also includes the refunded gas
+	balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), pair.Denom)
+	suite.Require().True(balanceAfter.Amount.GTE(balance.Amount.AddRaw(120)))
+	suite.Require().Equal(big.NewInt(380), suite.BalanceOf(contract, suite.address).(*big.Int))
+	suite.requireWrappedEVMDenomBacked(*pair, 380)
+}
+
+func (suite *KeeperTestSuite) TestWrappedEVMDenomConversions() {
+	suite.SetupTest()
+	pair, err := suite.app.Erc20Keeper.RegisterWrappedEVMDenom(suite.ctx)
+	suite.Require().NoError(err)
+	contract := pair.GetERC20Contract()
+	sender := sdk.AccAddress(suite.address.Bytes())
+	coin := suite.fundEVMDenom(1000)
+
+	// converted coins are moved from the module account to the contract
+	msgConvertCoin := types.NewMsgConvertCoin(coin, suite.address, sender)
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msgConvertCoin)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, pair.Denom).IsZero())
+	suite.Require().Equal(big.NewInt(1000), suite.BalanceOf(contract, suite.address).(*big.Int))
+	suite.requireWrappedEVMDenomBacked(*pair, 1000)
+
+	// converted tokens are unescrowed from the contract
+	msgConvertERC20 := types.NewMsgConvertERC20(sdk.NewInt(400), sender, contract, suite.address)
+	_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msgConvertERC20)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	suite.Require().Equal(sdk.NewInt(400), suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom).Amount)
+	suite.requireWrappedEVMDenomBacked(*pair, 600)
+
+	// tokens sent to the module account through the EVM hook are unescrowed
+	suite.ensureHooksSet()
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom)
+	suite.TransferERC20Token(contract, suite.address, types.ModuleAddress, big.NewInt(100))
+	suite.Commit()
+
+	balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom)
+	suite.Require().True(balanceAfter.Amount.GTE(balance.Amount.AddRaw(100)))
+	suite.requireWrappedEVMDenomBacked(*pair, 500)
+
+	// tokens minted with ConvertCoin can be withdrawn from the contract
+	withdraw, err := contracts.WEVMOSContract.ABI.Pack("withdraw", big.NewInt(500))
+	suite.Require().NoError(err)
+	_, res := suite.deliverTx(contract, suite.address, withdraw)
+	suite.Require().Empty(res.VmError)
+	suite.Commit()
+
+	suite.Require().Equal(int64(0), suite.BalanceOf(contract, suite.address).(*big.Int).Int64())
+	suite.requireWrappedEVMDenomBacked(*pair, 0)
+}
+
+func (suite *KeeperTestSuite) TestWrappedEVMDenomEscrowInvariant() {
+	suite.SetupTest()
+	pair, err := suite.app.Erc20Keeper.RegisterWrappedEVMDenom(suite.ctx)
+	suite.Require().NoError(err)
+	contract := pair.GetERC20Contract()
+
+	deposit, err := contracts.WEVMOSContract.ABI.Pack("deposit")
+	suite.Require().NoError(err)
+	suite.fundEVMDenom(100)
+	_, res := suite.deliverTxWithValue(contract, suite.address, big.NewInt(100), deposit)
+	suite.Require().Empty(res.VmError)
+	suite.Commit()
+
+	// coins sent directly to the contract over-collateralize the supply
+	coins := sdk.Coins{suite.fundEVMDenom(10)}
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, suite.address.Bytes(), contract.Bytes(), coins)
+	suite.Require().NoError(err)
+
+	msg, broken := keeper.NativeCoinEscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+
+	// tokens minted without escrow break the invariant
+	_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, contracts.WEVMOSContract.ABI, types.ModuleAddress, contract, "mint", suite.address, big.NewInt(20))
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	msg, broken = keeper.NativeCoinEscrowInvariant(suite.app.Erc20Keeper)(suite.ctx)
+	suite.Require().True(broken, msg)
+}
+
+func (suite *KeeperTestSuite) TestRegisterWrappedEVMDenomProposal() {
+	suite.SetupTest()
+	handler := erc20.NewErc20ProposalHandler(&suite.app.Erc20Keeper)
+	proposal := types.NewRegisterWrappedEVMDenomProposal("register wevmos", "deploy the wrapped evm denom contract")
+
+	err := handler(suite.ctx, proposal)
+	suite.Require().NoError(err)
+
+	contract, found := suite.app.Erc20Keeper.GetWrappedEVMDenomContract(suite.ctx)
+	suite.Require().True(found)
+	denom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, denom))
+	suite.Require().True(found)
+	suite.Require().Equal(contract, pair.GetERC20Contract())
+
+	// the wrapped evm denom can only be registered once
+	err = handler(suite.ctx, proposal)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestWrappedEVMDenomProposals() {
+	suite.SetupTest()
+	pair, err := suite.app.Erc20Keeper.RegisterWrappedEVMDenom(suite.ctx)
+	suite.Require().NoError(err)
+
+	_, _, err = suite.app.Erc20Keeper.DeregisterTokenPair(suite.ctx, pair.Denom, nil)
+	suite.Require().Error(err)
+
+	metadata := banktypes.Metadata{
+		Description: "The native token of Evmos",
+		Base:        pair.Denom,
+		Display:     "evmos",
+		Name:        "Evmos",
+		Symbol:      "EVMOS",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: pair.Denom, Exponent: 0},
+			{Denom: "evmos", Exponent: 18},
+		},
+	}
+	_, err = suite.app.Erc20Keeper.UpdateCoinMetadata(suite.ctx, metadata)
+	suite.Require().Error(err)
+
+	_, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, pair.GetID())
+	suite.Require().True(found)
+}
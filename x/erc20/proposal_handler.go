@@ -1,6 +1,9 @@
 package erc20
 
 import (
+	"strconv"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -23,6 +26,28 @@ func NewErc20ProposalHandler(k *keeper.Keeper) govtypes.Handler {
 			return handleToggleRelayProposal(ctx, k, c)
 		case *types.UpdateTokenPairERC20Proposal:
 			return handleUpdateTokenPairERC20Proposal(ctx, k, c)
+		case *types.DeregisterTokenPairProposal:
+			return handleDeregisterTokenPairProposal(ctx, k, c)
+		case *types.UpdateRateLimitProposal:
+			return handleUpdateRateLimitProposal(ctx, k, c)
+		case *types.UpgradeERC20ImplementationProposal:
+			return handleUpgradeERC20ImplementationProposal(ctx, k, c)
+		case *types.UpdateCoinMetadataProposal:
+			return handleUpdateCoinMetadataProposal(ctx, k, c)
+		case *types.VetoERC20RegistrationProposal:
+			return handleVetoERC20RegistrationProposal(ctx, k, c)
+		case *types.ExtendPauseProposal:
+			return handleExtendPauseProposal(ctx, k, c)
+		case *types.UpdateConversionFeeProposal:
+			return handleUpdateConversionFeeProposal(ctx, k, c)
+		case *types.UpdateMintCapProposal:
+			return handleUpdateMintCapProposal(ctx, k, c)
+		case *types.UpdateSafetyPolicyProposal:
+			return handleUpdateSafetyPolicyProposal(ctx, k, c)
+		case *types.RecoverTokenPairEscrowProposal:
+			return handleRecoverTokenPairEscrowProposal(ctx, k, c)
+		case *types.RegisterWrappedEVMDenomProposal:
+			return handleRegisterWrappedEVMDenomProposal(ctx, k, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
@@ -31,7 +56,7 @@ func NewErc20ProposalHandler(k *keeper.Keeper) govtypes.Handler {
 }
 
 func handleRegisterCoinProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RegisterCoinProposal) error {
-	pair, err := k.RegisterCoin(ctx, p.Metadata)
+	pair, err := k.RegisterCoin(ctx, p.Metadata, p.ScalingExponent, p.GasLimit)
 	if err != nil {
 		return err
 	}
@@ -40,6 +65,7 @@ func handleRegisterCoinProposal(ctx sdk.Context, k *keeper.Keeper, p *types.Regi
 			types.EventTypeRegisterCoin,
 			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
 			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyScalingExponent, strconv.FormatUint(uint64(pair.ScalingExponent), 10)),
 		),
 	)
 
@@ -47,7 +73,7 @@ func handleRegisterCoinProposal(ctx sdk.Context, k *keeper.Keeper, p *types.Regi
 }
 
 func handleRegisterERC20Proposal(ctx sdk.Context, k *keeper.Keeper, p *types.RegisterERC20Proposal) error {
-	pair, err := k.RegisterERC20(ctx, common.HexToAddress(p.Erc20Address))
+	pair, err := k.RegisterERC20(ctx, common.HexToAddress(p.Erc20Address), p.Behaviour, p.ScalingExponent, p.GasLimit, p.Metadata)
 	if err != nil {
 		return err
 	}
@@ -56,6 +82,8 @@ func handleRegisterERC20Proposal(ctx sdk.Context, k *keeper.Keeper, p *types.Reg
 			types.EventTypeRegisterERC20,
 			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
 			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyBehaviour, pair.Behaviour.String()),
+			sdk.NewAttribute(types.AttributeKeyScalingExponent, strconv.FormatUint(uint64(pair.ScalingExponent), 10)),
 		),
 	)
 
@@ -95,3 +123,219 @@ func handleUpdateTokenPairERC20Proposal(ctx sdk.Context, k *keeper.Keeper, p *ty
 
 	return nil
 }
+
+func handleDeregisterTokenPairProposal(ctx sdk.Context, k *keeper.Keeper, p *types.DeregisterTokenPairProposal) error {
+	holders, err := p.GetHolderAddresses()
+	if err != nil {
+		return err
+	}
+
+	pair, settlement, err := k.DeregisterTokenPair(ctx, p.Token, holders)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyRefunded, settlement.Refunded.String()),
+			sdk.NewAttribute(types.AttributeKeyHolders, strconv.FormatUint(settlement.Holders, 10)),
+			sdk.NewAttribute(types.AttributeKeyEscrowed, settlement.Escrowed.String()),
+		),
+	)
+
+	return nil
+}
+
+func handleUpdateRateLimitProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateRateLimitProposal) error {
+	rateLimit, err := k.UpdateRateLimit(ctx, p.Token, p.MaxPerConversion, p.InflowCap, p.OutflowCap, p.Window, p.EpochIdentifier)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateRateLimit,
+			sdk.NewAttribute(types.AttributeKeyERC20Token, rateLimit.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyMaxPerConversion, rateLimit.MaxPerConversion.String()),
+			sdk.NewAttribute(types.AttributeKeyInflowCap, rateLimit.InflowCap.String()),
+			sdk.NewAttribute(types.AttributeKeyOutflowCap, rateLimit.OutflowCap.String()),
+			sdk.NewAttribute(types.AttributeKeyWindow, strconv.FormatUint(rateLimit.Window, 10)),
+			sdk.NewAttribute(types.AttributeKeyEpochIdentifier, rateLimit.EpochIdentifier),
+		),
+	)
+
+	return nil
+}
+
+func handleUpgradeERC20ImplementationProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpgradeERC20ImplementationProposal) error {
+	proxies := make([]common.Address, len(p.Erc20Addresses))
+	for i, addr := range p.Erc20Addresses {
+		proxies[i] = common.HexToAddress(addr)
+	}
+
+	upgraded, err := k.UpgradeERC20Implementation(ctx, p.Implementation, proxies)
+	if err != nil {
+		return err
+	}
+
+	for _, proxy := range upgraded {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUpgradeImplementation,
+				sdk.NewAttribute(types.AttributeKeyERC20Token, proxy.String()),
+				sdk.NewAttribute(types.AttributeKeyImplementation, p.Implementation),
+			),
+		)
+	}
+
+	return nil
+}
+
+func handleUpdateCoinMetadataProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateCoinMetadataProposal) error {
+	pair, err := k.UpdateCoinMetadata(ctx, p.Metadata)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateCoinMetadata,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyName, p.Metadata.Name),
+			sdk.NewAttribute(types.AttributeKeySymbol, p.Metadata.Symbol),
+		),
+	)
+
+	return nil
+}
+
+func handleVetoERC20RegistrationProposal(ctx sdk.Context, k *keeper.Keeper, p *types.VetoERC20RegistrationProposal) error {
+	registration, err := k.VetoERC20Registration(ctx, common.HexToAddress(p.Erc20Address))
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVetoRegistration,
+			sdk.NewAttribute(types.AttributeKeyERC20Token, registration.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyRegistrant, registration.Registrant),
+			sdk.NewAttribute(types.AttributeKeyBurned, registration.Deposit.String()),
+		),
+	)
+
+	return nil
+}
+
+func handleExtendPauseProposal(ctx sdk.Context, k *keeper.Keeper, p *types.ExtendPauseProposal) error {
+	pause, err := k.ExtendPause(ctx, p.Token, p.Duration)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExtendPause,
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pause.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyExpiry, pause.Expiry.String()),
+		),
+	)
+
+	return nil
+}
+
+func handleUpdateConversionFeeProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateConversionFeeProposal) error {
+	conversionFee, err := k.UpdateConversionFee(ctx, p.Token, p.Rate, p.MinFee, p.UseGlobalFee)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateConversionFee,
+			sdk.NewAttribute(types.AttributeKeyERC20Token, conversionFee.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyFeeRate, conversionFee.Rate.String()),
+			sdk.NewAttribute(types.AttributeKeyMinFee, conversionFee.MinFee.String()),
+		),
+	)
+
+	return nil
+}
+
+func handleUpdateMintCapProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateMintCapProposal) error {
+	mintCap, err := k.UpdateMintCap(ctx, p.Token, p.Cap)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateMintCap,
+			sdk.NewAttribute(types.AttributeKeyERC20Token, mintCap.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyMintCap, mintCap.Cap.String()),
+		),
+	)
+
+	return nil
+}
+
+func handleUpdateSafetyPolicyProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateSafetyPolicyProposal) error {
+	policy, err := k.UpdateSafetyPolicy(ctx, p.Token, p.AllowedEventSignatures, p.AllowedEmitters, p.MaxLogs, p.UseDefaultPolicy)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateSafetyPolicy,
+			sdk.NewAttribute(types.AttributeKeyERC20Token, policy.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyAllowedEvents, strings.Join(policy.AllowedEventSignatures, ",")),
+			sdk.NewAttribute(types.AttributeKeyAllowedEmitters, strings.Join(policy.AllowedEmitters, ",")),
+			sdk.NewAttribute(types.AttributeKeyMaxLogs, strconv.FormatUint(uint64(policy.MaxLogs), 10)),
+		),
+	)
+
+	return nil
+}
+
+func handleRecoverTokenPairEscrowProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RecoverTokenPairEscrowProposal) error {
+	contract := common.HexToAddress(p.Erc20Address)
+	tombstone, communityPool, err := k.RecoverTokenPairEscrow(ctx, contract, p.Shares)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecoverEscrow,
+			sdk.NewAttribute(types.AttributeKeyERC20Token, tombstone.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, tombstone.Denom),
+			sdk.NewAttribute(types.AttributeKeyEscrowed, tombstone.Escrow.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipients, strconv.Itoa(len(p.Shares))),
+			sdk.NewAttribute(types.AttributeKeyCommunityPool, communityPool.String()),
+		),
+	)
+
+	return nil
+}
+
+func handleRegisterWrappedEVMDenomProposal(ctx sdk.Context, k *keeper.Keeper, _ *types.RegisterWrappedEVMDenomProposal) error {
+	pair, err := k.RegisterWrappedEVMDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterWrappedDenom,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return nil
+}
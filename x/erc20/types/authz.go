@@ -0,0 +1,205 @@
+package types
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// gasCostPerIteration is the gas consumed for every allowed receiver or ERC20
+// spend limit checked when accepting a conversion
+const gasCostPerIteration = uint64(10)
+
+var _ authz.Authorization = &ConvertAuthorization{}
+
+// NewConvertCoinAuthorization creates a ConvertAuthorization for MsgConvertCoin
+// with the given spend limit of Cosmos coins
+func NewConvertCoinAuthorization(spendLimit sdk.Coins, allowedReceivers []string) *ConvertAuthorization {
+	return &ConvertAuthorization{
+		MsgTypeUrl:       sdk.MsgTypeURL(&MsgConvertCoin{}),
+		SpendLimit:       spendLimit,
+		AllowedReceivers: allowedReceivers,
+	}
+}
+
+// NewConvertERC20Authorization creates a ConvertAuthorization for
+// MsgConvertERC20 with the given spend limit of ERC20 tokens
+func NewConvertERC20Authorization(tokenSpendLimit []ERC20Amount, allowedReceivers []string) *ConvertAuthorization {
+	return &ConvertAuthorization{
+		MsgTypeUrl:       sdk.MsgTypeURL(&MsgConvertERC20{}),
+		TokenSpendLimit:  tokenSpendLimit,
+		AllowedReceivers: allowedReceivers,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ConvertAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements Authorization.Accept. It decrements the spend limit of the
+// converted denomination or ERC20 contract by the converted amount and deletes
+// the authorization once all the spend limits are exhausted.
+func (a ConvertAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.MsgTypeUrl {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	switch msg := msg.(type) {
+	case *MsgConvertCoin:
+		if err := a.acceptReceiver(ctx, msg.Receiver); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		limitLeft, isNegative := a.SpendLimit.SafeSub(sdk.NewCoins(msg.Coin))
+		if isNegative {
+			return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+		}
+
+		if limitLeft.IsZero() {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+
+		updated := a
+		updated.SpendLimit = limitLeft
+		return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+
+	case *MsgConvertERC20:
+		if err := a.acceptReceiver(ctx, msg.Receiver); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		contract := common.HexToAddress(msg.ContractAddress)
+		limitLeft := make([]ERC20Amount, 0, len(a.TokenSpendLimit))
+		found := false
+
+		for _, limit := range a.TokenSpendLimit {
+			ctx.GasMeter().ConsumeGas(gasCostPerIteration, "convert authorization")
+
+			if common.HexToAddress(limit.ContractAddress) != contract {
+				limitLeft = append(limitLeft, limit)
+				continue
+			}
+
+			found = true
+			if limit.Amount.LT(msg.Amount) {
+				return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+			}
+
+			if remaining := limit.Amount.Sub(msg.Amount); remaining.IsPositive() {
+				limitLeft = append(limitLeft, ERC20Amount{ContractAddress: limit.ContractAddress, Amount: remaining})
+			}
+		}
+
+		if !found {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot convert tokens of contract %s", msg.ContractAddress)
+		}
+
+		if len(limitLeft) == 0 {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+
+		updated := a
+		updated.TokenSpendLimit = limitLeft
+		return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("unknown msg type")
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ConvertAuthorization) ValidateBasic() error {
+	switch a.MsgTypeUrl {
+	case sdk.MsgTypeURL(&MsgConvertCoin{}):
+		if len(a.TokenSpendLimit) != 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("token spend limit must be empty for coin conversions")
+		}
+		if a.SpendLimit.Empty() {
+			return sdkerrors.ErrInvalidCoins.Wrap("spend limit cannot be empty")
+		}
+		if !a.SpendLimit.IsValid() {
+			return sdkerrors.ErrInvalidCoins.Wrapf("invalid spend limit %s", a.SpendLimit)
+		}
+
+	case sdk.MsgTypeURL(&MsgConvertERC20{}):
+		if !a.SpendLimit.Empty() {
+			return sdkerrors.ErrInvalidRequest.Wrap("spend limit must be empty for ERC20 conversions")
+		}
+		if len(a.TokenSpendLimit) == 0 {
+			return sdkerrors.ErrInvalidCoins.Wrap("token spend limit cannot be empty")
+		}
+
+		seenContracts := make(map[common.Address]bool)
+		for _, limit := range a.TokenSpendLimit {
+			if !common.IsHexAddress(limit.ContractAddress) {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract hex address '%s'", limit.ContractAddress)
+			}
+
+			contract := common.HexToAddress(limit.ContractAddress)
+			if seenContracts[contract] {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated token spend limit for contract %s", limit.ContractAddress)
+			}
+			seenContracts[contract] = true
+
+			if limit.Amount.IsNil() || !limit.Amount.IsPositive() {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "token spend limit of contract %s must be positive", limit.ContractAddress)
+			}
+		}
+
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unsupported conversion msg type url %s", a.MsgTypeUrl)
+	}
+
+	for _, receiver := range a.AllowedReceivers {
+		if _, err := parseReceiver(receiver); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// acceptReceiver returns an error if the allowed receivers are set and don't
+// include the given receiver
+func (a ConvertAuthorization) acceptReceiver(ctx sdk.Context, receiver string) error {
+	if len(a.AllowedReceivers) == 0 {
+		return nil
+	}
+
+	receiverBz, err := parseReceiver(receiver)
+	if err != nil {
+		return err
+	}
+
+	for _, allowed := range a.AllowedReceivers {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "convert authorization")
+
+		allowedBz, err := parseReceiver(allowed)
+		if err != nil {
+			return err
+		}
+
+		if bytes.Equal(allowedBz, receiverBz) {
+			return nil
+		}
+	}
+
+	return sdkerrors.ErrUnauthorized.Wrapf("cannot convert to receiver %s", receiver)
+}
+
+// parseReceiver returns the bytes of a bech32 or hex receiver address
+func parseReceiver(receiver string) ([]byte, error) {
+	if common.IsHexAddress(receiver) {
+		return common.HexToAddress(receiver).Bytes(), nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address '%s'", receiver)
+	}
+	return addr, nil
+}
@@ -0,0 +1,522 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evmos/erc20/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ConvertAuthorization allows the grantee to convert the Cosmos coins or the
+// ERC20 tokens of the granter, up to the remaining spend limits and optionally
+// only to a set of receivers.
+type ConvertAuthorization struct {
+	// type URL of the authorized conversion message, either MsgConvertCoin or
+	// MsgConvertERC20
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// remaining amounts of Cosmos coins, per denomination, that can be converted
+	// through MsgConvertCoin
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// remaining amounts of ERC20 tokens, per contract, that can be converted
+	// through MsgConvertERC20
+	TokenSpendLimit []ERC20Amount `protobuf:"bytes,3,rep,name=token_spend_limit,json=tokenSpendLimit,proto3" json:"token_spend_limit"`
+	// addresses, either bech32 or hex, allowed to receive the converted tokens.
+	// An empty list allows any receiver.
+	AllowedReceivers []string `protobuf:"bytes,4,rep,name=allowed_receivers,json=allowedReceivers,proto3" json:"allowed_receivers,omitempty"`
+}
+
+func (m *ConvertAuthorization) Reset()         { *m = ConvertAuthorization{} }
+func (m *ConvertAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ConvertAuthorization) ProtoMessage()    {}
+func (*ConvertAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5fc2efe40a0cbd3, []int{0}
+}
+func (m *ConvertAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvertAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvertAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvertAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertAuthorization.Merge(m, src)
+}
+func (m *ConvertAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvertAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvertAuthorization proto.InternalMessageInfo
+
+func (m *ConvertAuthorization) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *ConvertAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *ConvertAuthorization) GetTokenSpendLimit() []ERC20Amount {
+	if m != nil {
+		return m.TokenSpendLimit
+	}
+	return nil
+}
+
+func (m *ConvertAuthorization) GetAllowedReceivers() []string {
+	if m != nil {
+		return m.AllowedReceivers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ConvertAuthorization)(nil), "evmos.erc20.v1.ConvertAuthorization")
+}
+
+func init() { proto.RegisterFile("evmos/erc20/v1/authz.proto", fileDescriptor_b5fc2efe40a0cbd3) }
+
+var fileDescriptor_b5fc2efe40a0cbd3 = []byte{
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcf, 0xae, 0xd2, 0x40,
+	0x14, 0xc6, 0xdb, 0xcb, 0x8d, 0x09, 0x83, 0xff, 0x68, 0x48, 0x04, 0x4c, 0x4a, 0xe3, 0xc2, 0x34,
+	0x31, 0xcc, 0x50, 0xdc, 0xb9, 0x12, 0x88, 0x3b, 0xdd, 0x54, 0xdd, 0xb8, 0x69, 0xda, 0x32, 0x69,
+	0x27, 0xb4, 0x3d, 0xcd, 0xcc, 0xb4, 0x02, 0x4f, 0xe1, 0x73, 0xb8, 0xd6, 0x77, 0x60, 0x49, 0x5c,
+	0xb9, 0x52, 0x03, 0x2f, 0x62, 0x3a, 0x1d, 0x23, 0xdc, 0x55, 0x7b, 0xe6, 0x37, 0xe7, 0x7c, 0xe7,
+	0xfb, 0x06, 0x8d, 0x69, 0x9d, 0x83, 0x20, 0x94, 0xc7, 0xf3, 0x19, 0xa9, 0x3d, 0x12, 0x56, 0x32,
+	0xdd, 0xe3, 0x92, 0x83, 0x04, 0xeb, 0xa1, 0x62, 0x58, 0x31, 0x5c, 0x7b, 0xe3, 0x41, 0x02, 0x09,
+	0x28, 0x44, 0x9a, 0xbf, 0xf6, 0xd6, 0x78, 0x14, 0x83, 0xc8, 0x41, 0x04, 0x2d, 0x68, 0x0b, 0x8d,
+	0xec, 0xb6, 0x22, 0x51, 0x28, 0x28, 0xa9, 0xbd, 0x88, 0xca, 0xd0, 0x23, 0x31, 0xb0, 0x42, 0xf3,
+	0x27, 0x77, 0xc4, 0xe5, 0xb6, 0x05, 0xcf, 0xbe, 0xdf, 0xa0, 0xc1, 0x0a, 0x8a, 0x9a, 0x72, 0xb9,
+	0xa8, 0x64, 0x0a, 0x9c, 0xed, 0x43, 0xc9, 0xa0, 0xb0, 0x1c, 0x74, 0x3f, 0x17, 0x49, 0x20, 0x77,
+	0x25, 0x0d, 0x2a, 0x9e, 0x0d, 0x4d, 0xc7, 0x74, 0xbb, 0x3e, 0xca, 0x45, 0xf2, 0x61, 0x57, 0xd2,
+	0x8f, 0x3c, 0xb3, 0x32, 0xd4, 0x13, 0x25, 0x2d, 0xd6, 0x41, 0xc6, 0x72, 0x26, 0x87, 0x37, 0x4e,
+	0xc7, 0xed, 0xcd, 0x47, 0x58, 0xef, 0xd5, 0x6c, 0x82, 0xf5, 0x26, 0x78, 0x05, 0xac, 0x58, 0xce,
+	0x0e, 0xbf, 0x26, 0xc6, 0xd7, 0xdf, 0x13, 0x37, 0x61, 0x32, 0xad, 0x22, 0x1c, 0x43, 0xae, 0x4d,
+	0xe8, 0xcf, 0x54, 0xac, 0x37, 0xa4, 0x11, 0x14, 0xaa, 0x41, 0xf8, 0x48, 0xcd, 0x7f, 0xdb, 0x8c,
+	0xb7, 0xde, 0xa1, 0xbe, 0x84, 0x0d, 0x2d, 0x82, 0x4b, 0xcd, 0x8e, 0xd2, 0x7c, 0x8a, 0xaf, 0xe3,
+	0xc3, 0x6f, 0xfc, 0xd5, 0x7c, 0xb6, 0xc8, 0xa1, 0x2a, 0xe4, 0xf2, 0xb6, 0x51, 0xf5, 0x1f, 0xa9,
+	0xde, 0xf7, 0xff, 0xc7, 0xbd, 0x40, 0xfd, 0x30, 0xcb, 0xe0, 0x33, 0x5d, 0x07, 0x9c, 0xc6, 0x94,
+	0xd5, 0x94, 0x8b, 0xe1, 0xad, 0xd3, 0x71, 0xbb, 0xfe, 0x63, 0x0d, 0xfc, 0x7f, 0xe7, 0xaf, 0xfa,
+	0x3f, 0xbe, 0x4d, 0x1f, 0x5c, 0xc5, 0xb3, 0x7c, 0x7d, 0x38, 0xd9, 0xe6, 0xf1, 0x64, 0x9b, 0x7f,
+	0x4e, 0xb6, 0xf9, 0xe5, 0x6c, 0x1b, 0xc7, 0xb3, 0x6d, 0xfc, 0x3c, 0xdb, 0xc6, 0xa7, 0xe7, 0x17,
+	0xf6, 0x64, 0x1a, 0x72, 0xc1, 0x04, 0x69, 0xd3, 0xdf, 0xea, 0xfc, 0x95, 0xc5, 0xe8, 0x9e, 0x7a,
+	0x80, 0x97, 0x7f, 0x07, 0x00, 0xcc, 0x4a, 0xd9, 0x3b, 0x18, 0x02, 0x00, 0x00,
+}
+
+func (m *ConvertAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvertAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvertAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedReceivers) > 0 {
+		for iNdEx := len(m.AllowedReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedReceivers[iNdEx])
+			copy(dAtA[i:], m.AllowedReceivers[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedReceivers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TokenSpendLimit) > 0 {
+		for iNdEx := len(m.TokenSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConvertAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.TokenSpendLimit) > 0 {
+		for _, e := range m.TokenSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedReceivers) > 0 {
+		for _, s := range m.AllowedReceivers {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConvertAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvertAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvertAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenSpendLimit = append(m.TokenSpendLimit, ERC20Amount{})
+			if err := m.TokenSpendLimit[len(m.TokenSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedReceivers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedReceivers = append(m.AllowedReceivers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
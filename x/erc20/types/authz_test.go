@@ -0,0 +1,236 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/tharsis/ethermint/tests"
+)
+
+type AuthzTestSuite struct {
+	suite.Suite
+}
+
+func TestAuthzSuite(t *testing.T) {
+	suite.Run(t, new(AuthzTestSuite))
+}
+
+func (suite *AuthzTestSuite) TestConvertAuthorizationValidateBasic() {
+	contract := tests.GenerateAddress().String()
+	receiver := tests.GenerateAddress().String()
+
+	testCases := []struct {
+		msg           string
+		authorization *ConvertAuthorization
+		expectPass    bool
+	}{
+		{
+			"fail - unsupported msg type",
+			&ConvertAuthorization{MsgTypeUrl: sdk.MsgTypeURL(&MsgTransferERC20{}), SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100))},
+			false,
+		},
+		{
+			"fail - coin conversion with empty spend limit",
+			NewConvertCoinAuthorization(sdk.NewCoins(), nil),
+			false,
+		},
+		{
+			"fail - coin conversion with token spend limit",
+			&ConvertAuthorization{
+				MsgTypeUrl:      sdk.MsgTypeURL(&MsgConvertCoin{}),
+				SpendLimit:      sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+				TokenSpendLimit: []ERC20Amount{{ContractAddress: contract, Amount: sdk.NewInt(100)}},
+			},
+			false,
+		},
+		{
+			"fail - coin conversion with zero spend limit",
+			NewConvertCoinAuthorization(sdk.Coins{sdk.NewInt64Coin("aevmos", 0)}, nil),
+			false,
+		},
+		{
+			"fail - erc20 conversion with empty token spend limit",
+			NewConvertERC20Authorization(nil, nil),
+			false,
+		},
+		{
+			"fail - erc20 conversion with spend limit",
+			&ConvertAuthorization{
+				MsgTypeUrl:      sdk.MsgTypeURL(&MsgConvertERC20{}),
+				SpendLimit:      sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+				TokenSpendLimit: []ERC20Amount{{ContractAddress: contract, Amount: sdk.NewInt(100)}},
+			},
+			false,
+		},
+		{
+			"fail - erc20 conversion with invalid contract",
+			NewConvertERC20Authorization([]ERC20Amount{{ContractAddress: "invalid", Amount: sdk.NewInt(100)}}, nil),
+			false,
+		},
+		{
+			"fail - erc20 conversion with duplicated contract",
+			NewConvertERC20Authorization([]ERC20Amount{{ContractAddress: contract, Amount: sdk.NewInt(100)}, {ContractAddress: contract, Amount: sdk.NewInt(5)}}, nil),
+			false,
+		},
+		{
+			"fail - erc20 conversion with zero token spend limit",
+			NewConvertERC20Authorization([]ERC20Amount{{ContractAddress: contract, Amount: sdk.ZeroInt()}}, nil),
+			false,
+		},
+		{
+			"fail - invalid allowed receiver",
+			NewConvertCoinAuthorization(sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)), []string{"invalid"}),
+			false,
+		},
+		{
+			"pass - coin conversion",
+			NewConvertCoinAuthorization(sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)), []string{receiver}),
+			true,
+		},
+		{
+			"pass - erc20 conversion",
+			NewConvertERC20Authorization([]ERC20Amount{{ContractAddress: contract, Amount: sdk.NewInt(100)}}, []string{sdk.AccAddress(tests.GenerateAddress().Bytes()).String()}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.authorization.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
+
+func (suite *AuthzTestSuite) TestConvertAuthorizationAccept() {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil).WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	receiverHex := tests.GenerateAddress()
+	receiverBech32 := sdk.AccAddress(receiverHex.Bytes())
+	contract := tests.GenerateAddress()
+	otherContract := tests.GenerateAddress()
+
+	coinAuthorization := NewConvertCoinAuthorization(
+		sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100), sdk.NewInt64Coin("atest", 50)),
+		[]string{receiverBech32.String()},
+	)
+	erc20Authorization := NewConvertERC20Authorization(
+		[]ERC20Amount{{ContractAddress: contract.String(), Amount: sdk.NewInt(100)}, {ContractAddress: otherContract.String(), Amount: sdk.NewInt(50)}},
+		nil,
+	)
+
+	testCases := []struct {
+		msg           string
+		authorization *ConvertAuthorization
+		convertMsg    sdk.Msg
+		expectPass    bool
+		expDelete     bool
+		expUpdated    *ConvertAuthorization
+	}{
+		{
+			"fail - msg type mismatch",
+			coinAuthorization,
+			NewMsgConvertERC20(sdk.NewInt(10), receiverBech32, contract, receiverHex),
+			false, false, nil,
+		},
+		{
+			"fail - receiver not allowed",
+			coinAuthorization,
+			NewMsgConvertCoin(sdk.NewInt64Coin("aevmos", 10), tests.GenerateAddress(), sender),
+			false, false, nil,
+		},
+		{
+			"fail - coin amount exceeds the spend limit",
+			coinAuthorization,
+			NewMsgConvertCoin(sdk.NewInt64Coin("aevmos", 101), receiverHex, sender),
+			false, false, nil,
+		},
+		{
+			"fail - denomination not in the spend limit",
+			coinAuthorization,
+			NewMsgConvertCoin(sdk.NewInt64Coin("aother", 10), receiverHex, sender),
+			false, false, nil,
+		},
+		{
+			"pass - spend limit decremented, hex receiver matches bech32 allowed receiver",
+			coinAuthorization,
+			NewMsgConvertCoin(sdk.NewInt64Coin("aevmos", 40), receiverHex, sender),
+			true, false,
+			NewConvertCoinAuthorization(
+				sdk.NewCoins(sdk.NewInt64Coin("aevmos", 60), sdk.NewInt64Coin("atest", 50)),
+				[]string{receiverBech32.String()},
+			),
+		},
+		{
+			"pass - denomination spend limit exhausted",
+			coinAuthorization,
+			NewMsgConvertCoin(sdk.NewInt64Coin("aevmos", 100), receiverHex, sender),
+			true, false,
+			NewConvertCoinAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atest", 50)), []string{receiverBech32.String()}),
+		},
+		{
+			"pass - all spend limits exhausted",
+			NewConvertCoinAuthorization(sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)), nil),
+			NewMsgConvertCoin(sdk.NewInt64Coin("aevmos", 100), receiverHex, sender),
+			true, true, nil,
+		},
+		{
+			"fail - contract not in the token spend limit",
+			erc20Authorization,
+			NewMsgConvertERC20(sdk.NewInt(10), receiverBech32, tests.GenerateAddress(), receiverHex),
+			false, false, nil,
+		},
+		{
+			"fail - token amount exceeds the spend limit",
+			erc20Authorization,
+			NewMsgConvertERC20(sdk.NewInt(101), receiverBech32, contract, receiverHex),
+			false, false, nil,
+		},
+		{
+			"pass - token spend limit decremented",
+			erc20Authorization,
+			NewMsgConvertERC20(sdk.NewInt(30), receiverBech32, contract, receiverHex),
+			true, false,
+			NewConvertERC20Authorization(
+				[]ERC20Amount{{ContractAddress: contract.String(), Amount: sdk.NewInt(70)}, {ContractAddress: otherContract.String(), Amount: sdk.NewInt(50)}},
+				nil,
+			),
+		},
+		{
+			"pass - contract spend limit exhausted",
+			erc20Authorization,
+			NewMsgConvertERC20(sdk.NewInt(50), receiverBech32, otherContract, receiverHex),
+			true, false,
+			NewConvertERC20Authorization([]ERC20Amount{{ContractAddress: contract.String(), Amount: sdk.NewInt(100)}}, nil),
+		},
+		{
+			"pass - all token spend limits exhausted",
+			NewConvertERC20Authorization([]ERC20Amount{{ContractAddress: contract.String(), Amount: sdk.NewInt(100)}}, nil),
+			NewMsgConvertERC20(sdk.NewInt(100), receiverBech32, contract, receiverHex),
+			true, true, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		res, err := tc.authorization.Accept(ctx, tc.convertMsg)
+		if !tc.expectPass {
+			suite.Require().Error(err, tc.msg)
+			continue
+		}
+
+		suite.Require().NoError(err, tc.msg)
+		suite.Require().True(res.Accept, tc.msg)
+		suite.Require().Equal(tc.expDelete, res.Delete, tc.msg)
+		if tc.expUpdated == nil {
+			suite.Require().Nil(res.Updated, tc.msg)
+		} else {
+			suite.Require().Equal(tc.expUpdated, res.Updated, tc.msg)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -21,6 +22,14 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgConvertCoin{},
 		&MsgConvertERC20{},
+		&MsgTransferERC20{},
+		&MsgConvertCoins{},
+		&MsgConvertERC20S{},
+		&MsgConvertERC20WithPermit{},
+		&MsgConvertERC20From{},
+		&MsgRegisterERC20{},
+		&MsgPausePair{},
+		&MsgPauseModule{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
@@ -28,6 +37,21 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&RegisterERC20Proposal{},
 		&ToggleTokenRelayProposal{},
 		&UpdateTokenPairERC20Proposal{},
+		&DeregisterTokenPairProposal{},
+		&UpdateRateLimitProposal{},
+		&UpgradeERC20ImplementationProposal{},
+		&UpdateCoinMetadataProposal{},
+		&VetoERC20RegistrationProposal{},
+		&ExtendPauseProposal{},
+		&UpdateConversionFeeProposal{},
+		&UpdateMintCapProposal{},
+		&UpdateSafetyPolicyProposal{},
+		&RecoverTokenPairEscrowProposal{},
+		&RegisterWrappedEVMDenomProposal{},
+	)
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&ConvertAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,194 @@
+;; ERC20Bytes32 is a quirky ERC20 token that mimics the MKR-style tokens which
+;; return their name and symbol as `bytes32` instead of `string`. Any account
+;; can mint tokens. It is only used for testing and is assembled with the
+;; go-ethereum `core/asm` compiler.
+;;
+;; storage:
+;;   0x00            total supply
+;;   keccak(account) balance of the account
+
+;; dispatch on the function selector
+PUSH 0
+CALLDATALOAD
+PUSH 0xe0
+SHR
+DUP1
+PUSH 0x06fdde03
+EQ
+JUMPI @name
+DUP1
+PUSH 0x95d89b41
+EQ
+JUMPI @symbol
+DUP1
+PUSH 0x313ce567
+EQ
+JUMPI @decimals
+DUP1
+PUSH 0x18160ddd
+EQ
+JUMPI @totalSupply
+DUP1
+PUSH 0x70a08231
+EQ
+JUMPI @balanceOf
+DUP1
+PUSH 0xa9059cbb
+EQ
+JUMPI @transfer
+DUP1
+PUSH 0x40c10f19
+EQ
+JUMPI @mint
+JUMP @revert
+
+;; name() returns (bytes32)
+name:
+;; "Maker"
+PUSH 0x4d616b6572000000000000000000000000000000000000000000000000000000
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+RETURN
+
+;; symbol() returns (bytes32)
+symbol:
+;; "MKR"
+PUSH 0x4d4b520000000000000000000000000000000000000000000000000000000000
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+RETURN
+
+;; decimals() returns (uint8)
+decimals:
+PUSH 18
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+RETURN
+
+;; totalSupply() returns (uint256)
+totalSupply:
+PUSH 0
+SLOAD
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+RETURN
+
+;; balanceOf(address account) returns (uint256)
+balanceOf:
+PUSH 0x04
+CALLDATALOAD
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+SHA3
+SLOAD
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+RETURN
+
+;; transfer(address to, uint256 amount) returns (bool)
+transfer:
+CALLER
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+SHA3
+DUP1
+SLOAD
+PUSH 0x24
+CALLDATALOAD
+DUP1
+DUP3
+LT
+JUMPI @revert
+SWAP1
+DUP2
+SWAP1
+SUB
+DUP3
+SSTORE
+SWAP1
+POP
+PUSH 0x04
+CALLDATALOAD
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+SHA3
+DUP1
+SLOAD
+DUP3
+ADD
+SWAP1
+SSTORE
+;; emit Transfer(msg.sender, to, amount)
+PUSH 0
+MSTORE
+PUSH 0x04
+CALLDATALOAD
+CALLER
+PUSH 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef
+PUSH 0x20
+PUSH 0
+LOG3
+PUSH 1
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+RETURN
+
+;; mint(address to, uint256 amount)
+mint:
+PUSH 0x04
+CALLDATALOAD
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+SHA3
+DUP1
+SLOAD
+PUSH 0x24
+CALLDATALOAD
+ADD
+SWAP1
+SSTORE
+PUSH 0
+SLOAD
+PUSH 0x24
+CALLDATALOAD
+ADD
+PUSH 0
+SSTORE
+;; emit Transfer(address(0), to, amount)
+PUSH 0x24
+CALLDATALOAD
+PUSH 0
+MSTORE
+PUSH 0x04
+CALLDATALOAD
+PUSH 0
+PUSH 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef
+PUSH 0x20
+PUSH 0
+LOG3
+STOP
+
+revert:
+PUSH 0
+DUP1
+REVERT
@@ -0,0 +1,188 @@
+;; ERC20Metadata is the runtime code of an implementation of the ERC20 proxies
+;; that lets the proxy admin (i.e the erc20 module account) update the token
+;; details to keep them in sync with the coin metadata:
+;;
+;;   setName(string name)
+;;   setSymbol(string symbol)
+;;
+;; Any other call is delegated to the base implementation, whose address is
+;; appended to the runtime code as a constructor argument. The strings are
+;; stored with the solc encoding of the ERC20MinterBurnerDecimals contract, so
+;; that they are returned by its name() and symbol() functions.
+;;
+;; storage:
+;;   0x05                                                                _name
+;;   0x06                                                                _symbol
+;;   0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103  admin
+
+;; dispatch on the function selector
+PUSH 0
+CALLDATALOAD
+PUSH 0xe0
+SHR
+DUP1
+PUSH 0xc47f0027
+EQ
+JUMPI @setName
+DUP1
+PUSH 0xb84c8246
+EQ
+JUMPI @setSymbol
+POP
+
+;; delegate the call to the base implementation and bubble up the result
+PUSH 0x20
+PUSH 0x20
+CODESIZE
+SUB
+PUSH 0
+CODECOPY
+PUSH 0
+MLOAD
+CALLDATASIZE
+PUSH 0
+PUSH 0
+CALLDATACOPY
+PUSH 0
+PUSH 0
+CALLDATASIZE
+PUSH 0
+DUP5
+GAS
+DELEGATECALL
+RETURNDATASIZE
+PUSH 0
+PUSH 0
+RETURNDATACOPY
+JUMPI @success
+RETURNDATASIZE
+PUSH 0
+REVERT
+success:
+RETURNDATASIZE
+PUSH 0
+RETURN
+
+;; setName(string name)
+setName:
+POP
+PUSH 0x05
+JUMP @setString
+
+;; setSymbol(string symbol)
+setSymbol:
+POP
+PUSH 0x06
+JUMP @setString
+
+;; setString stores the string argument of the call on the slot on top of the
+;; stack
+setString:
+CALLVALUE
+JUMPI @revert
+;; only the proxy admin
+CALLER
+PUSH 0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103
+SLOAD
+EQ
+ISZERO
+JUMPI @revert
+;; the offset and length of the string must be within the calldata
+PUSH 0x04
+CALLDATALOAD
+DUP1
+PUSH 0x20
+SHR
+JUMPI @revert
+PUSH 0x04
+ADD
+CALLDATALOAD
+DUP1
+PUSH 0x20
+SHR
+JUMPI @revert
+PUSH 0x04
+CALLDATALOAD
+PUSH 0x24
+ADD
+DUP2
+DUP2
+ADD
+CALLDATASIZE
+LT
+JUMPI @revert
+;; stack: slot, length, data
+PUSH 0x20
+DUP3
+LT
+JUMPI @short
+
+;; long strings store twice their length plus one on the slot and their data
+;; at keccak256(slot)
+DUP2
+PUSH 1
+SHL
+PUSH 1
+ADD
+DUP4
+SSTORE
+DUP3
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+SHA3
+PUSH 0
+copy:
+DUP4
+DUP2
+LT
+ISZERO
+JUMPI @copied
+DUP1
+DUP4
+ADD
+CALLDATALOAD
+DUP2
+PUSH 5
+SHR
+DUP4
+ADD
+SSTORE
+PUSH 0x20
+ADD
+JUMP @copy
+copied:
+POP
+POP
+STOP
+
+;; short strings are stored left aligned with twice their length in the
+;; lowest byte
+short:
+DUP1
+CALLDATALOAD
+PUSH 0x100
+DUP4
+PUSH 3
+SHL
+SWAP1
+SUB
+DUP1
+SWAP2
+SWAP1
+SHR
+SWAP1
+SHL
+DUP3
+PUSH 1
+SHL
+OR
+DUP4
+SSTORE
+STOP
+
+revert:
+PUSH 0
+PUSH 0
+REVERT
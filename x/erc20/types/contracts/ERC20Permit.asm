@@ -0,0 +1,371 @@
+;; ERC20Permit is the runtime code of an implementation of the ERC20 proxies
+;; that adds the EIP-2612 extension to the ERC20MinterBurnerDecimals contract:
+;;
+;;   permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s)
+;;   nonces(address owner) returns (uint256)
+;;   DOMAIN_SEPARATOR() returns (bytes32)
+;;
+;; Any other call is delegated to the base implementation, whose address is
+;; appended to the runtime code as a constructor argument. The extension works
+;; on the storage layout of the ERC20MinterBurnerDecimals contract and appends
+;; the permit nonces to it. The EIP-712 domain uses the current token name,
+;; version "1", the chain ID and the proxy address.
+;;
+;; storage:
+;;   0x03                          _allowances
+;;   0x05                          _name
+;;   0x08                          _nonces
+
+;; dispatch on the function selector
+PUSH 0
+CALLDATALOAD
+PUSH 0xe0
+SHR
+DUP1
+PUSH 0xd505accf
+EQ
+JUMPI @permit
+DUP1
+PUSH 0x7ecebe00
+EQ
+JUMPI @nonces
+DUP1
+PUSH 0x3644e515
+EQ
+JUMPI @domainSeparator
+POP
+
+;; delegate the call to the base implementation and bubble up the result
+PUSH 0x20
+PUSH 0x20
+CODESIZE
+SUB
+PUSH 0
+CODECOPY
+PUSH 0
+MLOAD
+CALLDATASIZE
+PUSH 0
+PUSH 0
+CALLDATACOPY
+PUSH 0
+PUSH 0
+CALLDATASIZE
+PUSH 0
+DUP5
+GAS
+DELEGATECALL
+RETURNDATASIZE
+PUSH 0
+PUSH 0
+RETURNDATACOPY
+JUMPI @success
+RETURNDATASIZE
+PUSH 0
+REVERT
+success:
+RETURNDATASIZE
+PUSH 0
+RETURN
+
+;; permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s)
+permit:
+POP
+CALLVALUE
+JUMPI @revert
+PUSH 0xe4
+CALLDATASIZE
+LT
+JUMPI @revert
+;; owner, spender and v must be correctly encoded
+PUSH 0x04
+CALLDATALOAD
+PUSH 0xa0
+SHR
+JUMPI @revert
+PUSH 0x24
+CALLDATALOAD
+PUSH 0xa0
+SHR
+JUMPI @revert
+PUSH 0x84
+CALLDATALOAD
+PUSH 0x08
+SHR
+JUMPI @revert
+;; approve to the zero address
+PUSH 0x24
+CALLDATALOAD
+ISZERO
+JUMPI @revert
+;; expired deadline
+PUSH 0x64
+CALLDATALOAD
+TIMESTAMP
+GT
+JUMPI @revert
+;; malleable signature, s must be on the lower half order
+PUSH 0xc4
+CALLDATALOAD
+PUSH 0x7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a0
+LT
+JUMPI @revert
+
+;; use the nonce of the owner
+PUSH 0x04
+CALLDATALOAD
+PUSH 0
+MSTORE
+PUSH 0x08
+PUSH 0x20
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+DUP1
+SLOAD
+DUP1
+PUSH 0x100
+MSTORE
+PUSH 1
+ADD
+SWAP1
+SSTORE
+
+;; keccak256(abi.encode(PERMIT_TYPEHASH, owner, spender, value, nonce, deadline))
+PUSH 0x6e71edae12b1b97f4d1f60370fef10105fa2faae0126114a169c64845d6126c9
+PUSH 0x80
+MSTORE
+PUSH 0x04
+CALLDATALOAD
+PUSH 0xa0
+MSTORE
+PUSH 0x24
+CALLDATALOAD
+PUSH 0xc0
+MSTORE
+PUSH 0x44
+CALLDATALOAD
+PUSH 0xe0
+MSTORE
+PUSH 0x64
+CALLDATALOAD
+PUSH 0x120
+MSTORE
+PUSH 0xc0
+PUSH 0x80
+SHA3
+
+;; keccak256("\x19\x01" ++ DOMAIN_SEPARATOR ++ structHash)
+PUSH @permitDomain
+JUMP @domain
+permitDomain:
+PUSH 0x1901
+PUSH 0xf0
+SHL
+PUSH 0x140
+MSTORE
+PUSH 0x142
+MSTORE
+PUSH 0x162
+MSTORE
+PUSH 0x42
+PUSH 0x140
+SHA3
+
+;; ecrecover(digest, v, r, s) must be the owner
+PUSH 0x80
+MSTORE
+PUSH 0x84
+CALLDATALOAD
+PUSH 0xa0
+MSTORE
+PUSH 0xa4
+CALLDATALOAD
+PUSH 0xc0
+MSTORE
+PUSH 0xc4
+CALLDATALOAD
+PUSH 0xe0
+MSTORE
+PUSH 0
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+PUSH 0x80
+PUSH 0x80
+PUSH 0x01
+GAS
+STATICCALL
+ISZERO
+JUMPI @revert
+PUSH 0
+MLOAD
+DUP1
+ISZERO
+JUMPI @revert
+PUSH 0x04
+CALLDATALOAD
+EQ
+ISZERO
+JUMPI @revert
+
+;; _allowances[owner][spender] = value
+PUSH 0x04
+CALLDATALOAD
+PUSH 0
+MSTORE
+PUSH 0x03
+PUSH 0x20
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+PUSH 0x20
+MSTORE
+PUSH 0x24
+CALLDATALOAD
+PUSH 0
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+PUSH 0x44
+CALLDATALOAD
+SWAP1
+SSTORE
+
+;; emit Approval(owner, spender, value)
+PUSH 0x44
+CALLDATALOAD
+PUSH 0
+MSTORE
+PUSH 0x24
+CALLDATALOAD
+PUSH 0x04
+CALLDATALOAD
+PUSH 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925
+PUSH 0x20
+PUSH 0
+LOG3
+STOP
+
+;; nonces(address owner) returns (uint256)
+nonces:
+POP
+CALLVALUE
+JUMPI @revert
+PUSH 0x04
+CALLDATALOAD
+PUSH 0
+MSTORE
+PUSH 0x08
+PUSH 0x20
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+SLOAD
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+RETURN
+
+;; DOMAIN_SEPARATOR() returns (bytes32)
+domainSeparator:
+POP
+CALLVALUE
+JUMPI @revert
+PUSH @returnDomain
+JUMP @domain
+returnDomain:
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+RETURN
+
+;; domain computes the EIP-712 domain separator and jumps back to the return
+;; address on top of the stack with the separator on top of the stack
+domain:
+;; keccak256(bytes(_name)), short strings are stored left aligned with twice
+;; their length in the lowest byte while long strings are stored at
+;; keccak256(slot) with twice their length plus one on the slot
+PUSH 0x05
+SLOAD
+DUP1
+PUSH 1
+AND
+JUMPI @longName
+DUP1
+PUSH 0x300
+MSTORE
+PUSH 0xff
+AND
+PUSH 1
+SHR
+PUSH 0x300
+SHA3
+JUMP @nameHashed
+longName:
+PUSH 1
+SHR
+PUSH 0x05
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+SHA3
+PUSH 0
+copyName:
+DUP3
+DUP2
+LT
+ISZERO
+JUMPI @nameCopied
+DUP1
+PUSH 5
+SHR
+DUP3
+ADD
+SLOAD
+DUP2
+PUSH 0x300
+ADD
+MSTORE
+PUSH 0x20
+ADD
+JUMP @copyName
+nameCopied:
+POP
+POP
+PUSH 0x300
+SHA3
+nameHashed:
+;; keccak256(abi.encode(TYPE_HASH, nameHash, versionHash, chainid, address(this)))
+PUSH 0x8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f
+PUSH 0x200
+MSTORE
+PUSH 0x220
+MSTORE
+PUSH 0xc89efdaa54c0f20c7adf612882df0950f5a951637e0307cdcb4c672f298b8bc6
+PUSH 0x240
+MSTORE
+CHAINID
+PUSH 0x260
+MSTORE
+ADDRESS
+PUSH 0x280
+MSTORE
+PUSH 0xa0
+PUSH 0x200
+SHA3
+SWAP1
+JUMP
+
+revert:
+PUSH 0
+PUSH 0
+REVERT
@@ -0,0 +1,117 @@
+;; ERC20Proxy is the runtime code of the transparent proxy deployed for the
+;; ERC20 representation of the registered Cosmos coins. It delegates all the
+;; calls to the implementation contract stored on the EIP-1967 implementation
+;; slot. The admin stored on the EIP-1967 admin slot (i.e the erc20 module
+;; account) can additionally call:
+;;
+;;   upgradeTo(address implementation)
+;;   implementation() returns (address)
+;;   admin() returns (address)
+;;
+;; Any other call of the admin is delegated to the implementation, so that the
+;; erc20 module can mint and burn the tokens through the proxy.
+;;
+;; storage:
+;;   0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc  implementation
+;;   0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103  admin
+
+;; dispatch the admin calls
+CALLER
+PUSH 0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103
+SLOAD
+EQ
+JUMPI @admin
+
+;; delegate the call to the implementation and bubble up the result
+delegate:
+CALLDATASIZE
+PUSH 0
+PUSH 0
+CALLDATACOPY
+PUSH 0
+PUSH 0
+CALLDATASIZE
+PUSH 0
+PUSH 0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc
+SLOAD
+GAS
+DELEGATECALL
+RETURNDATASIZE
+PUSH 0
+PUSH 0
+RETURNDATACOPY
+JUMPI @success
+RETURNDATASIZE
+PUSH 0
+REVERT
+success:
+RETURNDATASIZE
+PUSH 0
+RETURN
+
+admin:
+PUSH 0
+CALLDATALOAD
+PUSH 0xe0
+SHR
+DUP1
+PUSH 0x3659cfe6
+EQ
+JUMPI @upgradeTo
+DUP1
+PUSH 0x5c60da1b
+EQ
+JUMPI @implementation
+DUP1
+PUSH 0xf851a440
+EQ
+JUMPI @getAdmin
+POP
+JUMP @delegate
+
+;; upgradeTo(address implementation)
+upgradeTo:
+POP
+PUSH 0x04
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+;; the implementation must be a contract
+DUP1
+EXTCODESIZE
+ISZERO
+JUMPI @revert
+DUP1
+PUSH 0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc
+SSTORE
+;; emit Upgraded(implementation)
+PUSH 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b
+PUSH 0
+PUSH 0
+LOG2
+STOP
+
+;; implementation() returns (address)
+implementation:
+PUSH 0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc
+SLOAD
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+RETURN
+
+;; admin() returns (address)
+getAdmin:
+PUSH 0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103
+SLOAD
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+RETURN
+
+revert:
+PUSH 0
+PUSH 0
+REVERT
@@ -0,0 +1,33 @@
+;; ERC20ProxyConstructor is the constructor of the ERC20Proxy. The creation code
+;; of the proxy is laid out as:
+;;
+;;   constructor | runtime
+;;
+;; The constructor only sets the caller as admin and returns the runtime code of
+;; the proxy, so the creation code doesn't take any parameter and the address of
+;; a proxy deployed with CREATE2 only depends on the deployer and the salt. The
+;; admin then points the proxy to an initializer, i.e. a contract whose runtime
+;; code is the creation code of a contract with the same storage layout as the
+;; implementation, runs it on the storage of the proxy with an empty call and
+;; points the proxy to the implementation.
+
+;; set the caller as admin
+CALLER
+PUSH 0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103
+SSTORE
+
+;; return the runtime code, located after the constructor
+PUSH @constructorEnd
+PUSH 1
+ADD
+DUP1
+CODESIZE
+SUB
+DUP1
+SWAP2
+PUSH 0
+CODECOPY
+PUSH 0
+RETURN
+
+constructorEnd:
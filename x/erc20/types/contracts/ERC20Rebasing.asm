@@ -0,0 +1,236 @@
+;; ERC20Rebasing is a quirky ERC20 token which balances are tracked as shares of
+;; a rebasing index. The index increases on every `transfer`, so the balances of
+;; all the holders grow without any transfer to them. Any account can mint
+;; tokens. It is only used for testing and is assembled with the go-ethereum
+;; `core/asm` compiler.
+;;
+;; storage:
+;;   0x00            total shares
+;;   0x01            number of rebases, the index is rebases + 1
+;;   keccak(account) shares of the account
+
+;; dispatch on the function selector
+PUSH 0
+CALLDATALOAD
+PUSH 0xe0
+SHR
+DUP1
+PUSH 0x06fdde03
+EQ
+JUMPI @name
+DUP1
+PUSH 0x95d89b41
+EQ
+JUMPI @symbol
+DUP1
+PUSH 0x313ce567
+EQ
+JUMPI @decimals
+DUP1
+PUSH 0x18160ddd
+EQ
+JUMPI @totalSupply
+DUP1
+PUSH 0x70a08231
+EQ
+JUMPI @balanceOf
+DUP1
+PUSH 0xa9059cbb
+EQ
+JUMPI @transfer
+DUP1
+PUSH 0x40c10f19
+EQ
+JUMPI @mint
+JUMP @revert
+
+;; name() returns (string)
+name:
+PUSH 0x20
+PUSH 0
+MSTORE
+PUSH 13
+PUSH 0x20
+MSTORE
+;; "ERC20Rebasing"
+PUSH 0x45524332305265626173696e6700000000000000000000000000000000000000
+PUSH 0x40
+MSTORE
+PUSH 0x60
+PUSH 0
+RETURN
+
+;; symbol() returns (string)
+symbol:
+PUSH 0x20
+PUSH 0
+MSTORE
+PUSH 8
+PUSH 0x20
+MSTORE
+;; "REBASING"
+PUSH 0x5245424153494e47000000000000000000000000000000000000000000000000
+PUSH 0x40
+MSTORE
+PUSH 0x60
+PUSH 0
+RETURN
+
+;; decimals() returns (uint8)
+decimals:
+PUSH 18
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+RETURN
+
+;; totalSupply() returns (uint256)
+totalSupply:
+PUSH 1
+SLOAD
+PUSH 1
+ADD
+PUSH 0
+SLOAD
+MUL
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+RETURN
+
+;; balanceOf(address account) returns (uint256)
+balanceOf:
+PUSH 1
+SLOAD
+PUSH 1
+ADD
+PUSH 0x04
+CALLDATALOAD
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+SHA3
+SLOAD
+MUL
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+RETURN
+
+;; transfer(address to, uint256 amount) returns (bool)
+transfer:
+;; shares = amount / index
+PUSH 1
+SLOAD
+PUSH 1
+ADD
+PUSH 0x24
+CALLDATALOAD
+DIV
+CALLER
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+SHA3
+DUP1
+SLOAD
+DUP3
+DUP2
+LT
+JUMPI @revert
+DUP3
+SWAP1
+SUB
+SWAP1
+SSTORE
+PUSH 0x04
+CALLDATALOAD
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+SHA3
+DUP1
+SLOAD
+DUP3
+ADD
+SWAP1
+SSTORE
+POP
+;; emit Transfer(msg.sender, to, amount)
+PUSH 0x24
+CALLDATALOAD
+PUSH 0
+MSTORE
+PUSH 0x04
+CALLDATALOAD
+CALLER
+PUSH 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef
+PUSH 0x20
+PUSH 0
+LOG3
+;; rebase
+PUSH 1
+SLOAD
+PUSH 1
+ADD
+PUSH 1
+SSTORE
+PUSH 1
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+RETURN
+
+;; mint(address to, uint256 amount)
+mint:
+;; shares = amount / index
+PUSH 1
+SLOAD
+PUSH 1
+ADD
+PUSH 0x24
+CALLDATALOAD
+DIV
+PUSH 0x04
+CALLDATALOAD
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+SHA3
+DUP1
+SLOAD
+DUP3
+ADD
+SWAP1
+SSTORE
+PUSH 0
+SLOAD
+ADD
+PUSH 0
+SSTORE
+;; emit Transfer(address(0), to, amount)
+PUSH 0x24
+CALLDATALOAD
+PUSH 0
+MSTORE
+PUSH 0x04
+CALLDATALOAD
+PUSH 0
+PUSH 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef
+PUSH 0x20
+PUSH 0
+LOG3
+STOP
+
+revert:
+PUSH 0
+DUP1
+REVERT
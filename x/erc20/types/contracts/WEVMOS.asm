@@ -0,0 +1,635 @@
+;; WEVMOS is the runtime code of the canonical wrapper of the EVM denomination,
+;; registered by the erc20 module as a token pair owned by the module. It
+;; implements the WETH9 interface:
+;;
+;;   deposit() payable
+;;   withdraw(uint256 wad)
+;;
+;; Plain value transfers are deposits. The wrapped tokens are backed one to one
+;; by the native balance of the contract, which is also the escrow of the token
+;; pair: the admin (i.e the erc20 module account), whose address is appended to
+;; the runtime code as a constructor argument, mints and burns the tokens of the
+;; conversions and moves their backing to and from the contract balance:
+;;
+;;   mint(address to, uint256 amount)
+;;   burnCoins(address from, uint256 amount)
+;;   burn(uint256 amount)
+;;
+;; The other functions follow the ERC20 standard. As WETH9, an allowance of
+;; type(uint256).max is never spent and transferFrom doesn't emit an Approval
+;; event.
+;;
+;; storage:
+;;   0x00                                   total supply
+;;   keccak(account . 0x01)                 balance of the account
+;;   keccak(spender . keccak(owner . 0x02)) allowance of the spender
+
+;; plain value transfers are deposits
+CALLDATASIZE
+ISZERO
+JUMPI @deposit
+
+;; dispatch on the function selector
+PUSH 0
+CALLDATALOAD
+PUSH 0xe0
+SHR
+DUP1
+PUSH 0xd0e30db0
+EQ
+JUMPI @deposit
+
+;; the other functions are not payable
+CALLVALUE
+JUMPI @revert
+
+DUP1
+PUSH 0x2e1a7d4d
+EQ
+JUMPI @withdraw
+DUP1
+PUSH 0x06fdde03
+EQ
+JUMPI @name
+DUP1
+PUSH 0x95d89b41
+EQ
+JUMPI @symbol
+DUP1
+PUSH 0x313ce567
+EQ
+JUMPI @decimals
+DUP1
+PUSH 0x18160ddd
+EQ
+JUMPI @totalSupply
+DUP1
+PUSH 0x70a08231
+EQ
+JUMPI @balanceOf
+DUP1
+PUSH 0xdd62ed3e
+EQ
+JUMPI @allowance
+DUP1
+PUSH 0x095ea7b3
+EQ
+JUMPI @approve
+DUP1
+PUSH 0xa9059cbb
+EQ
+JUMPI @transfer
+DUP1
+PUSH 0x23b872dd
+EQ
+JUMPI @transferFrom
+DUP1
+PUSH 0x40c10f19
+EQ
+JUMPI @mint
+DUP1
+PUSH 0x1cf2c7e2
+EQ
+JUMPI @burnCoins
+DUP1
+PUSH 0x42966c68
+EQ
+JUMPI @burn
+JUMP @revert
+
+;; deposit() payable
+deposit:
+;; balance[msg.sender] += msg.value
+CALLER
+PUSH 0
+MSTORE
+PUSH 1
+PUSH 0x20
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+DUP1
+SLOAD
+CALLVALUE
+ADD
+SWAP1
+SSTORE
+;; totalSupply += msg.value
+PUSH 0
+SLOAD
+CALLVALUE
+ADD
+PUSH 0
+SSTORE
+;; emit Deposit(msg.sender, msg.value)
+CALLVALUE
+PUSH 0
+MSTORE
+CALLER
+PUSH 0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c
+PUSH 0x20
+PUSH 0
+LOG2
+STOP
+
+;; withdraw(uint256 wad)
+withdraw:
+PUSH 0x04
+CALLDATALOAD
+;; balance[msg.sender] -= wad
+CALLER
+PUSH 0
+MSTORE
+PUSH 1
+PUSH 0x20
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+DUP1
+SLOAD
+DUP3
+DUP2
+LT
+JUMPI @revert
+DUP3
+SWAP1
+SUB
+SWAP1
+SSTORE
+;; totalSupply -= wad
+PUSH 0
+SLOAD
+DUP2
+SWAP1
+SUB
+PUSH 0
+SSTORE
+;; emit Withdrawal(msg.sender, wad)
+DUP1
+PUSH 0
+MSTORE
+CALLER
+PUSH 0x7fcf532c15f0a6db0bd6d0e038bea71d30d808c7d98cb3bf7268a95bf5081b65
+PUSH 0x20
+PUSH 0
+LOG2
+;; send wad to msg.sender
+PUSH 0
+PUSH 0
+PUSH 0
+PUSH 0
+DUP5
+CALLER
+GAS
+CALL
+ISZERO
+JUMPI @revert
+STOP
+
+;; name() returns (string)
+name:
+PUSH 0x20
+PUSH 0
+MSTORE
+PUSH 13
+PUSH 0x20
+MSTORE
+;; "Wrapped Evmos"
+PUSH 0x577261707065642045766d6f7300000000000000000000000000000000000000
+PUSH 0x40
+MSTORE
+PUSH 0x60
+PUSH 0
+RETURN
+
+;; symbol() returns (string)
+symbol:
+PUSH 0x20
+PUSH 0
+MSTORE
+PUSH 6
+PUSH 0x20
+MSTORE
+;; "WEVMOS"
+PUSH 0x5745564d4f530000000000000000000000000000000000000000000000000000
+PUSH 0x40
+MSTORE
+PUSH 0x60
+PUSH 0
+RETURN
+
+;; decimals() returns (uint8)
+decimals:
+PUSH 18
+JUMP @returnWord
+
+;; totalSupply() returns (uint256)
+totalSupply:
+PUSH 0
+SLOAD
+JUMP @returnWord
+
+;; balanceOf(address account) returns (uint256)
+balanceOf:
+PUSH 0x04
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+PUSH 0
+MSTORE
+PUSH 1
+PUSH 0x20
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+SLOAD
+JUMP @returnWord
+
+;; allowance(address owner, address spender) returns (uint256)
+allowance:
+PUSH 0x04
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+PUSH 0
+MSTORE
+PUSH 2
+PUSH 0x20
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+PUSH 0x20
+MSTORE
+PUSH 0x24
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+PUSH 0
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+SLOAD
+JUMP @returnWord
+
+;; approve(address spender, uint256 amount) returns (bool)
+approve:
+;; allowance[msg.sender][spender] = amount
+PUSH 0x24
+CALLDATALOAD
+CALLER
+PUSH 0
+MSTORE
+PUSH 2
+PUSH 0x20
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+PUSH 0x20
+MSTORE
+PUSH 0x04
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+PUSH 0
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+SSTORE
+;; emit Approval(msg.sender, spender, amount)
+PUSH 0x24
+CALLDATALOAD
+PUSH 0
+MSTORE
+PUSH 0x04
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+CALLER
+PUSH 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925
+PUSH 0x20
+PUSH 0
+LOG3
+JUMP @returnTrue
+
+;; transfer(address to, uint256 amount) returns (bool)
+transfer:
+PUSH 0x24
+CALLDATALOAD
+;; balance[msg.sender] -= amount
+CALLER
+PUSH 0
+MSTORE
+PUSH 1
+PUSH 0x20
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+DUP1
+SLOAD
+DUP3
+DUP2
+LT
+JUMPI @revert
+DUP3
+SWAP1
+SUB
+SWAP1
+SSTORE
+;; balance[to] += amount
+PUSH 0x04
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+PUSH 0
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+DUP1
+SLOAD
+DUP3
+ADD
+SWAP1
+SSTORE
+;; emit Transfer(msg.sender, to, amount)
+PUSH 0
+MSTORE
+PUSH 0x04
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+CALLER
+PUSH 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef
+PUSH 0x20
+PUSH 0
+LOG3
+JUMP @returnTrue
+
+;; transferFrom(address from, address to, uint256 amount) returns (bool)
+transferFrom:
+PUSH 0x44
+CALLDATALOAD
+;; allowance[from][msg.sender] -= amount, unless it is unlimited
+PUSH 0x04
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+PUSH 0
+MSTORE
+PUSH 2
+PUSH 0x20
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+PUSH 0x20
+MSTORE
+CALLER
+PUSH 0
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+DUP1
+SLOAD
+DUP1
+NOT
+ISZERO
+JUMPI @spendBalance
+DUP3
+DUP2
+LT
+JUMPI @revert
+DUP3
+SWAP1
+SUB
+SWAP1
+SSTORE
+PUSH 0
+PUSH 0
+spendBalance:
+POP
+POP
+;; balance[from] -= amount
+PUSH 0x04
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+PUSH 0
+MSTORE
+PUSH 1
+PUSH 0x20
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+DUP1
+SLOAD
+DUP3
+DUP2
+LT
+JUMPI @revert
+DUP3
+SWAP1
+SUB
+SWAP1
+SSTORE
+;; balance[to] += amount
+PUSH 0x24
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+PUSH 0
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+DUP1
+SLOAD
+DUP3
+ADD
+SWAP1
+SSTORE
+;; emit Transfer(from, to, amount)
+PUSH 0
+MSTORE
+PUSH 0x24
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+PUSH 0x04
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+PUSH 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef
+PUSH 0x20
+PUSH 0
+LOG3
+JUMP @returnTrue
+
+;; mint(address to, uint256 amount), only callable by the admin
+mint:
+PUSH 0x20
+PUSH 0x20
+CODESIZE
+SUB
+PUSH 0
+CODECOPY
+PUSH 0
+MLOAD
+CALLER
+EQ
+ISZERO
+JUMPI @revert
+PUSH 0x24
+CALLDATALOAD
+;; balance[to] += amount
+PUSH 0x04
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+PUSH 0
+MSTORE
+PUSH 1
+PUSH 0x20
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+DUP1
+SLOAD
+DUP3
+ADD
+SWAP1
+SSTORE
+;; totalSupply += amount, reverting on overflow
+PUSH 0
+SLOAD
+DUP2
+ADD
+DUP1
+DUP3
+GT
+JUMPI @revert
+PUSH 0
+SSTORE
+;; emit Transfer(address(0), to, amount)
+PUSH 0
+MSTORE
+PUSH 0x04
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+PUSH 0
+PUSH 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef
+PUSH 0x20
+PUSH 0
+LOG3
+STOP
+
+;; burnCoins(address from, uint256 amount), only callable by the admin
+burnCoins:
+PUSH 0x20
+PUSH 0x20
+CODESIZE
+SUB
+PUSH 0
+CODECOPY
+PUSH 0
+MLOAD
+CALLER
+EQ
+ISZERO
+JUMPI @revert
+PUSH 0x24
+CALLDATALOAD
+PUSH 0x04
+CALLDATALOAD
+PUSH 0xffffffffffffffffffffffffffffffffffffffff
+AND
+JUMP @burnFrom
+
+;; burn(uint256 amount), only callable by the admin
+burn:
+PUSH 0x20
+PUSH 0x20
+CODESIZE
+SUB
+PUSH 0
+CODECOPY
+PUSH 0
+MLOAD
+CALLER
+EQ
+ISZERO
+JUMPI @revert
+PUSH 0x04
+CALLDATALOAD
+CALLER
+
+;; stack: [amount, from]
+burnFrom:
+;; balance[from] -= amount
+DUP1
+PUSH 0
+MSTORE
+PUSH 1
+PUSH 0x20
+MSTORE
+PUSH 0x40
+PUSH 0
+SHA3
+DUP1
+SLOAD
+DUP4
+DUP2
+LT
+JUMPI @revert
+DUP4
+SWAP1
+SUB
+SWAP1
+SSTORE
+;; totalSupply -= amount
+PUSH 0
+SLOAD
+DUP3
+SWAP1
+SUB
+PUSH 0
+SSTORE
+;; emit Transfer(from, address(0), amount)
+SWAP1
+PUSH 0
+MSTORE
+PUSH 0
+SWAP1
+PUSH 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef
+PUSH 0x20
+PUSH 0
+LOG3
+STOP
+
+returnTrue:
+PUSH 1
+
+;; stack: [word]
+returnWord:
+PUSH 0
+MSTORE
+PUSH 0x20
+PUSH 0
+RETURN
+
+revert:
+PUSH 0
+DUP1
+REVERT
@@ -0,0 +1,61 @@
+package contracts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/asm"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// compileAsmContract assembles the runtime code of a contract written in the
+// go-ethereum `core/asm` language and prepends a constructor that deploys it.
+func compileAsmContract(abiJSON string, source []byte) (evmtypes.CompiledContract, error) {
+	contractABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return evmtypes.CompiledContract{}, err
+	}
+
+	runtime, err := assemble(source)
+	if err != nil {
+		return evmtypes.CompiledContract{}, err
+	}
+
+	if len(runtime) > 0xffff {
+		return evmtypes.CompiledContract{}, fmt.Errorf("runtime code too large: %d bytes", len(runtime))
+	}
+
+	return evmtypes.CompiledContract{
+		ABI: contractABI,
+		Bin: append(deployerCode(len(runtime)), runtime...),
+	}, nil
+}
+
+// deployerCode returns a constructor that deploys the given number of bytes
+// following it as the runtime code.
+func deployerCode(size int) []byte {
+	// constructor: codecopy(0, len(constructor), size); return(0, size)
+	return []byte{
+		0x61, byte(size >> 8), byte(size), // PUSH2 size
+		0x80,       // DUP1
+		0x60, 0x0c, // PUSH1 len(constructor)
+		0x60, 0x00, // PUSH1 0
+		0x39,       // CODECOPY
+		0x60, 0x00, // PUSH1 0
+		0xf3, // RETURN
+	}
+}
+
+// assemble compiles code written in the go-ethereum `core/asm` language.
+func assemble(source []byte) ([]byte, error) {
+	compiler := asm.NewCompiler(false)
+	compiler.Feed(asm.Lex(source, false))
+	bin, errs := compiler.Compile()
+	if len(errs) != 0 {
+		return nil, fmt.Errorf("failed to assemble contract: %v", errs)
+	}
+
+	return common.FromHex(bin), nil
+}
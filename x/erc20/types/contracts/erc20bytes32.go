@@ -0,0 +1,40 @@
+package contracts
+
+import (
+	_ "embed" // embed smart contract source
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// This is a quirky token. As MKR-style tokens, it returns its name and symbol
+// as bytes32.
+var (
+	//go:embed ERC20Bytes32.asm
+	ERC20Bytes32Asm []byte // nolint: golint
+
+	// ERC20Bytes32Contract is the assembled erc20 contract
+	ERC20Bytes32Contract evmtypes.CompiledContract
+)
+
+const erc20Bytes32ABI = `[
+	{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"bytes32"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"bytes32"}]},
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"mint","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}]}
+]`
+
+func init() {
+	var err error
+	ERC20Bytes32Contract, err = compileAsmContract(erc20Bytes32ABI, ERC20Bytes32Asm)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(ERC20Bytes32Contract.Bin) == 0 {
+		panic("load contract failed")
+	}
+}
@@ -0,0 +1,43 @@
+package contracts
+
+import (
+	_ "embed" // embed smart contract source
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed ERC20Metadata.asm
+	ERC20MetadataAsm []byte // nolint: golint
+
+	// ERC20MetadataContract is the assembled extension of the ERC20 proxies
+	// implementations that lets the proxy admin update the token name and
+	// symbol. The address of the extended implementation must be appended to
+	// its creation code as a 32 bytes word.
+	ERC20MetadataContract evmtypes.CompiledContract
+)
+
+const erc20MetadataABI = `[
+	{"type":"function","name":"setName","stateMutability":"nonpayable","inputs":[{"name":"name","type":"string"}],"outputs":[]},
+	{"type":"function","name":"setSymbol","stateMutability":"nonpayable","inputs":[{"name":"symbol","type":"string"}],"outputs":[]}
+]`
+
+func init() {
+	contractABI, err := abi.JSON(strings.NewReader(erc20MetadataABI))
+	if err != nil {
+		panic(err)
+	}
+
+	runtime, err := assemble(ERC20MetadataAsm)
+	if err != nil {
+		panic(err)
+	}
+
+	// the deployed code includes the address of the extended implementation
+	ERC20MetadataContract = evmtypes.CompiledContract{
+		ABI: contractABI,
+		Bin: append(deployerCode(len(runtime)+32), runtime...),
+	}
+}
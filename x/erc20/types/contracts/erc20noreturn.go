@@ -0,0 +1,40 @@
+package contracts
+
+import (
+	_ "embed" // embed smart contract source
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// This is a quirky token. As USDT-style tokens, it doesn't return a value on
+// transfer.
+var (
+	//go:embed ERC20NoReturn.asm
+	ERC20NoReturnAsm []byte // nolint: golint
+
+	// ERC20NoReturnContract is the assembled erc20 contract
+	ERC20NoReturnContract evmtypes.CompiledContract
+)
+
+const erc20NoReturnABI = `[
+	{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"mint","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}]}
+]`
+
+func init() {
+	var err error
+	ERC20NoReturnContract, err = compileAsmContract(erc20NoReturnABI, ERC20NoReturnAsm)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(ERC20NoReturnContract.Bin) == 0 {
+		panic("load contract failed")
+	}
+}
@@ -0,0 +1,43 @@
+package contracts
+
+import (
+	_ "embed" // embed smart contract source
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed ERC20Permit.asm
+	ERC20PermitAsm []byte // nolint: golint
+
+	// ERC20PermitContract is the assembled EIP-2612 extension of the
+	// ERC20MinterBurnerDecimals contract. The address of the extended
+	// implementation must be appended to its creation code as a 32 bytes word.
+	ERC20PermitContract evmtypes.CompiledContract
+)
+
+const erc20PermitABI = `[
+	{"type":"function","name":"permit","stateMutability":"nonpayable","inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"},{"name":"value","type":"uint256"},{"name":"deadline","type":"uint256"},{"name":"v","type":"uint8"},{"name":"r","type":"bytes32"},{"name":"s","type":"bytes32"}],"outputs":[]},
+	{"type":"function","name":"nonces","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"DOMAIN_SEPARATOR","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"bytes32"}]}
+]`
+
+func init() {
+	contractABI, err := abi.JSON(strings.NewReader(erc20PermitABI))
+	if err != nil {
+		panic(err)
+	}
+
+	runtime, err := assemble(ERC20PermitAsm)
+	if err != nil {
+		panic(err)
+	}
+
+	// the deployed code includes the address of the extended implementation
+	ERC20PermitContract = evmtypes.CompiledContract{
+		ABI: contractABI,
+		Bin: append(deployerCode(len(runtime)+32), runtime...),
+	}
+}
@@ -0,0 +1,72 @@
+package contracts
+
+import (
+	_ "embed" // embed smart contract source
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed ERC20Proxy.asm
+	ERC20ProxyAsm []byte // nolint: golint
+
+	//go:embed ERC20ProxyConstructor.asm
+	ERC20ProxyConstructorAsm []byte // nolint: golint
+
+	// ERC20ProxyContract is the assembled proxy contract. Its creation code
+	// doesn't take any parameter, the proxy is initialized by its admin after
+	// the deployment.
+	ERC20ProxyContract evmtypes.CompiledContract
+
+	// ERC20ProxyRuntimeCodeHash is the hash of the runtime code of the proxy,
+	// used to identify the deployed proxies
+	ERC20ProxyRuntimeCodeHash common.Hash
+)
+
+const erc20ProxyABI = `[
+	{"type":"function","name":"upgradeTo","stateMutability":"nonpayable","inputs":[{"name":"implementation","type":"address"}],"outputs":[]},
+	{"type":"function","name":"implementation","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"admin","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"event","name":"Upgraded","anonymous":false,"inputs":[{"indexed":true,"name":"implementation","type":"address"}]}
+]`
+
+func init() {
+	contractABI, err := abi.JSON(strings.NewReader(erc20ProxyABI))
+	if err != nil {
+		panic(err)
+	}
+
+	constructor, err := assemble(ERC20ProxyConstructorAsm)
+	if err != nil {
+		panic(err)
+	}
+
+	runtime, err := assemble(ERC20ProxyAsm)
+	if err != nil {
+		panic(err)
+	}
+
+	ERC20ProxyContract = evmtypes.CompiledContract{
+		ABI: contractABI,
+		Bin: append(constructor, runtime...),
+	}
+	ERC20ProxyRuntimeCodeHash = crypto.Keccak256Hash(runtime)
+}
+
+// ERC20ProxyInitializerCreationCode returns the creation code of the
+// initializer of a proxy. The initializer is a contract whose runtime code is
+// the given creation code of a contract with the same storage layout as the
+// implementation, which the proxy runs on its storage when its admin calls it
+// with empty calldata.
+func ERC20ProxyInitializerCreationCode(initializer []byte) ([]byte, error) {
+	if len(initializer) > 0xffff {
+		return nil, fmt.Errorf("initializer code too large: %d bytes", len(initializer))
+	}
+
+	return append(deployerCode(len(initializer)), initializer...), nil
+}
@@ -0,0 +1,40 @@
+package contracts
+
+import (
+	_ "embed" // embed smart contract source
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// This is a quirky token. Its balances grow on every transfer, as the rebasing
+// index used to compute them from the holder shares increases.
+var (
+	//go:embed ERC20Rebasing.asm
+	ERC20RebasingAsm []byte // nolint: golint
+
+	// ERC20RebasingContract is the assembled erc20 contract
+	ERC20RebasingContract evmtypes.CompiledContract
+)
+
+const erc20RebasingABI = `[
+	{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"mint","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}]}
+]`
+
+func init() {
+	var err error
+	ERC20RebasingContract, err = compileAsmContract(erc20RebasingABI, ERC20RebasingAsm)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(ERC20RebasingContract.Bin) == 0 {
+		panic("load contract failed")
+	}
+}
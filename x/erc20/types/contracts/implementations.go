@@ -0,0 +1,140 @@
+package contracts
+
+import "fmt"
+
+// StorageVariable defines the location of a state variable of a contract,
+// following the format of the solc `storageLayout` output. The layouts below
+// are declared by hand and the tests check every variable against the storage
+// read by a deployed proxy.
+type StorageVariable struct {
+	Label  string
+	Slot   uint64
+	Offset uint8
+	Type   string
+}
+
+// ERC20Implementation defines a contract that can be used as the
+// implementation of the ERC20 proxies deployed for the registered Cosmos coins.
+type ERC20Implementation struct {
+	// unique name of the implementation
+	Name string
+	// creation code of the implementation contract
+	CreationCode []byte
+	// name of the implementation the calls not handled by this implementation
+	// are delegated to. Its address is appended to the creation code.
+	Base string
+	// storage layout of the implementation. An implementation must keep the
+	// storage layout of the previous ones and can only append new variables.
+	StorageLayout []StorageVariable
+}
+
+const (
+	// ERC20MinterBurnerDecimalsImplementation is the ERC20MinterBurnerDecimals
+	// contract
+	ERC20MinterBurnerDecimalsImplementation = "ERC20MinterBurnerDecimals"
+	// ERC20PermitImplementation is the ERC20MinterBurnerDecimals contract with
+	// the EIP-2612 permit extension
+	ERC20PermitImplementation = "ERC20MinterBurnerDecimalsPermit"
+	// ERC20MetadataImplementation is the ERC20MinterBurnerDecimals contract with
+	// the EIP-2612 permit extension and the admin functions to update the token
+	// name and symbol
+	ERC20MetadataImplementation = "ERC20MinterBurnerDecimalsPermitMetadata"
+)
+
+// DefaultERC20Implementation is the implementation of the ERC20 proxies until
+// governance upgrades them.
+const DefaultERC20Implementation = ERC20MetadataImplementation
+
+// erc20MinterBurnerDecimalsLayout is the storage layout of the
+// ERC20MinterBurnerDecimals contract.
+var erc20MinterBurnerDecimalsLayout = []StorageVariable{
+	{Label: "_roles", Slot: 0, Offset: 0, Type: "mapping(bytes32 => struct AccessControl.RoleData)"},
+	{Label: "_roleMembers", Slot: 1, Offset: 0, Type: "mapping(bytes32 => struct EnumerableSet.AddressSet)"},
+	{Label: "_balances", Slot: 2, Offset: 0, Type: "mapping(address => uint256)"},
+	{Label: "_allowances", Slot: 3, Offset: 0, Type: "mapping(address => mapping(address => uint256))"},
+	{Label: "_totalSupply", Slot: 4, Offset: 0, Type: "uint256"},
+	{Label: "_name", Slot: 5, Offset: 0, Type: "string"},
+	{Label: "_symbol", Slot: 6, Offset: 0, Type: "string"},
+	{Label: "_paused", Slot: 7, Offset: 0, Type: "bool"},
+	{Label: "_decimals", Slot: 7, Offset: 1, Type: "uint8"},
+}
+
+// erc20PermitLayout is the storage layout of the ERC20MinterBurnerDecimals
+// contract with the EIP-2612 permit extension.
+var erc20PermitLayout = append(
+	append([]StorageVariable{}, erc20MinterBurnerDecimalsLayout...),
+	StorageVariable{Label: "_nonces", Slot: 8, Offset: 0, Type: "mapping(address => struct Counters.Counter)"},
+)
+
+// ERC20Implementations are the implementations of the ERC20 proxies shipped
+// with the module, in release order.
+var ERC20Implementations []ERC20Implementation
+
+func init() {
+	// the implementation storage is never used, so the constructor arguments
+	// are irrelevant
+	ctorArgs, err := ERC20MinterBurnerDecimalsContract.ABI.Pack("", "", "", uint8(0))
+	if err != nil {
+		panic(err)
+	}
+
+	ERC20Implementations = []ERC20Implementation{
+		{
+			Name:          ERC20MinterBurnerDecimalsImplementation,
+			CreationCode:  append(append([]byte{}, ERC20MinterBurnerDecimalsContract.Bin...), ctorArgs...),
+			StorageLayout: erc20MinterBurnerDecimalsLayout,
+		},
+		{
+			Name:          ERC20PermitImplementation,
+			CreationCode:  ERC20PermitContract.Bin,
+			Base:          ERC20MinterBurnerDecimalsImplementation,
+			StorageLayout: erc20PermitLayout,
+		},
+		{
+			Name:          ERC20MetadataImplementation,
+			CreationCode:  ERC20MetadataContract.Bin,
+			Base:          ERC20PermitImplementation,
+			StorageLayout: erc20PermitLayout,
+		},
+	}
+
+	// the base of an implementation must be shipped before it
+	for i, implementation := range ERC20Implementations {
+		if implementation.Base == "" {
+			continue
+		}
+		found := false
+		for _, base := range ERC20Implementations[:i] {
+			found = found || base.Name == implementation.Base
+		}
+		if !found {
+			panic(fmt.Errorf("base implementation %s of %s not found", implementation.Base, implementation.Name))
+		}
+	}
+}
+
+// GetERC20Implementation returns the shipped implementation with the given name.
+func GetERC20Implementation(name string) (ERC20Implementation, bool) {
+	for _, implementation := range ERC20Implementations {
+		if implementation.Name == name {
+			return implementation, true
+		}
+	}
+	return ERC20Implementation{}, false
+}
+
+// IsStorageLayoutCompatible returns true if the layout keeps all the variables
+// of the previous layout in the same location. It only compares the declared
+// layouts and doesn't inspect the contract code.
+func IsStorageLayoutCompatible(previous, layout []StorageVariable) bool {
+	if len(layout) < len(previous) {
+		return false
+	}
+
+	for i, variable := range previous {
+		if layout[i] != variable {
+			return false
+		}
+	}
+	return true
+}
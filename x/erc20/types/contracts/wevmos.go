@@ -0,0 +1,67 @@
+package contracts
+
+import (
+	_ "embed" // embed smart contract source
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed WEVMOS.asm
+	WEVMOSAsm []byte // nolint: golint
+
+	// WEVMOSContract is the assembled wrapper of the EVM denomination. Its
+	// creation code must be built with WEVMOSCreationCode.
+	WEVMOSContract evmtypes.CompiledContract
+)
+
+const wevmosABI = `[
+	{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"allowance","stateMutability":"view","inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"approve","stateMutability":"nonpayable","inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"transferFrom","stateMutability":"nonpayable","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"deposit","stateMutability":"payable","inputs":[],"outputs":[]},
+	{"type":"function","name":"withdraw","stateMutability":"nonpayable","inputs":[{"name":"wad","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"mint","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"burnCoins","stateMutability":"nonpayable","inputs":[{"name":"from","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"burn","stateMutability":"nonpayable","inputs":[{"name":"amount","type":"uint256"}],"outputs":[]},
+	{"type":"receive","stateMutability":"payable"},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}]},
+	{"type":"event","name":"Approval","anonymous":false,"inputs":[{"indexed":true,"name":"owner","type":"address"},{"indexed":true,"name":"spender","type":"address"},{"indexed":false,"name":"value","type":"uint256"}]},
+	{"type":"event","name":"Deposit","anonymous":false,"inputs":[{"indexed":true,"name":"dst","type":"address"},{"indexed":false,"name":"wad","type":"uint256"}]},
+	{"type":"event","name":"Withdrawal","anonymous":false,"inputs":[{"indexed":true,"name":"src","type":"address"},{"indexed":false,"name":"wad","type":"uint256"}]}
+]`
+
+func init() {
+	contractABI, err := abi.JSON(strings.NewReader(wevmosABI))
+	if err != nil {
+		panic(err)
+	}
+
+	runtime, err := assemble(WEVMOSAsm)
+	if err != nil {
+		panic(err)
+	}
+
+	// the deployed code includes the address of the admin
+	WEVMOSContract = evmtypes.CompiledContract{
+		ABI: contractABI,
+		Bin: append(deployerCode(len(runtime)+32), runtime...),
+	}
+}
+
+// WEVMOSCreationCode returns the creation code of the wrapper of the EVM
+// denomination administered by the given account.
+func WEVMOSCreationCode(admin common.Address) []byte {
+	code := make([]byte, 0, len(WEVMOSContract.Bin)+32)
+	code = append(code, WEVMOSContract.Bin...)
+	return append(code, common.LeftPadBytes(admin.Bytes(), 32)...)
+}
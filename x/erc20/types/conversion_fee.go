@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// NewConversionFee returns an instance of ConversionFee
+func NewConversionFee(erc20Address common.Address, rate sdk.Dec, minFee sdk.Int) ConversionFee {
+	return ConversionFee{
+		Erc20Address: erc20Address.String(),
+		Rate:         rate,
+		MinFee:       minFee,
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (cf ConversionFee) GetERC20Contract() common.Address {
+	return common.HexToAddress(cf.Erc20Address)
+}
+
+// Validate performs a stateless validation of a ConversionFee
+func (cf ConversionFee) Validate() error {
+	if err := ethermint.ValidateAddress(cf.Erc20Address); err != nil {
+		return err
+	}
+
+	return validateConversionFee(cf.Rate, cf.MinFee)
+}
+
+// IsZero returns true if no fee is charged on the conversions
+func (cf ConversionFee) IsZero() bool {
+	return cf.Rate.IsZero() && cf.MinFee.IsZero()
+}
+
+// Compute returns the fee charged on the conversion of the given amount, which
+// is the greater of the rate applied to the amount, rounded up, and the
+// minimum fee
+func (cf ConversionFee) Compute(amount sdk.Int) sdk.Int {
+	fee := cf.Rate.MulInt(amount).Ceil().TruncateInt()
+	return sdk.MaxInt(fee, cf.MinFee)
+}
+
+// ValidateConversionFeeRate checks that the rate is within [0, 1)
+func ValidateConversionFeeRate(rate sdk.Dec) error {
+	if rate.IsNil() || rate.IsNegative() {
+		return fmt.Errorf("conversion fee rate cannot be nil or negative: %s", rate)
+	}
+
+	if rate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("conversion fee rate must be less than 1: %s", rate)
+	}
+
+	return nil
+}
+
+func validateConversionFee(rate sdk.Dec, minFee sdk.Int) error {
+	if err := ValidateConversionFeeRate(rate); err != nil {
+		return err
+	}
+
+	if minFee.IsNil() || minFee.IsNegative() {
+		return fmt.Errorf("minimum conversion fee cannot be nil or negative: %s", minFee)
+	}
+
+	return nil
+}
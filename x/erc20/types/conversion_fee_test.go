@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tharsis/ethermint/tests"
+)
+
+type ConversionFeeTestSuite struct {
+	suite.Suite
+}
+
+func TestConversionFeeSuite(t *testing.T) {
+	suite.Run(t, new(ConversionFeeTestSuite))
+}
+
+func (suite *ConversionFeeTestSuite) TestConversionFeeNew() {
+	addr := tests.GenerateAddress()
+	cf := NewConversionFee(addr, sdk.NewDecWithPrec(1, 3), sdk.NewInt(10))
+
+	suite.Require().Equal(addr, cf.GetERC20Contract())
+	suite.Require().NoError(cf.Validate())
+	suite.Require().False(cf.IsZero())
+}
+
+func (suite *ConversionFeeTestSuite) TestConversionFee() {
+	testCases := []struct {
+		msg           string
+		conversionFee ConversionFee
+		expectPass    bool
+	}{
+		{msg: "invalid address", conversionFee: ConversionFee{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", sdk.NewDecWithPrec(1, 3), sdk.ZeroInt()}, expectPass: false},
+		{msg: "nil rate", conversionFee: ConversionFee{tests.GenerateAddress().String(), sdk.Dec{}, sdk.ZeroInt()}, expectPass: false},
+		{msg: "negative rate", conversionFee: ConversionFee{tests.GenerateAddress().String(), sdk.NewDecWithPrec(-1, 3), sdk.ZeroInt()}, expectPass: false},
+		{msg: "rate of 100%", conversionFee: ConversionFee{tests.GenerateAddress().String(), sdk.OneDec(), sdk.ZeroInt()}, expectPass: false},
+		{msg: "nil min fee", conversionFee: ConversionFee{tests.GenerateAddress().String(), sdk.NewDecWithPrec(1, 3), sdk.Int{}}, expectPass: false},
+		{msg: "negative min fee", conversionFee: ConversionFee{tests.GenerateAddress().String(), sdk.NewDecWithPrec(1, 3), sdk.NewInt(-1)}, expectPass: false},
+		{msg: "pass - rate", conversionFee: ConversionFee{tests.GenerateAddress().String(), sdk.NewDecWithPrec(1, 3), sdk.ZeroInt()}, expectPass: true},
+		{msg: "pass - min fee", conversionFee: ConversionFee{tests.GenerateAddress().String(), sdk.ZeroDec(), sdk.NewInt(10)}, expectPass: true},
+		{msg: "pass - no fee", conversionFee: ConversionFee{tests.GenerateAddress().String(), sdk.ZeroDec(), sdk.ZeroInt()}, expectPass: true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.conversionFee.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ConversionFeeTestSuite) TestCompute() {
+	testCases := []struct {
+		msg    string
+		rate   sdk.Dec
+		minFee sdk.Int
+		amount sdk.Int
+		expFee sdk.Int
+	}{
+		{msg: "rate", rate: sdk.NewDecWithPrec(1, 2), minFee: sdk.ZeroInt(), amount: sdk.NewInt(1000), expFee: sdk.NewInt(10)},
+		{msg: "rate rounded up", rate: sdk.NewDecWithPrec(1, 2), minFee: sdk.ZeroInt(), amount: sdk.NewInt(1001), expFee: sdk.NewInt(11)},
+		{msg: "min fee", rate: sdk.NewDecWithPrec(1, 2), minFee: sdk.NewInt(50), amount: sdk.NewInt(1000), expFee: sdk.NewInt(50)},
+		{msg: "rate above min fee", rate: sdk.NewDecWithPrec(1, 1), minFee: sdk.NewInt(50), amount: sdk.NewInt(1000), expFee: sdk.NewInt(100)},
+		{msg: "no fee", rate: sdk.ZeroDec(), minFee: sdk.ZeroInt(), amount: sdk.NewInt(1000), expFee: sdk.ZeroInt()},
+	}
+
+	for _, tc := range testCases {
+		cf := NewConversionFee(tests.GenerateAddress(), tc.rate, tc.minFee)
+		suite.Require().True(tc.expFee.Equal(cf.Compute(tc.amount)), tc.msg)
+	}
+}
+
+func (suite *ConversionFeeTestSuite) TestIsZero() {
+	cf := NewConversionFee(tests.GenerateAddress(), sdk.ZeroDec(), sdk.ZeroInt())
+	suite.Require().True(cf.IsZero())
+
+	cf.MinFee = sdk.NewInt(1)
+	suite.Require().False(cf.IsZero())
+}
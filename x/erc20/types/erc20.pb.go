@@ -5,18 +5,25 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -56,6 +63,81 @@ func (Owner) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_668d5dc537f45142, []int{0}
 }
 
+// FeeDestination enumerates the destinations of the conversion fees.
+type FeeDestination int32
+
+const (
+	// FEE_DESTINATION_COMMUNITY_POOL defines the fees funding the community pool.
+	FEE_DESTINATION_COMMUNITY_POOL FeeDestination = 0
+	// FEE_DESTINATION_FEE_COLLECTOR defines the fees sent to the fee collector
+	// account, to be distributed along with the transaction fees.
+	FEE_DESTINATION_FEE_COLLECTOR FeeDestination = 1
+	// FEE_DESTINATION_BURN defines the fees burned. The fees of the native ERC20
+	// token pairs fund the community pool instead.
+	FEE_DESTINATION_BURN FeeDestination = 2
+)
+
+var FeeDestination_name = map[int32]string{
+	0: "FEE_DESTINATION_COMMUNITY_POOL",
+	1: "FEE_DESTINATION_FEE_COLLECTOR",
+	2: "FEE_DESTINATION_BURN",
+}
+
+var FeeDestination_value = map[string]int32{
+	"FEE_DESTINATION_COMMUNITY_POOL": 0,
+	"FEE_DESTINATION_FEE_COLLECTOR":  1,
+	"FEE_DESTINATION_BURN":           2,
+}
+
+func (x FeeDestination) String() string {
+	return proto.EnumName(FeeDestination_name, int32(x))
+}
+
+func (FeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{1}
+}
+
+// TokenBehaviour enumerates the transfer behaviour of an ERC20 contract.
+type TokenBehaviour int32
+
+const (
+	// TOKEN_BEHAVIOUR_STANDARD defines a token that returns true on transfer and
+	// moves balances by exactly the transferred amount.
+	TOKEN_BEHAVIOUR_STANDARD TokenBehaviour = 0
+	// TOKEN_BEHAVIOUR_SAFE_TRANSFER defines a token that may return no data on
+	// transfer (eg: USDT).
+	TOKEN_BEHAVIOUR_SAFE_TRANSFER TokenBehaviour = 1
+	// TOKEN_BEHAVIOUR_FEE_ON_TRANSFER defines a token that charges a fee on
+	// transfer, so that the recipient receives less than the transferred amount.
+	// The transfer may return no data.
+	TOKEN_BEHAVIOUR_FEE_ON_TRANSFER TokenBehaviour = 2
+	// TOKEN_BEHAVIOUR_REBASING defines a token whose balances change without
+	// transfers. Rebasing tokens are not supported.
+	TOKEN_BEHAVIOUR_REBASING TokenBehaviour = 3
+)
+
+var TokenBehaviour_name = map[int32]string{
+	0: "TOKEN_BEHAVIOUR_STANDARD",
+	1: "TOKEN_BEHAVIOUR_SAFE_TRANSFER",
+	2: "TOKEN_BEHAVIOUR_FEE_ON_TRANSFER",
+	3: "TOKEN_BEHAVIOUR_REBASING",
+}
+
+var TokenBehaviour_value = map[string]int32{
+	"TOKEN_BEHAVIOUR_STANDARD":        0,
+	"TOKEN_BEHAVIOUR_SAFE_TRANSFER":   1,
+	"TOKEN_BEHAVIOUR_FEE_ON_TRANSFER": 2,
+	"TOKEN_BEHAVIOUR_REBASING":        3,
+}
+
+func (x TokenBehaviour) String() string {
+	return proto.EnumName(TokenBehaviour_name, int32(x))
+}
+
+func (TokenBehaviour) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{2}
+}
+
 // TokenPair defines an instance that records pairing consisting of a Cosmos
 // native Coin and an ERC20 token address.
 type TokenPair struct {
@@ -67,6 +149,14 @@ type TokenPair struct {
 	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	// ERC20 owner address ENUM (0 invalid, 1 ModuleAccount, 2 external address)
 	ContractOwner Owner `protobuf:"varint,4,opt,name=contract_owner,json=contractOwner,proto3,enum=evmos.erc20.v1.Owner" json:"contract_owner,omitempty"`
+	// transfer behaviour of the ERC20 contract
+	Behaviour TokenBehaviour `protobuf:"varint,5,opt,name=behaviour,proto3,enum=evmos.erc20.v1.TokenBehaviour" json:"behaviour,omitempty"`
+	// decimal scaling exponent between the Cosmos coin and the ERC20 token: 1
+	// base unit of the coin maps to 10^scaling_exponent ERC20 token units
+	ScalingExponent uint32 `protobuf:"varint,6,opt,name=scaling_exponent,json=scalingExponent,proto3" json:"scaling_exponent,omitempty"`
+	// gas limit of the EVM calls to the ERC20 contract performed by the module. A
+	// zero value uses the EVM gas cap.
+	GasLimit uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
 func (m *TokenPair) Reset()         { *m = TokenPair{} }
@@ -130,6 +220,27 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+func (m *TokenPair) GetBehaviour() TokenBehaviour {
+	if m != nil {
+		return m.Behaviour
+	}
+	return TOKEN_BEHAVIOUR_STANDARD
+}
+
+func (m *TokenPair) GetScalingExponent() uint32 {
+	if m != nil {
+		return m.ScalingExponent
+	}
+	return 0
+}
+
+func (m *TokenPair) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 // RegisterCoinProposal is a gov Content type to register a token pair
 type RegisterCoinProposal struct {
 	// title of the proposal
@@ -138,6 +249,12 @@ type RegisterCoinProposal struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	// token pair of Cosmos native denom and ERC20 token address
 	Metadata types.Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
+	// decimal scaling exponent between the Cosmos coin and the ERC20 token. The
+	// ERC20 contract is deployed with the coin decimals plus the scaling exponent.
+	ScalingExponent uint32 `protobuf:"varint,4,opt,name=scaling_exponent,json=scalingExponent,proto3" json:"scaling_exponent,omitempty"`
+	// gas limit of the EVM calls to the ERC20 contract performed by the module. A
+	// zero value uses the EVM gas cap.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
 func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
@@ -194,6 +311,20 @@ func (m *RegisterCoinProposal) GetMetadata() types.Metadata {
 	return types.Metadata{}
 }
 
+func (m *RegisterCoinProposal) GetScalingExponent() uint32 {
+	if m != nil {
+		return m.ScalingExponent
+	}
+	return 0
+}
+
+func (m *RegisterCoinProposal) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 // RegisterCoinProposal is a gov Content type to register a token pair
 type RegisterERC20Proposal struct {
 	// title of the proposal
@@ -202,6 +333,19 @@ type RegisterERC20Proposal struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	// contract address of ERC20 token
 	Erc20Address string `protobuf:"bytes,3,opt,name=erc20address,proto3" json:"erc20address,omitempty"`
+	// transfer behaviour of the ERC20 contract
+	Behaviour TokenBehaviour `protobuf:"varint,4,opt,name=behaviour,proto3,enum=evmos.erc20.v1.TokenBehaviour" json:"behaviour,omitempty"`
+	// decimal scaling exponent between the Cosmos coin and the ERC20 token. The
+	// Cosmos coin is created with the ERC20 decimals minus the scaling exponent.
+	ScalingExponent uint32 `protobuf:"varint,5,opt,name=scaling_exponent,json=scalingExponent,proto3" json:"scaling_exponent,omitempty"`
+	// gas limit of the EVM calls to the ERC20 contract performed by the module. A
+	// zero value uses the EVM gas cap.
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// optional metadata of the Cosmos coin overriding the one derived from the
+	// ERC20 name and symbol. Its base must be the erc20/{address} denomination
+	// and its display unit must have the ERC20 decimals minus the scaling
+	// exponent.
+	Metadata *types.Metadata `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
@@ -258,6 +402,34 @@ func (m *RegisterERC20Proposal) GetErc20Address() string {
 	return ""
 }
 
+func (m *RegisterERC20Proposal) GetBehaviour() TokenBehaviour {
+	if m != nil {
+		return m.Behaviour
+	}
+	return TOKEN_BEHAVIOUR_STANDARD
+}
+
+func (m *RegisterERC20Proposal) GetScalingExponent() uint32 {
+	if m != nil {
+		return m.ScalingExponent
+	}
+	return 0
+}
+
+func (m *RegisterERC20Proposal) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *RegisterERC20Proposal) GetMetadata() *types.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // ToggleTokenRelayProposal is a gov Content type to toggle
 // the internal relaying of a token pair.
 type ToggleTokenRelayProposal struct {
@@ -398,515 +570,8931 @@ func (m *UpdateTokenPairERC20Proposal) GetNewErc20Address() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("evmos.erc20.v1.Owner", Owner_name, Owner_value)
-	proto.RegisterType((*TokenPair)(nil), "evmos.erc20.v1.TokenPair")
-	proto.RegisterType((*RegisterCoinProposal)(nil), "evmos.erc20.v1.RegisterCoinProposal")
-	proto.RegisterType((*RegisterERC20Proposal)(nil), "evmos.erc20.v1.RegisterERC20Proposal")
-	proto.RegisterType((*ToggleTokenRelayProposal)(nil), "evmos.erc20.v1.ToggleTokenRelayProposal")
-	proto.RegisterType((*UpdateTokenPairERC20Proposal)(nil), "evmos.erc20.v1.UpdateTokenPairERC20Proposal")
+// DeregisterTokenPairProposal is a gov Content type to remove a registered
+// token pair and settle its escrow.
+type DeregisterTokenPairProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// bech32 or hex addresses of the holders of the Cosmos coin of a native
+	// ERC20 token pair to refund, e.g. from an off-chain snapshot of the coin
+	// balances. Module accounts and blocked addresses are skipped.
+	Holders []string `protobuf:"bytes,4,rep,name=holders,proto3" json:"holders,omitempty"`
 }
 
-func init() { proto.RegisterFile("evmos/erc20/v1/erc20.proto", fileDescriptor_668d5dc537f45142) }
-
-var fileDescriptor_668d5dc537f45142 = []byte{
-	// 523 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xde, 0x69, 0x52, 0x6d, 0xa7, 0x6d, 0x4c, 0x87, 0x04, 0x96, 0xa0, 0xdb, 0x10, 0x41, 0x42,
-	0x0f, 0xbb, 0x4d, 0xbc, 0x89, 0xa0, 0x6d, 0xba, 0x42, 0xa5, 0x4d, 0xc2, 0x9a, 0xa0, 0x78, 0x09,
-	0x93, 0xdd, 0xc7, 0x76, 0x69, 0x32, 0xb3, 0xec, 0x8c, 0x89, 0xf9, 0x0f, 0x3c, 0x7a, 0xf1, 0x2e,
-	0xe8, 0xd5, 0xff, 0xa3, 0xc7, 0x1e, 0x3d, 0x89, 0x24, 0x17, 0xff, 0x0c, 0xd9, 0x99, 0x4d, 0x49,
-	0xd1, 0x5b, 0x7b, 0x9b, 0xef, 0x7b, 0xdf, 0xcc, 0xfb, 0xe6, 0xfd, 0xc0, 0x15, 0x98, 0x8c, 0xb9,
-	0x70, 0x20, 0xf1, 0x9b, 0x07, 0xce, 0xa4, 0xa1, 0x0f, 0x76, 0x9c, 0x70, 0xc9, 0x49, 0x41, 0xc5,
-	0x6c, 0x4d, 0x4d, 0x1a, 0x95, 0x52, 0xc8, 0x43, 0xae, 0x42, 0x4e, 0x7a, 0xd2, 0xaa, 0x8a, 0xe5,
-	0x73, 0x91, 0x3e, 0x31, 0xa4, 0xec, 0xc2, 0x99, 0x34, 0x86, 0x20, 0x69, 0x43, 0x01, 0x1d, 0xaf,
-	0x7d, 0x47, 0x78, 0xb3, 0xc7, 0x2f, 0x80, 0x75, 0x69, 0x94, 0x90, 0xc7, 0x78, 0x47, 0xbd, 0x37,
-	0xa0, 0x41, 0x90, 0x80, 0x10, 0x26, 0xaa, 0xa2, 0xfa, 0xa6, 0xb7, 0xad, 0xc8, 0x43, 0xcd, 0x91,
-	0x12, 0x5e, 0x0f, 0x80, 0xf1, 0xb1, 0xb9, 0xa6, 0x82, 0x1a, 0x10, 0x13, 0xdf, 0x07, 0x46, 0x87,
-	0x23, 0x08, 0xcc, 0x5c, 0x15, 0xd5, 0x37, 0xbc, 0x25, 0x24, 0xcf, 0x71, 0xc1, 0xe7, 0x4c, 0x26,
-	0xd4, 0x97, 0x03, 0x3e, 0x65, 0x90, 0x98, 0xf9, 0x2a, 0xaa, 0x17, 0x9a, 0x65, 0xfb, 0xe6, 0x0f,
-	0xec, 0x4e, 0x1a, 0xf4, 0x76, 0x96, 0x62, 0x05, 0x9f, 0xe5, 0xff, 0x7c, 0xdd, 0x43, 0xb5, 0x2f,
-	0x08, 0x97, 0x3c, 0x08, 0x23, 0x21, 0x21, 0x69, 0xf1, 0x88, 0x75, 0x13, 0x1e, 0x73, 0x41, 0x47,
-	0xa9, 0x19, 0x19, 0xc9, 0x11, 0x64, 0x4e, 0x35, 0x20, 0x55, 0xbc, 0x15, 0x80, 0xf0, 0x93, 0x28,
-	0x96, 0x11, 0x67, 0x99, 0xd1, 0x55, 0x8a, 0xbc, 0xc0, 0x1b, 0x63, 0x90, 0x34, 0xa0, 0x92, 0x2a,
-	0xbf, 0x5b, 0xcd, 0x47, 0xb6, 0x2e, 0x95, 0xad, 0xaa, 0x93, 0x95, 0xca, 0x3e, 0xcb, 0x44, 0x47,
-	0xf9, 0xcb, 0x5f, 0x7b, 0x86, 0x77, 0x7d, 0x49, 0xf9, 0x32, 0x6a, 0x33, 0x5c, 0x5e, 0xda, 0x72,
-	0xbd, 0x56, 0xf3, 0xe0, 0xd6, 0xbe, 0x6a, 0x58, 0x17, 0x7b, 0xd9, 0x80, 0xdc, 0x4a, 0x03, 0x32,
-	0x2e, 0x4b, 0xcd, 0xb0, 0xd9, 0xe3, 0x61, 0x38, 0x02, 0xd5, 0x3e, 0x0f, 0x46, 0x74, 0x76, 0xeb,
-	0xec, 0xe9, 0xbd, 0xf4, 0xb5, 0x2c, 0xad, 0x06, 0x59, 0x0b, 0x7e, 0x20, 0xfc, 0xb0, 0x1f, 0x07,
-	0x54, 0xc2, 0xf5, 0xbc, 0xdc, 0xcd, 0x97, 0xff, 0x19, 0xba, 0xdc, 0x7f, 0x86, 0x6e, 0x1f, 0xef,
-	0x32, 0x98, 0x0e, 0x6e, 0x0a, 0xf3, 0x4a, 0xf8, 0x80, 0xc1, 0xd4, 0x5d, 0xd1, 0x6a, 0xbf, 0xfb,
-	0xaf, 0xf1, 0xba, 0x9a, 0x20, 0x52, 0xc6, 0xbb, 0x9d, 0xb7, 0x6d, 0xd7, 0x1b, 0xf4, 0xdb, 0x6f,
-	0xba, 0x6e, 0xeb, 0xe4, 0xd5, 0x89, 0x7b, 0x5c, 0x34, 0x48, 0x11, 0x6f, 0x6b, 0xfa, 0xac, 0x73,
-	0xdc, 0x3f, 0x75, 0x8b, 0x88, 0x10, 0x5c, 0xd0, 0x8c, 0xfb, 0xae, 0xe7, 0x7a, 0xed, 0xc3, 0xd3,
-	0xe2, 0x5a, 0x25, 0xff, 0xe9, 0x9b, 0x65, 0x1c, 0xbd, 0xbc, 0x9c, 0x5b, 0xe8, 0x6a, 0x6e, 0xa1,
-	0xdf, 0x73, 0x0b, 0x7d, 0x5e, 0x58, 0xc6, 0xd5, 0xc2, 0x32, 0x7e, 0x2e, 0x2c, 0xe3, 0xfd, 0x93,
-	0x30, 0x92, 0xe7, 0x1f, 0x86, 0xb6, 0xcf, 0xc7, 0x8e, 0x3c, 0xa7, 0x89, 0x88, 0x84, 0xa3, 0x97,
-	0xf6, 0x63, 0xb6, 0xb6, 0x72, 0x16, 0x83, 0x18, 0xde, 0x53, 0xeb, 0xf6, 0xf4, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x19, 0x15, 0x37, 0xca, 0xd2, 0x03, 0x00, 0x00,
+func (m *DeregisterTokenPairProposal) Reset()         { *m = DeregisterTokenPairProposal{} }
+func (m *DeregisterTokenPairProposal) String() string { return proto.CompactTextString(m) }
+func (*DeregisterTokenPairProposal) ProtoMessage()    {}
+func (*DeregisterTokenPairProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{5}
 }
-
-func (this *TokenPair) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*TokenPair)
-	if !ok {
-		that2, ok := that.(TokenPair)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *DeregisterTokenPairProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregisterTokenPairProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregisterTokenPairProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Erc20Address != that1.Erc20Address {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
+}
+func (m *DeregisterTokenPairProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterTokenPairProposal.Merge(m, src)
+}
+func (m *DeregisterTokenPairProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregisterTokenPairProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterTokenPairProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregisterTokenPairProposal proto.InternalMessageInfo
+
+func (m *DeregisterTokenPairProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if this.Enabled != that1.Enabled {
-		return false
+	return ""
+}
+
+func (m *DeregisterTokenPairProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if this.ContractOwner != that1.ContractOwner {
-		return false
+	return ""
+}
+
+func (m *DeregisterTokenPairProposal) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return true
+	return ""
 }
-func (this *ToggleTokenRelayProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DeregisterTokenPairProposal) GetHolders() []string {
+	if m != nil {
+		return m.Holders
 	}
+	return nil
+}
 
-	that1, ok := that.(*ToggleTokenRelayProposal)
-	if !ok {
-		that2, ok := that.(ToggleTokenRelayProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// RateLimit defines the conversion limits of a token pair. Inflow refers to
+// the conversion of Cosmos coins into ERC20 tokens and outflow to the
+// conversion of ERC20 tokens into Cosmos coins. A zero value disables the
+// corresponding limit.
+type RateLimit struct {
+	// address of ERC20 contract token of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// maximum amount allowed on a single conversion
+	MaxPerConversion github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_per_conversion,json=maxPerConversion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_per_conversion"`
+	// maximum amount of Cosmos coins converted into ERC20 tokens within the
+	// window
+	InflowCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=inflow_cap,json=inflowCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow_cap"`
+	// maximum amount of ERC20 tokens converted into Cosmos coins within the
+	// window
+	OutflowCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=outflow_cap,json=outflowCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow_cap"`
+	// number of blocks, or epochs if the epoch identifier is set, of the rolling
+	// window over which the inflow and outflow caps are enforced
+	Window uint64 `protobuf:"varint,5,opt,name=window,proto3" json:"window,omitempty"`
+	// identifier of the epochs the window is measured in. The window is measured
+	// in blocks if empty.
+	EpochIdentifier string `protobuf:"bytes,6,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{6}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
 	}
-	if this.Token != that1.Token {
-		return false
+	return ""
+}
+
+func (m *RateLimit) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
 	}
-	return true
+	return 0
 }
-func (this *UpdateTokenPairERC20Proposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RateLimit) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
 	}
+	return ""
+}
 
-	that1, ok := that.(*UpdateTokenPairERC20Proposal)
-	if !ok {
-		that2, ok := that.(UpdateTokenPairERC20Proposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ConversionVolume defines the amounts converted for a token pair within a
+// single block.
+type ConversionVolume struct {
+	// amount of Cosmos coins converted into ERC20 tokens
+	Inflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=inflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow"`
+	// amount of ERC20 tokens converted into Cosmos coins
+	Outflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=outflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow"`
+}
+
+func (m *ConversionVolume) Reset()         { *m = ConversionVolume{} }
+func (m *ConversionVolume) String() string { return proto.CompactTextString(m) }
+func (*ConversionVolume) ProtoMessage()    {}
+func (*ConversionVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{7}
+}
+func (m *ConversionVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	if this.Erc20Address != that1.Erc20Address {
-		return false
-	}
-	if this.NewErc20Address != that1.NewErc20Address {
-		return false
-	}
-	return true
 }
-func (m *TokenPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ConversionVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionVolume.Merge(m, src)
+}
+func (m *ConversionVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionVolume.DiscardUnknown(m)
 }
 
-func (m *TokenPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ConversionVolume proto.InternalMessageInfo
+
+// PeriodConversionVolume defines the amounts converted for a rate limited token
+// pair on a block height, or on an epoch number if the window of its rate limit
+// is measured in epochs.
+type PeriodConversionVolume struct {
+	// address of ERC20 contract token of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// block height or epoch number
+	Period uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	// amounts converted on the period
+	Volume ConversionVolume `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume"`
 }
 
-func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractOwner != 0 {
-		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *PeriodConversionVolume) Reset()         { *m = PeriodConversionVolume{} }
+func (m *PeriodConversionVolume) String() string { return proto.CompactTextString(m) }
+func (*PeriodConversionVolume) ProtoMessage()    {}
+func (*PeriodConversionVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{8}
+}
+func (m *PeriodConversionVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodConversionVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodConversionVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *PeriodConversionVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodConversionVolume.Merge(m, src)
+}
+func (m *PeriodConversionVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodConversionVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodConversionVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodConversionVolume proto.InternalMessageInfo
+
+func (m *PeriodConversionVolume) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
 	}
-	if len(m.Erc20Address) > 0 {
-		i -= len(m.Erc20Address)
-		copy(dAtA[i:], m.Erc20Address)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *PeriodConversionVolume) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PeriodConversionVolume) GetVolume() ConversionVolume {
+	if m != nil {
+		return m.Volume
 	}
-	return dAtA[:n], nil
+	return ConversionVolume{}
 }
 
-func (m *RegisterCoinProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UpdateRateLimitProposal is a gov Content type to set the conversion limits
+// of a token pair. Setting all the limits to zero removes the rate limit of the
+// pair.
+type UpdateRateLimitProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// maximum amount allowed on a single conversion
+	MaxPerConversion github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_per_conversion,json=maxPerConversion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_per_conversion"`
+	// maximum amount of Cosmos coins converted into ERC20 tokens within the
+	// window
+	InflowCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=inflow_cap,json=inflowCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow_cap"`
+	// maximum amount of ERC20 tokens converted into Cosmos coins within the
+	// window
+	OutflowCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=outflow_cap,json=outflowCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow_cap"`
+	// number of blocks, or epochs if the epoch identifier is set, of the rolling
+	// window over which the inflow and outflow caps are enforced
+	Window uint64 `protobuf:"varint,7,opt,name=window,proto3" json:"window,omitempty"`
+	// identifier of the epochs the window is measured in. The window is measured
+	// in blocks if empty.
+	EpochIdentifier string `protobuf:"bytes,8,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
 }
 
-func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *UpdateRateLimitProposal) Reset()         { *m = UpdateRateLimitProposal{} }
+func (m *UpdateRateLimitProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateRateLimitProposal) ProtoMessage()    {}
+func (*UpdateRateLimitProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{9}
+}
+func (m *UpdateRateLimitProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateRateLimitProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateRateLimitProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintErc20(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpdateRateLimitProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRateLimitProposal.Merge(m, src)
+}
+func (m *UpdateRateLimitProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateRateLimitProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRateLimitProposal.DiscardUnknown(m)
 }
 
-func (m *RegisterERC20Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateRateLimitProposal proto.InternalMessageInfo
+
+func (m *UpdateRateLimitProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RegisterERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UpdateRateLimitProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *RegisterERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Erc20Address) > 0 {
-		i -= len(m.Erc20Address)
-		copy(dAtA[i:], m.Erc20Address)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+func (m *UpdateRateLimitProposal) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *UpdateRateLimitProposal) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ToggleTokenRelayProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpdateRateLimitProposal) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ToggleTokenRelayProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UpgradeERC20ImplementationProposal is a gov Content type to point the ERC20
+// proxies of the registered Cosmos coins to a new implementation shipped with
+// the module.
+type UpgradeERC20ImplementationProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// name of the implementation
+	Implementation string `protobuf:"bytes,3,opt,name=implementation,proto3" json:"implementation,omitempty"`
+	// hex contract addresses of the ERC20 proxies to upgrade. If empty, all the
+	// proxies are upgraded and the implementation is used for the Cosmos coins
+	// registered afterwards.
+	Erc20Addresses []string `protobuf:"bytes,4,rep,name=erc20_addresses,json=erc20Addresses,proto3" json:"erc20_addresses,omitempty"`
 }
 
-func (m *ToggleTokenRelayProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+func (m *UpgradeERC20ImplementationProposal) Reset()         { *m = UpgradeERC20ImplementationProposal{} }
+func (m *UpgradeERC20ImplementationProposal) String() string { return proto.CompactTextString(m) }
+func (*UpgradeERC20ImplementationProposal) ProtoMessage()    {}
+func (*UpgradeERC20ImplementationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{10}
+}
+func (m *UpgradeERC20ImplementationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeERC20ImplementationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeERC20ImplementationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpgradeERC20ImplementationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeERC20ImplementationProposal.Merge(m, src)
+}
+func (m *UpgradeERC20ImplementationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeERC20ImplementationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeERC20ImplementationProposal.DiscardUnknown(m)
 }
 
-func (m *UpdateTokenPairERC20Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpgradeERC20ImplementationProposal proto.InternalMessageInfo
+
+func (m *UpgradeERC20ImplementationProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UpdateTokenPairERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UpgradeERC20ImplementationProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *UpdateTokenPairERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewErc20Address) > 0 {
-		i -= len(m.NewErc20Address)
-		copy(dAtA[i:], m.NewErc20Address)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.NewErc20Address)))
-		i--
-		dAtA[i] = 0x22
+func (m *UpgradeERC20ImplementationProposal) GetImplementation() string {
+	if m != nil {
+		return m.Implementation
 	}
-	if len(m.Erc20Address) > 0 {
-		i -= len(m.Erc20Address)
-		copy(dAtA[i:], m.Erc20Address)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *UpgradeERC20ImplementationProposal) GetErc20Addresses() []string {
+	if m != nil {
+		return m.Erc20Addresses
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
-	offset -= sovErc20(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+// UpdateCoinMetadataProposal is a gov Content type to update the bank metadata
+// of the Cosmos coin of a registered token pair. The name and symbol of the
+// ERC20 contracts owned by the module are updated accordingly.
+type UpdateCoinMetadataProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// new metadata of the Cosmos coin, identified by its base denomination
+	Metadata types.Metadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata"`
 }
-func (m *TokenPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Erc20Address)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
-	}
-	if m.Enabled {
-		n += 2
+
+func (m *UpdateCoinMetadataProposal) Reset()         { *m = UpdateCoinMetadataProposal{} }
+func (m *UpdateCoinMetadataProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateCoinMetadataProposal) ProtoMessage()    {}
+func (*UpdateCoinMetadataProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{11}
+}
+func (m *UpdateCoinMetadataProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateCoinMetadataProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateCoinMetadataProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ContractOwner != 0 {
-		n += 1 + sovErc20(uint64(m.ContractOwner))
+}
+func (m *UpdateCoinMetadataProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCoinMetadataProposal.Merge(m, src)
+}
+func (m *UpdateCoinMetadataProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateCoinMetadataProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCoinMetadataProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCoinMetadataProposal proto.InternalMessageInfo
+
+func (m *UpdateCoinMetadataProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return n
+	return ""
 }
 
-func (m *RegisterCoinProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateCoinMetadataProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *UpdateCoinMetadataProposal) GetMetadata() types.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return types.Metadata{}
+}
+
+// PendingRegistration defines a token pair registered through a
+// MsgRegisterERC20 that is disabled until the end of the challenge period.
+type PendingRegistration struct {
+	// hex address of the ERC20 contract of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// bech32 address of the account that registered the token pair
+	Registrant string `protobuf:"bytes,2,opt,name=registrant,proto3" json:"registrant,omitempty"`
+	// registration deposit, refunded on activation and burned on veto
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// time at which the token pair is activated
+	ActivationTime time.Time `protobuf:"bytes,4,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time"`
+}
+
+func (m *PendingRegistration) Reset()         { *m = PendingRegistration{} }
+func (m *PendingRegistration) String() string { return proto.CompactTextString(m) }
+func (*PendingRegistration) ProtoMessage()    {}
+func (*PendingRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{12}
+}
+func (m *PendingRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovErc20(uint64(l))
-	return n
+}
+func (m *PendingRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRegistration.Merge(m, src)
+}
+func (m *PendingRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRegistration.DiscardUnknown(m)
 }
 
-func (m *RegisterERC20Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_PendingRegistration proto.InternalMessageInfo
+
+func (m *PendingRegistration) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *PendingRegistration) GetRegistrant() string {
+	if m != nil {
+		return m.Registrant
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *PendingRegistration) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
 	}
-	l = len(m.Erc20Address)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return nil
+}
+
+func (m *PendingRegistration) GetActivationTime() time.Time {
+	if m != nil {
+		return m.ActivationTime
 	}
-	return n
+	return time.Time{}
 }
 
-func (m *ToggleTokenRelayProposal) Size() (n int) {
-	if m == nil {
-		return 0
+// VetoERC20RegistrationProposal is a gov Content type to reject a pending
+// registration of a token pair. The token pair is removed and the registration
+// deposit is burned.
+type VetoERC20RegistrationProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// hex address of the ERC20 contract of the pending registration
+	Erc20Address string `protobuf:"bytes,3,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+}
+
+func (m *VetoERC20RegistrationProposal) Reset()         { *m = VetoERC20RegistrationProposal{} }
+func (m *VetoERC20RegistrationProposal) String() string { return proto.CompactTextString(m) }
+func (*VetoERC20RegistrationProposal) ProtoMessage()    {}
+func (*VetoERC20RegistrationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{13}
+}
+func (m *VetoERC20RegistrationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VetoERC20RegistrationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VetoERC20RegistrationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+}
+func (m *VetoERC20RegistrationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VetoERC20RegistrationProposal.Merge(m, src)
+}
+func (m *VetoERC20RegistrationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *VetoERC20RegistrationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_VetoERC20RegistrationProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VetoERC20RegistrationProposal proto.InternalMessageInfo
+
+func (m *VetoERC20RegistrationProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *VetoERC20RegistrationProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *VetoERC20RegistrationProposal) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
 	}
-	return n
+	return ""
 }
 
-func (m *UpdateTokenPairERC20Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+// Pause defines an emergency pause of the conversions of a token pair, or of
+// the whole module, set by the guardian. The pause expires automatically
+// unless governance extends it.
+type Pause struct {
+	// hex address of the ERC20 contract of the paused token pair. Empty for a
+	// pause of the whole module.
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// reason of the pause given by the guardian
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// bech32 address of the guardian that paused the conversions
+	Guardian string `protobuf:"bytes,3,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// time at which the pause expires
+	Expiry time.Time `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *Pause) Reset()         { *m = Pause{} }
+func (m *Pause) String() string { return proto.CompactTextString(m) }
+func (*Pause) ProtoMessage()    {}
+func (*Pause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{14}
+}
+func (m *Pause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+}
+func (m *Pause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pause.Merge(m, src)
+}
+func (m *Pause) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pause) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pause proto.InternalMessageInfo
+
+func (m *Pause) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *Pause) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	l = len(m.Erc20Address)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *Pause) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
 	}
-	l = len(m.NewErc20Address)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *Pause) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
 	}
-	return n
+	return time.Time{}
 }
 
-func sovErc20(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// ExtendPauseProposal is a gov Content type to extend or lift an active pause
+// set by the guardian.
+type ExtendPauseProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination of the paused token pair. Empty for the pause of
+	// the whole module.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// duration of the pause from the execution of the proposal. A zero duration
+	// lifts the pause.
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
 }
-func sozErc20(x uint64) (n int) {
+
+func (m *ExtendPauseProposal) Reset()         { *m = ExtendPauseProposal{} }
+func (m *ExtendPauseProposal) String() string { return proto.CompactTextString(m) }
+func (*ExtendPauseProposal) ProtoMessage()    {}
+func (*ExtendPauseProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{15}
+}
+func (m *ExtendPauseProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendPauseProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendPauseProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtendPauseProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendPauseProposal.Merge(m, src)
+}
+func (m *ExtendPauseProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendPauseProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendPauseProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendPauseProposal proto.InternalMessageInfo
+
+func (m *ExtendPauseProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ExtendPauseProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ExtendPauseProposal) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ExtendPauseProposal) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// ConversionFee defines the fee charged on the conversions of a token pair, in
+// the Cosmos coin denomination of the pair. The fee is the greater of the rate
+// applied to the converted amount and the minimum fee.
+type ConversionFee struct {
+	// address of ERC20 contract token of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// rate of the converted amount charged as fee
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// minimum fee charged on a conversion
+	MinFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_fee,json=minFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee"`
+}
+
+func (m *ConversionFee) Reset()         { *m = ConversionFee{} }
+func (m *ConversionFee) String() string { return proto.CompactTextString(m) }
+func (*ConversionFee) ProtoMessage()    {}
+func (*ConversionFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{16}
+}
+func (m *ConversionFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionFee.Merge(m, src)
+}
+func (m *ConversionFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionFee proto.InternalMessageInfo
+
+func (m *ConversionFee) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+// UpdateConversionFeeProposal is a gov Content type to set the conversion fee
+// of a token pair, overriding the global conversion fee of the module
+// parameters.
+type UpdateConversionFeeProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// rate of the converted amount charged as fee
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// minimum fee charged on a conversion
+	MinFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_fee,json=minFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee"`
+	// remove the conversion fee of the token pair so that the global conversion
+	// fee applies. The rate and minimum fee are ignored.
+	UseGlobalFee bool `protobuf:"varint,6,opt,name=use_global_fee,json=useGlobalFee,proto3" json:"use_global_fee,omitempty"`
+}
+
+func (m *UpdateConversionFeeProposal) Reset()         { *m = UpdateConversionFeeProposal{} }
+func (m *UpdateConversionFeeProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateConversionFeeProposal) ProtoMessage()    {}
+func (*UpdateConversionFeeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{17}
+}
+func (m *UpdateConversionFeeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateConversionFeeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateConversionFeeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateConversionFeeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateConversionFeeProposal.Merge(m, src)
+}
+func (m *UpdateConversionFeeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateConversionFeeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateConversionFeeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateConversionFeeProposal proto.InternalMessageInfo
+
+func (m *UpdateConversionFeeProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *UpdateConversionFeeProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateConversionFeeProposal) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *UpdateConversionFeeProposal) GetUseGlobalFee() bool {
+	if m != nil {
+		return m.UseGlobalFee
+	}
+	return false
+}
+
+// TokenPairStats defines the cumulative conversion statistics of a token pair.
+type TokenPairStats struct {
+	// address of ERC20 contract token of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// total amount of Cosmos coins converted into ERC20 tokens
+	TotalInflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_inflow,json=totalInflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_inflow"`
+	// total amount of ERC20 tokens converted into Cosmos coins
+	TotalOutflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total_outflow,json=totalOutflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_outflow"`
+	// number of conversions of Cosmos coins into ERC20 tokens
+	InflowConversions uint64 `protobuf:"varint,4,opt,name=inflow_conversions,json=inflowConversions,proto3" json:"inflow_conversions,omitempty"`
+	// number of conversions of ERC20 tokens into Cosmos coins
+	OutflowConversions uint64 `protobuf:"varint,5,opt,name=outflow_conversions,json=outflowConversions,proto3" json:"outflow_conversions,omitempty"`
+	// block height of the last conversion
+	LastConversionHeight int64 `protobuf:"varint,6,opt,name=last_conversion_height,json=lastConversionHeight,proto3" json:"last_conversion_height,omitempty"`
+	// total amount converted through the EVM hook
+	EvmHookVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=evm_hook_volume,json=evmHookVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"evm_hook_volume"`
+	// total amount converted through the module messages
+	MsgVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=msg_volume,json=msgVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"msg_volume"`
+}
+
+func (m *TokenPairStats) Reset()         { *m = TokenPairStats{} }
+func (m *TokenPairStats) String() string { return proto.CompactTextString(m) }
+func (*TokenPairStats) ProtoMessage()    {}
+func (*TokenPairStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{18}
+}
+func (m *TokenPairStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPairStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPairStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenPairStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPairStats.Merge(m, src)
+}
+func (m *TokenPairStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPairStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPairStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPairStats proto.InternalMessageInfo
+
+func (m *TokenPairStats) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *TokenPairStats) GetInflowConversions() uint64 {
+	if m != nil {
+		return m.InflowConversions
+	}
+	return 0
+}
+
+func (m *TokenPairStats) GetOutflowConversions() uint64 {
+	if m != nil {
+		return m.OutflowConversions
+	}
+	return 0
+}
+
+func (m *TokenPairStats) GetLastConversionHeight() int64 {
+	if m != nil {
+		return m.LastConversionHeight
+	}
+	return 0
+}
+
+// MintCap defines the maximum supply of the Cosmos coin of a token pair owned
+// by an external ERC20 contract. Conversions that mint Cosmos coins beyond the
+// cap are rejected.
+type MintCap struct {
+	// address of ERC20 contract token of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// maximum supply of the Cosmos coin of the token pair
+	Cap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cap"`
+}
+
+func (m *MintCap) Reset()         { *m = MintCap{} }
+func (m *MintCap) String() string { return proto.CompactTextString(m) }
+func (*MintCap) ProtoMessage()    {}
+func (*MintCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{19}
+}
+func (m *MintCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintCap.Merge(m, src)
+}
+func (m *MintCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintCap proto.InternalMessageInfo
+
+func (m *MintCap) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+// UpdateMintCapProposal is a gov Content type to set the maximum supply of the
+// Cosmos coin of a token pair owned by an external ERC20 contract. A zero cap
+// removes the mint cap of the pair.
+type UpdateMintCapProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// maximum supply of the Cosmos coin of the token pair
+	Cap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cap"`
+}
+
+func (m *UpdateMintCapProposal) Reset()         { *m = UpdateMintCapProposal{} }
+func (m *UpdateMintCapProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateMintCapProposal) ProtoMessage()    {}
+func (*UpdateMintCapProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{20}
+}
+func (m *UpdateMintCapProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateMintCapProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateMintCapProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateMintCapProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateMintCapProposal.Merge(m, src)
+}
+func (m *UpdateMintCapProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateMintCapProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateMintCapProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateMintCapProposal proto.InternalMessageInfo
+
+func (m *UpdateMintCapProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *UpdateMintCapProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateMintCapProposal) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// SafetyPolicy defines the rules that the logs of the EVM calls made by the
+// conversions of a token pair must satisfy. Unexpected Approval events are
+// always rejected.
+type SafetyPolicy struct {
+	// address of ERC20 contract token of the token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// canonical signatures of the events allowed in the logs, e.g.
+	// "Transfer(address,address,uint256)". If empty, any event is allowed.
+	AllowedEventSignatures []string `protobuf:"bytes,2,rep,name=allowed_event_signatures,json=allowedEventSignatures,proto3" json:"allowed_event_signatures,omitempty"`
+	// hex addresses of the contracts, other than the ERC20 contract of the token
+	// pair, allowed to emit logs
+	AllowedEmitters []string `protobuf:"bytes,3,rep,name=allowed_emitters,json=allowedEmitters,proto3" json:"allowed_emitters,omitempty"`
+	// maximum number of logs emitted by an EVM call. Zero disables the limit.
+	MaxLogs uint32 `protobuf:"varint,4,opt,name=max_logs,json=maxLogs,proto3" json:"max_logs,omitempty"`
+}
+
+func (m *SafetyPolicy) Reset()         { *m = SafetyPolicy{} }
+func (m *SafetyPolicy) String() string { return proto.CompactTextString(m) }
+func (*SafetyPolicy) ProtoMessage()    {}
+func (*SafetyPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{21}
+}
+func (m *SafetyPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SafetyPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SafetyPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SafetyPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SafetyPolicy.Merge(m, src)
+}
+func (m *SafetyPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SafetyPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SafetyPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SafetyPolicy proto.InternalMessageInfo
+
+func (m *SafetyPolicy) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *SafetyPolicy) GetAllowedEventSignatures() []string {
+	if m != nil {
+		return m.AllowedEventSignatures
+	}
+	return nil
+}
+
+func (m *SafetyPolicy) GetAllowedEmitters() []string {
+	if m != nil {
+		return m.AllowedEmitters
+	}
+	return nil
+}
+
+func (m *SafetyPolicy) GetMaxLogs() uint32 {
+	if m != nil {
+		return m.MaxLogs
+	}
+	return 0
+}
+
+// UpdateSafetyPolicyProposal is a gov Content type to set the safety policy
+// of a token pair.
+type UpdateSafetyPolicyProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// canonical signatures of the events allowed in the logs. If empty, any event
+	// is allowed.
+	AllowedEventSignatures []string `protobuf:"bytes,4,rep,name=allowed_event_signatures,json=allowedEventSignatures,proto3" json:"allowed_event_signatures,omitempty"`
+	// hex addresses of the contracts, other than the ERC20 contract of the token
+	// pair, allowed to emit logs
+	AllowedEmitters []string `protobuf:"bytes,5,rep,name=allowed_emitters,json=allowedEmitters,proto3" json:"allowed_emitters,omitempty"`
+	// maximum number of logs emitted by an EVM call. Zero disables the limit.
+	MaxLogs uint32 `protobuf:"varint,6,opt,name=max_logs,json=maxLogs,proto3" json:"max_logs,omitempty"`
+	// remove the safety policy of the token pair so that only unexpected
+	// Approval events are rejected. The other fields are ignored.
+	UseDefaultPolicy bool `protobuf:"varint,7,opt,name=use_default_policy,json=useDefaultPolicy,proto3" json:"use_default_policy,omitempty"`
+}
+
+func (m *UpdateSafetyPolicyProposal) Reset()         { *m = UpdateSafetyPolicyProposal{} }
+func (m *UpdateSafetyPolicyProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateSafetyPolicyProposal) ProtoMessage()    {}
+func (*UpdateSafetyPolicyProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{22}
+}
+func (m *UpdateSafetyPolicyProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateSafetyPolicyProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateSafetyPolicyProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateSafetyPolicyProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSafetyPolicyProposal.Merge(m, src)
+}
+func (m *UpdateSafetyPolicyProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateSafetyPolicyProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSafetyPolicyProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSafetyPolicyProposal proto.InternalMessageInfo
+
+func (m *UpdateSafetyPolicyProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *UpdateSafetyPolicyProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *UpdateSafetyPolicyProposal) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *UpdateSafetyPolicyProposal) GetAllowedEventSignatures() []string {
+	if m != nil {
+		return m.AllowedEventSignatures
+	}
+	return nil
+}
+
+func (m *UpdateSafetyPolicyProposal) GetAllowedEmitters() []string {
+	if m != nil {
+		return m.AllowedEmitters
+	}
+	return nil
+}
+
+func (m *UpdateSafetyPolicyProposal) GetMaxLogs() uint32 {
+	if m != nil {
+		return m.MaxLogs
+	}
+	return 0
+}
+
+func (m *UpdateSafetyPolicyProposal) GetUseDefaultPolicy() bool {
+	if m != nil {
+		return m.UseDefaultPolicy
+	}
+	return false
+}
+
+// TokenPairTombstone records a token pair removed because its ERC20 contract
+// no longer has code, e.g. after a self-destruct, or a deregistered native
+// coin token pair, together with a snapshot of the Cosmos coins that remained
+// escrowed for the pair.
+type TokenPairTombstone struct {
+	// address of ERC20 contract token of the removed token pair
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// cosmos base denomination of the removed token pair
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// ERC20 owner address ENUM (0 invalid, 1 ModuleAccount, 2 external address)
+	ContractOwner Owner `protobuf:"varint,3,opt,name=contract_owner,json=contractOwner,proto3,enum=evmos.erc20.v1.Owner" json:"contract_owner,omitempty"`
+	// block height at which the token pair was tombstoned
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// reason for which the token pair was tombstoned
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Cosmos coins escrowed on the module account for the token pair that were
+	// stranded by the removal. It is zero for the pairs owned by an external
+	// ERC20 contract, as their Cosmos coins are minted.
+	Escrow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=escrow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"escrow"`
+	// supply of the Cosmos coin of the token pair when it was tombstoned
+	Supply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply"`
+	// true once the escrow has been distributed through a
+	// RecoverTokenPairEscrowProposal
+	Recovered bool `protobuf:"varint,8,opt,name=recovered,proto3" json:"recovered,omitempty"`
+}
+
+func (m *TokenPairTombstone) Reset()         { *m = TokenPairTombstone{} }
+func (m *TokenPairTombstone) String() string { return proto.CompactTextString(m) }
+func (*TokenPairTombstone) ProtoMessage()    {}
+func (*TokenPairTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{23}
+}
+func (m *TokenPairTombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPairTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPairTombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenPairTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPairTombstone.Merge(m, src)
+}
+func (m *TokenPairTombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPairTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPairTombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPairTombstone proto.InternalMessageInfo
+
+func (m *TokenPairTombstone) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *TokenPairTombstone) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenPairTombstone) GetContractOwner() Owner {
+	if m != nil {
+		return m.ContractOwner
+	}
+	return OWNER_UNSPECIFIED
+}
+
+func (m *TokenPairTombstone) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TokenPairTombstone) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *TokenPairTombstone) GetRecovered() bool {
+	if m != nil {
+		return m.Recovered
+	}
+	return false
+}
+
+// EscrowRecoveryShare defines the balance of an account in the snapshot used
+// to distribute the escrow of a tombstoned token pair pro-rata.
+type EscrowRecoveryShare struct {
+	// bech32 or hex address of the account receiving its share of the escrow
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balance of the account in the snapshot
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *EscrowRecoveryShare) Reset()         { *m = EscrowRecoveryShare{} }
+func (m *EscrowRecoveryShare) String() string { return proto.CompactTextString(m) }
+func (*EscrowRecoveryShare) ProtoMessage()    {}
+func (*EscrowRecoveryShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{24}
+}
+func (m *EscrowRecoveryShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowRecoveryShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowRecoveryShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowRecoveryShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowRecoveryShare.Merge(m, src)
+}
+func (m *EscrowRecoveryShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowRecoveryShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowRecoveryShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowRecoveryShare proto.InternalMessageInfo
+
+func (m *EscrowRecoveryShare) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// RecoverTokenPairEscrowProposal is a gov Content type to distribute the
+// Cosmos coins stranded on the module account by a tombstoned token pair.
+// The escrow is distributed pro-rata to the balances of the snapshot, or sent
+// to the community pool if the snapshot is empty.
+type RecoverTokenPairEscrowProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// hex address of the ERC20 contract of the tombstoned token pair
+	Erc20Address string `protobuf:"bytes,3,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// balances of the token holders, e.g. the ERC20 balances at a height before
+	// the contract was destroyed. The rounding remainder is sent to the
+	// community pool.
+	Shares []EscrowRecoveryShare `protobuf:"bytes,4,rep,name=shares,proto3" json:"shares"`
+}
+
+func (m *RecoverTokenPairEscrowProposal) Reset()         { *m = RecoverTokenPairEscrowProposal{} }
+func (m *RecoverTokenPairEscrowProposal) String() string { return proto.CompactTextString(m) }
+func (*RecoverTokenPairEscrowProposal) ProtoMessage()    {}
+func (*RecoverTokenPairEscrowProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{25}
+}
+func (m *RecoverTokenPairEscrowProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoverTokenPairEscrowProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoverTokenPairEscrowProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecoverTokenPairEscrowProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoverTokenPairEscrowProposal.Merge(m, src)
+}
+func (m *RecoverTokenPairEscrowProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoverTokenPairEscrowProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoverTokenPairEscrowProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoverTokenPairEscrowProposal proto.InternalMessageInfo
+
+func (m *RecoverTokenPairEscrowProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RecoverTokenPairEscrowProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RecoverTokenPairEscrowProposal) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *RecoverTokenPairEscrowProposal) GetShares() []EscrowRecoveryShare {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// RegisterWrappedEVMDenomProposal is a gov Content type to deploy the WEVMOS
+// contract and register it as the canonical token pair of the EVM
+// denomination on a running chain.
+type RegisterWrappedEVMDenomProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *RegisterWrappedEVMDenomProposal) Reset()         { *m = RegisterWrappedEVMDenomProposal{} }
+func (m *RegisterWrappedEVMDenomProposal) String() string { return proto.CompactTextString(m) }
+func (*RegisterWrappedEVMDenomProposal) ProtoMessage()    {}
+func (*RegisterWrappedEVMDenomProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{26}
+}
+func (m *RegisterWrappedEVMDenomProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterWrappedEVMDenomProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterWrappedEVMDenomProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterWrappedEVMDenomProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterWrappedEVMDenomProposal.Merge(m, src)
+}
+func (m *RegisterWrappedEVMDenomProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterWrappedEVMDenomProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterWrappedEVMDenomProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterWrappedEVMDenomProposal proto.InternalMessageInfo
+
+func (m *RegisterWrappedEVMDenomProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RegisterWrappedEVMDenomProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// ERC20Transfer defines the ERC20 contract of the tokens sent over IBC through
+// MsgTransferERC20 on the packet with the given source port, channel and
+// sequence. The tokens are converted back if the packet fails.
+type ERC20Transfer struct {
+	// source port of the packet
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// source channel of the packet
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence of the packet
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// address of the ERC20 contract of the transferred tokens
+	Erc20Address string `protobuf:"bytes,4,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+}
+
+func (m *ERC20Transfer) Reset()         { *m = ERC20Transfer{} }
+func (m *ERC20Transfer) String() string { return proto.CompactTextString(m) }
+func (*ERC20Transfer) ProtoMessage()    {}
+func (*ERC20Transfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{27}
+}
+func (m *ERC20Transfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20Transfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20Transfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20Transfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20Transfer.Merge(m, src)
+}
+func (m *ERC20Transfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20Transfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20Transfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20Transfer proto.InternalMessageInfo
+
+func (m *ERC20Transfer) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ERC20Transfer) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ERC20Transfer) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ERC20Transfer) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("evmos.erc20.v1.Owner", Owner_name, Owner_value)
+	proto.RegisterEnum("evmos.erc20.v1.FeeDestination", FeeDestination_name, FeeDestination_value)
+	proto.RegisterEnum("evmos.erc20.v1.TokenBehaviour", TokenBehaviour_name, TokenBehaviour_value)
+	proto.RegisterType((*TokenPair)(nil), "evmos.erc20.v1.TokenPair")
+	proto.RegisterType((*RegisterCoinProposal)(nil), "evmos.erc20.v1.RegisterCoinProposal")
+	proto.RegisterType((*RegisterERC20Proposal)(nil), "evmos.erc20.v1.RegisterERC20Proposal")
+	proto.RegisterType((*ToggleTokenRelayProposal)(nil), "evmos.erc20.v1.ToggleTokenRelayProposal")
+	proto.RegisterType((*UpdateTokenPairERC20Proposal)(nil), "evmos.erc20.v1.UpdateTokenPairERC20Proposal")
+	proto.RegisterType((*DeregisterTokenPairProposal)(nil), "evmos.erc20.v1.DeregisterTokenPairProposal")
+	proto.RegisterType((*RateLimit)(nil), "evmos.erc20.v1.RateLimit")
+	proto.RegisterType((*ConversionVolume)(nil), "evmos.erc20.v1.ConversionVolume")
+	proto.RegisterType((*PeriodConversionVolume)(nil), "evmos.erc20.v1.PeriodConversionVolume")
+	proto.RegisterType((*UpdateRateLimitProposal)(nil), "evmos.erc20.v1.UpdateRateLimitProposal")
+	proto.RegisterType((*UpgradeERC20ImplementationProposal)(nil), "evmos.erc20.v1.UpgradeERC20ImplementationProposal")
+	proto.RegisterType((*UpdateCoinMetadataProposal)(nil), "evmos.erc20.v1.UpdateCoinMetadataProposal")
+	proto.RegisterType((*PendingRegistration)(nil), "evmos.erc20.v1.PendingRegistration")
+	proto.RegisterType((*VetoERC20RegistrationProposal)(nil), "evmos.erc20.v1.VetoERC20RegistrationProposal")
+	proto.RegisterType((*Pause)(nil), "evmos.erc20.v1.Pause")
+	proto.RegisterType((*ExtendPauseProposal)(nil), "evmos.erc20.v1.ExtendPauseProposal")
+	proto.RegisterType((*ConversionFee)(nil), "evmos.erc20.v1.ConversionFee")
+	proto.RegisterType((*UpdateConversionFeeProposal)(nil), "evmos.erc20.v1.UpdateConversionFeeProposal")
+	proto.RegisterType((*TokenPairStats)(nil), "evmos.erc20.v1.TokenPairStats")
+	proto.RegisterType((*MintCap)(nil), "evmos.erc20.v1.MintCap")
+	proto.RegisterType((*UpdateMintCapProposal)(nil), "evmos.erc20.v1.UpdateMintCapProposal")
+	proto.RegisterType((*SafetyPolicy)(nil), "evmos.erc20.v1.SafetyPolicy")
+	proto.RegisterType((*UpdateSafetyPolicyProposal)(nil), "evmos.erc20.v1.UpdateSafetyPolicyProposal")
+	proto.RegisterType((*TokenPairTombstone)(nil), "evmos.erc20.v1.TokenPairTombstone")
+	proto.RegisterType((*EscrowRecoveryShare)(nil), "evmos.erc20.v1.EscrowRecoveryShare")
+	proto.RegisterType((*RecoverTokenPairEscrowProposal)(nil), "evmos.erc20.v1.RecoverTokenPairEscrowProposal")
+	proto.RegisterType((*RegisterWrappedEVMDenomProposal)(nil), "evmos.erc20.v1.RegisterWrappedEVMDenomProposal")
+	proto.RegisterType((*ERC20Transfer)(nil), "evmos.erc20.v1.ERC20Transfer")
+}
+
+func init() { proto.RegisterFile("evmos/erc20/v1/erc20.proto", fileDescriptor_668d5dc537f45142) }
+
+var fileDescriptor_668d5dc537f45142 = []byte{
+	// 2006 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4f, 0x6f, 0x23, 0x59,
+	0x11, 0x4f, 0xdb, 0x1d, 0xdb, 0xa9, 0x4c, 0x1c, 0xcf, 0x9b, 0x99, 0xac, 0x27, 0x33, 0xe3, 0x84,
+	0x0c, 0x5a, 0xc2, 0x88, 0x75, 0x66, 0x02, 0x07, 0x40, 0x23, 0x76, 0x93, 0xb8, 0x33, 0x63, 0x48,
+	0xec, 0xd0, 0x76, 0x66, 0x01, 0x21, 0xb5, 0x5e, 0xdc, 0x2f, 0xed, 0xd6, 0x74, 0xf7, 0x6b, 0xba,
+	0x9f, 0x9d, 0xe4, 0xc0, 0x85, 0x13, 0x12, 0x12, 0x9a, 0x0b, 0x12, 0x02, 0x09, 0xad, 0x04, 0xa7,
+	0x91, 0xe0, 0xc2, 0x09, 0x3e, 0x00, 0xec, 0x71, 0x8f, 0x08, 0x89, 0x5d, 0x34, 0x73, 0xe1, 0xca,
+	0x89, 0x2b, 0x7a, 0x7f, 0xba, 0x6d, 0x27, 0x61, 0xd7, 0xe3, 0x24, 0xcb, 0x29, 0xa9, 0xaa, 0x57,
+	0xf5, 0xaa, 0xea, 0x55, 0x55, 0xff, 0xde, 0x33, 0x2c, 0x92, 0xbe, 0x4f, 0xe3, 0x35, 0x12, 0x75,
+	0xd6, 0x1f, 0xae, 0xf5, 0x1f, 0xc9, 0x7f, 0xaa, 0x61, 0x44, 0x19, 0x45, 0x45, 0x21, 0xab, 0x4a,
+	0x56, 0xff, 0xd1, 0xe2, 0x4d, 0x87, 0x3a, 0x54, 0x88, 0xd6, 0xf8, 0x7f, 0x72, 0xd5, 0x62, 0xa5,
+	0x43, 0x63, 0x6e, 0xe2, 0x00, 0x07, 0xcf, 0xd7, 0xfa, 0x8f, 0x0e, 0x08, 0xc3, 0x8f, 0x04, 0x71,
+	0x46, 0x1e, 0x93, 0x54, 0xde, 0xa1, 0x6e, 0x90, 0xc8, 0x1d, 0x4a, 0x1d, 0x8f, 0xac, 0x09, 0xea,
+	0xa0, 0x77, 0xb8, 0x66, 0xf7, 0x22, 0xcc, 0x5c, 0x9a, 0xc8, 0x97, 0x4e, 0xcb, 0x99, 0xeb, 0x93,
+	0x98, 0x61, 0x3f, 0x94, 0x0b, 0x56, 0x5e, 0x66, 0x60, 0xa6, 0x4d, 0x9f, 0x93, 0x60, 0x0f, 0xbb,
+	0x11, 0xba, 0x0f, 0x73, 0xc2, 0x61, 0x0b, 0xdb, 0x76, 0x44, 0xe2, 0xb8, 0xac, 0x2d, 0x6b, 0xab,
+	0x33, 0xe6, 0x35, 0xc1, 0xdc, 0x90, 0x3c, 0x74, 0x13, 0xa6, 0x6d, 0x12, 0x50, 0xbf, 0x9c, 0x11,
+	0x42, 0x49, 0xa0, 0x32, 0xe4, 0x49, 0x80, 0x0f, 0x3c, 0x62, 0x97, 0xb3, 0xcb, 0xda, 0x6a, 0xc1,
+	0x4c, 0x48, 0xf4, 0x18, 0x8a, 0x1d, 0x1a, 0xb0, 0x08, 0x77, 0x98, 0x45, 0x8f, 0x02, 0x12, 0x95,
+	0xf5, 0x65, 0x6d, 0xb5, 0xb8, 0x7e, 0xab, 0x3a, 0x9a, 0xa2, 0x6a, 0x93, 0x0b, 0xcd, 0xb9, 0x64,
+	0xb1, 0x20, 0xd1, 0x63, 0x98, 0x39, 0x20, 0x5d, 0xdc, 0x77, 0x69, 0x2f, 0x2a, 0x4f, 0x0b, 0xc5,
+	0xca, 0x69, 0x45, 0x11, 0xc0, 0x66, 0xb2, 0xca, 0x1c, 0x28, 0xa0, 0x2f, 0x43, 0x29, 0xee, 0x60,
+	0xcf, 0x0d, 0x1c, 0x8b, 0x1c, 0x87, 0x34, 0x20, 0x01, 0x2b, 0xe7, 0x96, 0xb5, 0xd5, 0x39, 0x73,
+	0x5e, 0xf1, 0x0d, 0xc5, 0x46, 0x77, 0x60, 0xc6, 0xc1, 0xb1, 0xe5, 0xb9, 0xbe, 0xcb, 0xca, 0xf9,
+	0x65, 0x6d, 0x55, 0x37, 0x0b, 0x0e, 0x8e, 0x77, 0x38, 0xfd, 0x4d, 0xfd, 0x5f, 0x1f, 0x2c, 0x69,
+	0x2b, 0xff, 0xd0, 0xe0, 0xa6, 0x49, 0x1c, 0x37, 0x66, 0x24, 0xda, 0xa2, 0x6e, 0xb0, 0x17, 0xd1,
+	0x90, 0xc6, 0xd8, 0xe3, 0x29, 0x61, 0x2e, 0xf3, 0x88, 0xca, 0x97, 0x24, 0xd0, 0x32, 0xcc, 0xda,
+	0x24, 0xee, 0x44, 0x6e, 0xc8, 0x4f, 0x44, 0xa5, 0x6b, 0x98, 0x85, 0xde, 0x85, 0x82, 0x4f, 0x18,
+	0xb6, 0x31, 0xc3, 0x22, 0x6b, 0xb3, 0xeb, 0xf7, 0xaa, 0xf2, 0xc4, 0xab, 0xa2, 0x08, 0xd4, 0x89,
+	0x57, 0x77, 0xd5, 0xa2, 0x4d, 0xfd, 0xc3, 0x8f, 0x97, 0xa6, 0xcc, 0x54, 0xe9, 0xdc, 0xf8, 0xf4,
+	0x31, 0xe2, 0x9b, 0x3e, 0x27, 0xbe, 0xa9, 0x95, 0x3f, 0x67, 0xe0, 0x56, 0x12, 0x9f, 0x61, 0x6e,
+	0xad, 0x3f, 0xbc, 0x70, 0x80, 0x2b, 0x20, 0x6b, 0x27, 0xa9, 0xa7, 0xec, 0x50, 0x3d, 0x29, 0xde,
+	0xe8, 0x09, 0xeb, 0x97, 0x71, 0xc2, 0xd3, 0x63, 0x64, 0x20, 0x37, 0x9a, 0x01, 0xf4, 0x8d, 0xa1,
+	0xa3, 0xc8, 0x8f, 0x71, 0x14, 0x83, 0x43, 0x50, 0xc9, 0x0b, 0xa0, 0xdc, 0xa6, 0x8e, 0xe3, 0x11,
+	0xe1, 0xab, 0x49, 0x3c, 0x7c, 0x72, 0xe1, 0xf4, 0x71, 0x3d, 0x6e, 0x4d, 0xe5, 0x4d, 0x12, 0xaa,
+	0x18, 0x7f, 0xaf, 0xc1, 0xdd, 0xfd, 0xd0, 0xc6, 0x8c, 0xa4, 0xfd, 0x7b, 0x39, 0x67, 0x76, 0x66,
+	0x08, 0x64, 0xcf, 0x19, 0x02, 0x0f, 0xe0, 0x7a, 0x40, 0x8e, 0xac, 0xd1, 0x85, 0xba, 0x58, 0x38,
+	0x1f, 0x90, 0x23, 0x63, 0x68, 0xad, 0xf2, 0xf7, 0x67, 0x1a, 0xdc, 0xa9, 0x91, 0x48, 0x95, 0x57,
+	0xea, 0xf3, 0xd5, 0xe4, 0x88, 0x8f, 0xa3, 0x2e, 0xf5, 0x6c, 0x12, 0x71, 0xaf, 0xb2, 0xab, 0x33,
+	0x66, 0x42, 0x2a, 0x6f, 0xfe, 0x93, 0x81, 0x19, 0x13, 0x33, 0x22, 0x0f, 0x7f, 0xac, 0xb9, 0xf7,
+	0x43, 0x40, 0x3e, 0x3e, 0xb6, 0x42, 0x12, 0x59, 0x1d, 0x1a, 0xf4, 0x49, 0x14, 0xa7, 0x1e, 0x6d,
+	0x56, 0x79, 0x5f, 0xfe, 0xfd, 0xe3, 0xa5, 0xb7, 0x1d, 0x97, 0x75, 0x7b, 0x07, 0xd5, 0x0e, 0xf5,
+	0xd7, 0xd4, 0xe8, 0x96, 0x7f, 0xde, 0x89, 0xed, 0xe7, 0x6b, 0xec, 0x24, 0x24, 0x71, 0xb5, 0x1e,
+	0x30, 0xb3, 0xe4, 0xe3, 0xe3, 0x3d, 0x3e, 0x44, 0x12, 0x3b, 0x68, 0x17, 0xc0, 0x0d, 0x0e, 0x3d,
+	0x7a, 0x64, 0x75, 0x70, 0x58, 0xce, 0x4e, 0x64, 0x75, 0x46, 0x5a, 0xd8, 0xc2, 0x21, 0x6a, 0xc2,
+	0x2c, 0xed, 0xb1, 0xd4, 0x9e, 0x3e, 0x91, 0x3d, 0x50, 0x26, 0xb8, 0xc1, 0x05, 0xc8, 0x1d, 0xb9,
+	0x81, 0x4d, 0x8f, 0xd4, 0xec, 0x50, 0x14, 0xef, 0x3f, 0x12, 0xd2, 0x4e, 0xd7, 0x72, 0x6d, 0x12,
+	0x30, 0xf7, 0xd0, 0x25, 0x91, 0xe8, 0xad, 0x19, 0x73, 0x5e, 0xf0, 0xeb, 0x29, 0x5b, 0x65, 0xfe,
+	0x77, 0x1a, 0x94, 0x06, 0x71, 0x3f, 0xa3, 0x5e, 0xcf, 0x27, 0x68, 0x1b, 0x72, 0xd2, 0xf7, 0xb2,
+	0x36, 0x91, 0xa7, 0x4a, 0x1b, 0x3d, 0x85, 0xbc, 0xf2, 0x79, 0xc2, 0x83, 0x49, 0xd4, 0x57, 0x7e,
+	0xa1, 0xc1, 0xc2, 0x1e, 0x89, 0x5c, 0x6a, 0x9f, 0x71, 0x76, 0xac, 0x6a, 0x59, 0x80, 0x5c, 0x28,
+	0xd4, 0x85, 0x23, 0xba, 0xa9, 0x28, 0xf4, 0x2d, 0xc8, 0xf5, 0x85, 0x19, 0x35, 0xf0, 0x97, 0x4f,
+	0x8f, 0xba, 0xd3, 0xdb, 0xa9, 0x99, 0xaf, 0xb4, 0x56, 0xfe, 0x90, 0x85, 0xb7, 0x64, 0xdb, 0xa7,
+	0xe5, 0x7b, 0x45, 0x2d, 0x74, 0x7e, 0xbd, 0xeb, 0x57, 0x52, 0xef, 0xd3, 0x97, 0x5c, 0xef, 0xb9,
+	0x4b, 0xac, 0xf7, 0xfc, 0x67, 0xd6, 0x7b, 0xe1, 0xd3, 0xea, 0xfd, 0x8f, 0x1a, 0xac, 0xec, 0x87,
+	0x4e, 0x84, 0x6d, 0x22, 0xe6, 0x73, 0xdd, 0x0f, 0x3d, 0xe2, 0x93, 0x80, 0x09, 0x9c, 0x76, 0xe1,
+	0xb3, 0x7b, 0x1b, 0x8a, 0xee, 0x88, 0x45, 0x75, 0x88, 0xa7, 0xb8, 0xe8, 0x4b, 0x30, 0x3f, 0x52,
+	0xb4, 0x24, 0x19, 0x8c, 0xc5, 0xe1, 0xb2, 0x25, 0xc9, 0x7c, 0xfc, 0xb5, 0x06, 0x8b, 0xb2, 0xcc,
+	0x38, 0xd0, 0x49, 0x3e, 0x7a, 0xff, 0x77, 0xc0, 0xa3, 0xbe, 0xb5, 0x2f, 0x32, 0x70, 0x63, 0x8f,
+	0x04, 0xb6, 0x1b, 0x38, 0x12, 0xaf, 0x48, 0xd0, 0x3b, 0x5e, 0x67, 0x56, 0x00, 0x22, 0xa5, 0x14,
+	0x30, 0xe5, 0xe4, 0x10, 0x07, 0x11, 0xc8, 0xdb, 0x24, 0xa4, 0xb1, 0xcb, 0xca, 0xd9, 0xe5, 0xec,
+	0xea, 0xec, 0xfa, 0xed, 0x81, 0x8b, 0x31, 0x49, 0x5d, 0xe4, 0x79, 0xd9, 0x7c, 0xc8, 0xdd, 0x7b,
+	0xf9, 0xc9, 0xd2, 0xea, 0x18, 0x15, 0xc6, 0x15, 0x62, 0x33, 0xb1, 0x8d, 0x76, 0x61, 0x1e, 0x77,
+	0x98, 0xdb, 0x17, 0x9e, 0x5b, 0x1c, 0x97, 0x8b, 0xde, 0x9a, 0x5d, 0x5f, 0xac, 0x4a, 0xd0, 0x5e,
+	0x4d, 0x40, 0x7b, 0xb5, 0x9d, 0x80, 0xf6, 0xcd, 0x02, 0xdf, 0xef, 0xc5, 0x27, 0x4b, 0x9a, 0x59,
+	0x1c, 0x28, 0x73, 0xf1, 0xca, 0x4f, 0x34, 0xb8, 0xf7, 0x8c, 0x30, 0x2a, 0x6a, 0x6c, 0x38, 0x29,
+	0x9f, 0x0b, 0x1e, 0x50, 0x55, 0xf3, 0x1b, 0x0d, 0xa6, 0xf7, 0x70, 0x2f, 0x1e, 0x7f, 0x46, 0x46,
+	0x04, 0xc7, 0xe9, 0xb6, 0x8a, 0x42, 0x8b, 0x50, 0x70, 0x7a, 0x38, 0xb2, 0x5d, 0x9c, 0x54, 0x73,
+	0x4a, 0xa3, 0xc7, 0x90, 0x23, 0xc7, 0xa1, 0x1b, 0x9d, 0xbc, 0x51, 0xb6, 0x94, 0xce, 0xca, 0x4b,
+	0x0d, 0x6e, 0x18, 0xc7, 0x8c, 0x04, 0xb6, 0x70, 0xf3, 0x8a, 0x26, 0xe7, 0xbb, 0x50, 0x48, 0xee,
+	0x61, 0xca, 0xcb, 0xdb, 0x67, 0xbc, 0xac, 0xa9, 0x05, 0xd2, 0xc9, 0x5f, 0x72, 0x27, 0x53, 0x25,
+	0x55, 0xe5, 0x7f, 0xd5, 0x60, 0x6e, 0x30, 0x31, 0xb7, 0xc9, 0x98, 0x59, 0xdd, 0x04, 0x3d, 0xc2,
+	0x8c, 0x4c, 0xf0, 0x01, 0xac, 0x91, 0x8e, 0x29, 0x74, 0xd1, 0x13, 0xc8, 0xfb, 0x6e, 0x60, 0x1d,
+	0x12, 0x32, 0x21, 0x14, 0xc9, 0xf9, 0x2e, 0xf7, 0x38, 0xf9, 0xe6, 0x67, 0xe0, 0x4e, 0x32, 0x4d,
+	0x86, 0xe2, 0xb9, 0xa2, 0xf4, 0x27, 0x09, 0xd0, 0x2f, 0x27, 0x01, 0xd3, 0x17, 0x49, 0x00, 0xfa,
+	0x22, 0x14, 0x7b, 0x31, 0xb1, 0x1c, 0x8f, 0x1e, 0x60, 0x4f, 0xd8, 0xcb, 0x89, 0xeb, 0xf1, 0xb5,
+	0x5e, 0x4c, 0x9e, 0x08, 0xe6, 0x20, 0x4d, 0x1f, 0xe8, 0x50, 0x4c, 0x81, 0x71, 0x8b, 0x61, 0x16,
+	0x8f, 0x77, 0xe2, 0xdf, 0x85, 0x6b, 0x8c, 0x32, 0xec, 0x59, 0x0a, 0x43, 0x4d, 0x06, 0x7d, 0x66,
+	0x85, 0x8d, 0xba, 0x04, 0x52, 0x2d, 0x98, 0x93, 0x26, 0x13, 0x38, 0x35, 0x59, 0x19, 0x48, 0xbf,
+	0x9a, 0xd2, 0x06, 0x7a, 0x07, 0x50, 0xf2, 0xcd, 0x4f, 0xcb, 0x40, 0xde, 0x1a, 0x74, 0xf3, 0xba,
+	0xfa, 0x96, 0x0f, 0x04, 0x68, 0x0d, 0x6e, 0xa4, 0xdf, 0xf4, 0xa1, 0xf5, 0x12, 0x7f, 0xa2, 0xe4,
+	0x5b, 0x3d, 0xa4, 0xf0, 0x35, 0x58, 0xf0, 0x70, 0xcc, 0x86, 0x56, 0x5b, 0x5d, 0xe2, 0x3a, 0x5d,
+	0x79, 0xdb, 0xcb, 0x9a, 0x37, 0xb9, 0x74, 0xa0, 0xf0, 0x54, 0xc8, 0xd0, 0x33, 0x98, 0x27, 0x7d,
+	0xdf, 0xea, 0x52, 0xfa, 0xdc, 0x52, 0xd0, 0x2c, 0x3f, 0x51, 0xb0, 0x73, 0xa4, 0xef, 0x3f, 0xa5,
+	0xf4, 0xb9, 0x82, 0x89, 0xbb, 0x00, 0x7e, 0xec, 0x24, 0x26, 0x0b, 0x93, 0x21, 0x1c, 0x3f, 0x76,
+	0xa4, 0x39, 0x55, 0x22, 0x7d, 0xc8, 0xef, 0xba, 0x01, 0xe3, 0x08, 0x65, 0xac, 0xd2, 0x78, 0x0f,
+	0xb2, 0x1c, 0x0f, 0x4d, 0x56, 0x11, 0x5c, 0x75, 0x70, 0xdb, 0xbc, 0x25, 0x3b, 0x58, 0x6d, 0x7f,
+	0x45, 0xbd, 0xab, 0xfc, 0xd5, 0x2f, 0xea, 0xef, 0x9f, 0x34, 0xb8, 0xd6, 0xc2, 0x87, 0x84, 0x9d,
+	0xec, 0x51, 0xcf, 0xed, 0x9c, 0x8c, 0x97, 0xad, 0xaf, 0x43, 0x19, 0x7b, 0x1e, 0x3d, 0x22, 0xb6,
+	0x45, 0xfa, 0x24, 0x60, 0x56, 0xec, 0x3a, 0x01, 0x66, 0xbd, 0x88, 0xc4, 0xe5, 0x8c, 0x40, 0x4b,
+	0x0b, 0x4a, 0x6e, 0x70, 0x71, 0x2b, 0x95, 0x72, 0x58, 0x98, 0x6a, 0xfa, 0x2e, 0x63, 0xfc, 0xe2,
+	0x99, 0x15, 0x1a, 0xf3, 0x89, 0x86, 0x62, 0xa3, 0xdb, 0x50, 0xe0, 0xb8, 0xda, 0xa3, 0x4e, 0xac,
+	0xde, 0x6a, 0xf2, 0x3e, 0x3e, 0xde, 0xa1, 0x4e, 0xfa, 0x15, 0xcd, 0x24, 0xd8, 0x6b, 0x38, 0x82,
+	0x2b, 0x4a, 0xf8, 0xa7, 0x85, 0xac, 0xbf, 0x71, 0xc8, 0xd3, 0x9f, 0x1d, 0x72, 0x6e, 0x24, 0x64,
+	0xf4, 0x15, 0x40, 0x7c, 0x3e, 0xda, 0xe4, 0x10, 0xf7, 0x3c, 0x66, 0x85, 0x22, 0x56, 0xd1, 0x80,
+	0x05, 0xb3, 0xd4, 0x8b, 0x49, 0x4d, 0x0a, 0x64, 0x0e, 0x54, 0x82, 0xfe, 0x9d, 0x01, 0x94, 0xce,
+	0xc9, 0x36, 0xf5, 0x0f, 0x62, 0x46, 0x03, 0x72, 0x91, 0xd7, 0xcb, 0xb3, 0x6f, 0x94, 0xd9, 0x37,
+	0x78, 0xa3, 0x5c, 0x80, 0x9c, 0x9a, 0x33, 0xba, 0x98, 0x33, 0x8a, 0x1a, 0xc2, 0x37, 0xd3, 0x23,
+	0xf8, 0x66, 0x1b, 0x72, 0xfc, 0x5c, 0xe8, 0xd1, 0x84, 0xf7, 0x14, 0xa5, 0xcd, 0xed, 0xc4, 0xbd,
+	0x30, 0xf4, 0x4e, 0x26, 0x1c, 0x58, 0x4a, 0x1b, 0xdd, 0x85, 0x99, 0x88, 0x74, 0x68, 0x9f, 0x44,
+	0xc4, 0x16, 0x83, 0xaa, 0x60, 0x0e, 0x18, 0x2a, 0xe7, 0x3f, 0x86, 0x1b, 0x86, 0xd8, 0xd5, 0x94,
+	0x82, 0x93, 0x56, 0x17, 0x47, 0x84, 0xbf, 0xb3, 0x8c, 0x66, 0x3b, 0x21, 0xb9, 0x73, 0xd8, 0xa7,
+	0xbd, 0x04, 0x62, 0xbf, 0xb9, 0x73, 0x52, 0x5b, 0x6d, 0xff, 0x17, 0x0d, 0x2a, 0x6a, 0xe7, 0xc1,
+	0x73, 0x97, 0xf0, 0xe7, 0xf3, 0x79, 0xef, 0xda, 0x80, 0x5c, 0xdc, 0xc5, 0x49, 0x53, 0xcc, 0xae,
+	0xdf, 0x3f, 0x5d, 0x18, 0xe7, 0x24, 0x27, 0xb9, 0xb9, 0x4b, 0x45, 0x15, 0x88, 0x05, 0x4b, 0xc9,
+	0x13, 0xeb, 0xfb, 0x11, 0x0e, 0x43, 0x62, 0x1b, 0xcf, 0x76, 0x6b, 0xbc, 0x08, 0x2f, 0x1a, 0x88,
+	0xda, 0xe0, 0xe7, 0x1a, 0xcc, 0x89, 0x4b, 0x40, 0x3b, 0xc2, 0x41, 0x7c, 0x48, 0x22, 0xf4, 0x16,
+	0xe4, 0x43, 0x1a, 0x31, 0xcb, 0xb5, 0x95, 0xc5, 0x1c, 0x27, 0xeb, 0x36, 0xba, 0x07, 0xd0, 0xe9,
+	0xe2, 0x20, 0x20, 0x1e, 0x97, 0x49, 0x8b, 0x33, 0x8a, 0x53, 0xb7, 0x39, 0x0c, 0x8f, 0xc9, 0x8f,
+	0x7a, 0x24, 0xe8, 0x48, 0x14, 0xa8, 0x9b, 0x29, 0x7d, 0x36, 0x69, 0xfa, 0xff, 0xba, 0x14, 0x3c,
+	0xf8, 0x36, 0x4c, 0xcb, 0x36, 0xb9, 0x05, 0xd7, 0x9b, 0xef, 0x37, 0x0c, 0xd3, 0xda, 0x6f, 0xb4,
+	0xf6, 0x8c, 0xad, 0xfa, 0x76, 0xdd, 0xa8, 0x95, 0xa6, 0x50, 0x09, 0xae, 0x49, 0xf6, 0x6e, 0xb3,
+	0xb6, 0xbf, 0x63, 0x94, 0x34, 0x84, 0xa0, 0x28, 0x39, 0xc6, 0xf7, 0xda, 0x86, 0xd9, 0xd8, 0xd8,
+	0x29, 0x65, 0x16, 0xf5, 0x9f, 0xfe, 0xb6, 0x32, 0xf5, 0xe0, 0x08, 0x8a, 0xdb, 0x84, 0xd4, 0x48,
+	0xcc, 0xdc, 0x00, 0xab, 0x17, 0xe6, 0xca, 0xb6, 0x61, 0x58, 0x35, 0xa3, 0xd5, 0xae, 0x37, 0x36,
+	0xda, 0xf5, 0x66, 0xc3, 0xda, 0x6a, 0xee, 0xee, 0xee, 0x37, 0xea, 0xed, 0xef, 0x5b, 0x7b, 0xcd,
+	0xe6, 0x4e, 0x69, 0x0a, 0x7d, 0x01, 0xee, 0x9d, 0x5e, 0xc3, 0xe9, 0xad, 0xe6, 0xce, 0x8e, 0xb1,
+	0xd5, 0x6e, 0x9a, 0x25, 0x0d, 0x95, 0xe1, 0xe6, 0xe9, 0x25, 0x9b, 0xfb, 0x66, 0x23, 0xdd, 0xf8,
+	0x57, 0x1a, 0x14, 0x47, 0x1f, 0xa1, 0xd1, 0x5d, 0x28, 0xb7, 0x9b, 0xdf, 0x31, 0x1a, 0xd6, 0xa6,
+	0xf1, 0x74, 0xe3, 0x59, 0xbd, 0xb9, 0x6f, 0x5a, 0xad, 0xf6, 0x46, 0xa3, 0xb6, 0x61, 0xd6, 0xe4,
+	0x9e, 0x67, 0xa4, 0x1b, 0xdb, 0x86, 0xd5, 0x36, 0x37, 0x1a, 0xad, 0x6d, 0x83, 0xef, 0x79, 0x1f,
+	0x96, 0x4e, 0x2f, 0xe1, 0x3e, 0x34, 0x1b, 0x83, 0x45, 0x99, 0xf3, 0x76, 0x31, 0x8d, 0xcd, 0x8d,
+	0x56, 0xbd, 0xf1, 0xa4, 0x94, 0x95, 0xce, 0x6d, 0xbe, 0xf7, 0xe1, 0xab, 0x8a, 0xf6, 0xd1, 0xab,
+	0x8a, 0xf6, 0xcf, 0x57, 0x15, 0xed, 0xc5, 0xeb, 0xca, 0xd4, 0x47, 0xaf, 0x2b, 0x53, 0x7f, 0x7b,
+	0x5d, 0x99, 0xfa, 0xc1, 0x70, 0xb3, 0xb1, 0x2e, 0x8e, 0x62, 0x37, 0x5e, 0x93, 0x3f, 0x5a, 0x1d,
+	0xab, 0x9f, 0xad, 0x44, 0xc3, 0x1d, 0xe4, 0xc4, 0xbd, 0xe4, 0xab, 0xff, 0x1d, 0x00, 0xe3, 0x80,
+	0xa5, 0x6f, 0xd2, 0x1a, 0x00, 0x00,
+}
+
+func (this *TokenPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenPair)
+	if !ok {
+		that2, ok := that.(TokenPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.ContractOwner != that1.ContractOwner {
+		return false
+	}
+	if this.Behaviour != that1.Behaviour {
+		return false
+	}
+	if this.ScalingExponent != that1.ScalingExponent {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	return true
+}
+func (this *ToggleTokenRelayProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ToggleTokenRelayProposal)
+	if !ok {
+		that2, ok := that.(ToggleTokenRelayProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	return true
+}
+func (this *UpdateTokenPairERC20Proposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateTokenPairERC20Proposal)
+	if !ok {
+		that2, ok := that.(UpdateTokenPairERC20Proposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if this.NewErc20Address != that1.NewErc20Address {
+		return false
+	}
+	return true
+}
+func (this *DeregisterTokenPairProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeregisterTokenPairProposal)
+	if !ok {
+		that2, ok := that.(DeregisterTokenPairProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if len(this.Holders) != len(that1.Holders) {
+		return false
+	}
+	for i := range this.Holders {
+		if this.Holders[i] != that1.Holders[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *RateLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RateLimit)
+	if !ok {
+		that2, ok := that.(RateLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if !this.MaxPerConversion.Equal(that1.MaxPerConversion) {
+		return false
+	}
+	if !this.InflowCap.Equal(that1.InflowCap) {
+		return false
+	}
+	if !this.OutflowCap.Equal(that1.OutflowCap) {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	if this.EpochIdentifier != that1.EpochIdentifier {
+		return false
+	}
+	return true
+}
+func (this *UpdateRateLimitProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateRateLimitProposal)
+	if !ok {
+		that2, ok := that.(UpdateRateLimitProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if !this.MaxPerConversion.Equal(that1.MaxPerConversion) {
+		return false
+	}
+	if !this.InflowCap.Equal(that1.InflowCap) {
+		return false
+	}
+	if !this.OutflowCap.Equal(that1.OutflowCap) {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	if this.EpochIdentifier != that1.EpochIdentifier {
+		return false
+	}
+	return true
+}
+func (this *UpgradeERC20ImplementationProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpgradeERC20ImplementationProposal)
+	if !ok {
+		that2, ok := that.(UpgradeERC20ImplementationProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Implementation != that1.Implementation {
+		return false
+	}
+	if len(this.Erc20Addresses) != len(that1.Erc20Addresses) {
+		return false
+	}
+	for i := range this.Erc20Addresses {
+		if this.Erc20Addresses[i] != that1.Erc20Addresses[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *VetoERC20RegistrationProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VetoERC20RegistrationProposal)
+	if !ok {
+		that2, ok := that.(VetoERC20RegistrationProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	return true
+}
+func (this *ConversionFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConversionFee)
+	if !ok {
+		that2, ok := that.(ConversionFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if !this.MinFee.Equal(that1.MinFee) {
+		return false
+	}
+	return true
+}
+func (this *UpdateConversionFeeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateConversionFeeProposal)
+	if !ok {
+		that2, ok := that.(UpdateConversionFeeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if !this.MinFee.Equal(that1.MinFee) {
+		return false
+	}
+	if this.UseGlobalFee != that1.UseGlobalFee {
+		return false
+	}
+	return true
+}
+func (this *TokenPairStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenPairStats)
+	if !ok {
+		that2, ok := that.(TokenPairStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if !this.TotalInflow.Equal(that1.TotalInflow) {
+		return false
+	}
+	if !this.TotalOutflow.Equal(that1.TotalOutflow) {
+		return false
+	}
+	if this.InflowConversions != that1.InflowConversions {
+		return false
+	}
+	if this.OutflowConversions != that1.OutflowConversions {
+		return false
+	}
+	if this.LastConversionHeight != that1.LastConversionHeight {
+		return false
+	}
+	if !this.EvmHookVolume.Equal(that1.EvmHookVolume) {
+		return false
+	}
+	if !this.MsgVolume.Equal(that1.MsgVolume) {
+		return false
+	}
+	return true
+}
+func (this *MintCap) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintCap)
+	if !ok {
+		that2, ok := that.(MintCap)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if !this.Cap.Equal(that1.Cap) {
+		return false
+	}
+	return true
+}
+func (this *UpdateMintCapProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateMintCapProposal)
+	if !ok {
+		that2, ok := that.(UpdateMintCapProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if !this.Cap.Equal(that1.Cap) {
+		return false
+	}
+	return true
+}
+func (this *SafetyPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SafetyPolicy)
+	if !ok {
+		that2, ok := that.(SafetyPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if len(this.AllowedEventSignatures) != len(that1.AllowedEventSignatures) {
+		return false
+	}
+	for i := range this.AllowedEventSignatures {
+		if this.AllowedEventSignatures[i] != that1.AllowedEventSignatures[i] {
+			return false
+		}
+	}
+	if len(this.AllowedEmitters) != len(that1.AllowedEmitters) {
+		return false
+	}
+	for i := range this.AllowedEmitters {
+		if this.AllowedEmitters[i] != that1.AllowedEmitters[i] {
+			return false
+		}
+	}
+	if this.MaxLogs != that1.MaxLogs {
+		return false
+	}
+	return true
+}
+func (this *UpdateSafetyPolicyProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateSafetyPolicyProposal)
+	if !ok {
+		that2, ok := that.(UpdateSafetyPolicyProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	if len(this.AllowedEventSignatures) != len(that1.AllowedEventSignatures) {
+		return false
+	}
+	for i := range this.AllowedEventSignatures {
+		if this.AllowedEventSignatures[i] != that1.AllowedEventSignatures[i] {
+			return false
+		}
+	}
+	if len(this.AllowedEmitters) != len(that1.AllowedEmitters) {
+		return false
+	}
+	for i := range this.AllowedEmitters {
+		if this.AllowedEmitters[i] != that1.AllowedEmitters[i] {
+			return false
+		}
+	}
+	if this.MaxLogs != that1.MaxLogs {
+		return false
+	}
+	if this.UseDefaultPolicy != that1.UseDefaultPolicy {
+		return false
+	}
+	return true
+}
+func (this *TokenPairTombstone) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenPairTombstone)
+	if !ok {
+		that2, ok := that.(TokenPairTombstone)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.ContractOwner != that1.ContractOwner {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if !this.Escrow.Equal(that1.Escrow) {
+		return false
+	}
+	if !this.Supply.Equal(that1.Supply) {
+		return false
+	}
+	if this.Recovered != that1.Recovered {
+		return false
+	}
+	return true
+}
+func (this *EscrowRecoveryShare) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EscrowRecoveryShare)
+	if !ok {
+		that2, ok := that.(EscrowRecoveryShare)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *RecoverTokenPairEscrowProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RecoverTokenPairEscrowProposal)
+	if !ok {
+		that2, ok := that.(RecoverTokenPairEscrowProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if len(this.Shares) != len(that1.Shares) {
+		return false
+	}
+	for i := range this.Shares {
+		if !this.Shares[i].Equal(&that1.Shares[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *RegisterWrappedEVMDenomProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegisterWrappedEVMDenomProposal)
+	if !ok {
+		that2, ok := that.(RegisterWrappedEVMDenomProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	return true
+}
+func (this *ERC20Transfer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ERC20Transfer)
+	if !ok {
+		that2, ok := that.(ERC20Transfer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PortId != that1.PortId {
+		return false
+	}
+	if this.ChannelId != that1.ChannelId {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	return true
+}
+func (m *TokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ScalingExponent != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ScalingExponent))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Behaviour != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Behaviour))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ContractOwner != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterCoinProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ScalingExponent != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ScalingExponent))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterERC20Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintErc20(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ScalingExponent != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ScalingExponent))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Behaviour != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Behaviour))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ToggleTokenRelayProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ToggleTokenRelayProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ToggleTokenRelayProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTokenPairERC20Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTokenPairERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTokenPairERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewErc20Address) > 0 {
+		i -= len(m.NewErc20Address)
+		copy(dAtA[i:], m.NewErc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.NewErc20Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeregisterTokenPairProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeregisterTokenPairProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeregisterTokenPairProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Holders) > 0 {
+		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Holders[iNdEx])
+			copy(dAtA[i:], m.Holders[iNdEx])
+			i = encodeVarintErc20(dAtA, i, uint64(len(m.Holders[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Window != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.OutflowCap.Size()
+		i -= size
+		if _, err := m.OutflowCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InflowCap.Size()
+		i -= size
+		if _, err := m.InflowCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxPerConversion.Size()
+		i -= size
+		if _, err := m.MaxPerConversion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodConversionVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodConversionVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodConversionVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Volume.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Period != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateRateLimitProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateRateLimitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateRateLimitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Window != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.OutflowCap.Size()
+		i -= size
+		if _, err := m.OutflowCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.InflowCap.Size()
+		i -= size
+		if _, err := m.InflowCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxPerConversion.Size()
+		i -= size
+		if _, err := m.MaxPerConversion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeERC20ImplementationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeERC20ImplementationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeERC20ImplementationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Addresses) > 0 {
+		for iNdEx := len(m.Erc20Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Erc20Addresses[iNdEx])
+			copy(dAtA[i:], m.Erc20Addresses[iNdEx])
+			i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Implementation) > 0 {
+		i -= len(m.Implementation)
+		copy(dAtA[i:], m.Implementation)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Implementation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateCoinMetadataProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateCoinMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateCoinMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ActivationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintErc20(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintErc20(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Registrant) > 0 {
+		i -= len(m.Registrant)
+		copy(dAtA[i:], m.Registrant)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Registrant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VetoERC20RegistrationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VetoERC20RegistrationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VetoERC20RegistrationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintErc20(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtendPauseProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtendPauseProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtendPauseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintErc20(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateConversionFeeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateConversionFeeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateConversionFeeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UseGlobalFee {
+		i--
+		if m.UseGlobalFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenPairStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPairStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPairStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MsgVolume.Size()
+		i -= size
+		if _, err := m.MsgVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.EvmHookVolume.Size()
+		i -= size
+		if _, err := m.EvmHookVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.LastConversionHeight != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.LastConversionHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OutflowConversions != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.OutflowConversions))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InflowConversions != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.InflowConversions))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TotalOutflow.Size()
+		i -= size
+		if _, err := m.TotalOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalInflow.Size()
+		i -= size
+		if _, err := m.TotalInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateMintCapProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateMintCapProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateMintCapProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SafetyPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SafetyPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SafetyPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxLogs != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.MaxLogs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AllowedEmitters) > 0 {
+		for iNdEx := len(m.AllowedEmitters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedEmitters[iNdEx])
+			copy(dAtA[i:], m.AllowedEmitters[iNdEx])
+			i = encodeVarintErc20(dAtA, i, uint64(len(m.AllowedEmitters[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedEventSignatures) > 0 {
+		for iNdEx := len(m.AllowedEventSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedEventSignatures[iNdEx])
+			copy(dAtA[i:], m.AllowedEventSignatures[iNdEx])
+			i = encodeVarintErc20(dAtA, i, uint64(len(m.AllowedEventSignatures[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateSafetyPolicyProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateSafetyPolicyProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateSafetyPolicyProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UseDefaultPolicy {
+		i--
+		if m.UseDefaultPolicy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxLogs != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.MaxLogs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AllowedEmitters) > 0 {
+		for iNdEx := len(m.AllowedEmitters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedEmitters[iNdEx])
+			copy(dAtA[i:], m.AllowedEmitters[iNdEx])
+			i = encodeVarintErc20(dAtA, i, uint64(len(m.AllowedEmitters[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowedEventSignatures) > 0 {
+		for iNdEx := len(m.AllowedEventSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedEventSignatures[iNdEx])
+			copy(dAtA[i:], m.AllowedEventSignatures[iNdEx])
+			i = encodeVarintErc20(dAtA, i, uint64(len(m.AllowedEventSignatures[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenPairTombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPairTombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPairTombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Recovered {
+		i--
+		if m.Recovered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Escrow.Size()
+		i -= size
+		if _, err := m.Escrow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ContractOwner != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowRecoveryShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowRecoveryShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowRecoveryShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecoverTokenPairEscrowProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoverTokenPairEscrowProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoverTokenPairEscrowProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintErc20(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterWrappedEVMDenomProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterWrappedEVMDenomProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterWrappedEVMDenomProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20Transfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Transfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Transfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
+	offset -= sovErc20(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.ContractOwner != 0 {
+		n += 1 + sovErc20(uint64(m.ContractOwner))
+	}
+	if m.Behaviour != 0 {
+		n += 1 + sovErc20(uint64(m.Behaviour))
+	}
+	if m.ScalingExponent != 0 {
+		n += 1 + sovErc20(uint64(m.ScalingExponent))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovErc20(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *RegisterCoinProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	if m.ScalingExponent != 0 {
+		n += 1 + sovErc20(uint64(m.ScalingExponent))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovErc20(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *RegisterERC20Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Behaviour != 0 {
+		n += 1 + sovErc20(uint64(m.Behaviour))
+	}
+	if m.ScalingExponent != 0 {
+		n += 1 + sovErc20(uint64(m.ScalingExponent))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovErc20(uint64(m.GasLimit))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *ToggleTokenRelayProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateTokenPairERC20Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.NewErc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *DeregisterTokenPairProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.Holders) > 0 {
+		for _, s := range m.Holders {
+			l = len(s)
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.MaxPerConversion.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.InflowCap.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.OutflowCap.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovErc20(uint64(m.Window))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *ConversionVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *PeriodConversionVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovErc20(uint64(m.Period))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *UpdateRateLimitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.MaxPerConversion.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.InflowCap.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.OutflowCap.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovErc20(uint64(m.Window))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradeERC20ImplementationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Implementation)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.Erc20Addresses) > 0 {
+		for _, s := range m.Erc20Addresses {
+			l = len(s)
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateCoinMetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *PendingRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Registrant)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ActivationTime)
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *VetoERC20RegistrationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *Pause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *ExtendPauseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *ConversionFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *UpdateConversionFeeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	if m.UseGlobalFee {
+		n += 2
+	}
+	return n
+}
+
+func (m *TokenPairStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.TotalInflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.TotalOutflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	if m.InflowConversions != 0 {
+		n += 1 + sovErc20(uint64(m.InflowConversions))
+	}
+	if m.OutflowConversions != 0 {
+		n += 1 + sovErc20(uint64(m.OutflowConversions))
+	}
+	if m.LastConversionHeight != 0 {
+		n += 1 + sovErc20(uint64(m.LastConversionHeight))
+	}
+	l = m.EvmHookVolume.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.MsgVolume.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *MintCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *UpdateMintCapProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *SafetyPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.AllowedEventSignatures) > 0 {
+		for _, s := range m.AllowedEventSignatures {
+			l = len(s)
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	if len(m.AllowedEmitters) > 0 {
+		for _, s := range m.AllowedEmitters {
+			l = len(s)
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	if m.MaxLogs != 0 {
+		n += 1 + sovErc20(uint64(m.MaxLogs))
+	}
+	return n
+}
+
+func (m *UpdateSafetyPolicyProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.AllowedEventSignatures) > 0 {
+		for _, s := range m.AllowedEventSignatures {
+			l = len(s)
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	if len(m.AllowedEmitters) > 0 {
+		for _, s := range m.AllowedEmitters {
+			l = len(s)
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	if m.MaxLogs != 0 {
+		n += 1 + sovErc20(uint64(m.MaxLogs))
+	}
+	if m.UseDefaultPolicy {
+		n += 2
+	}
+	return n
+}
+
+func (m *TokenPairTombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.ContractOwner != 0 {
+		n += 1 + sovErc20(uint64(m.ContractOwner))
+	}
+	if m.Height != 0 {
+		n += 1 + sovErc20(uint64(m.Height))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Escrow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	if m.Recovered {
+		n += 2
+	}
+	return n
+}
+
+func (m *EscrowRecoveryShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *RecoverTokenPairEscrowProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisterWrappedEVMDenomProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20Transfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovErc20(uint64(m.Sequence))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func sovErc20(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozErc20(x uint64) (n int) {
 	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *TokenPair) Unmarshal(dAtA []byte) error {
+func (m *TokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			}
+			m.ContractOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractOwner |= Owner(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Behaviour", wireType)
+			}
+			m.Behaviour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Behaviour |= TokenBehaviour(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingExponent", wireType)
+			}
+			m.ScalingExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScalingExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterCoinProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterCoinProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingExponent", wireType)
+			}
+			m.ScalingExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScalingExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterERC20Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Behaviour", wireType)
+			}
+			m.Behaviour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Behaviour |= TokenBehaviour(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingExponent", wireType)
+			}
+			m.ScalingExponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScalingExponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &types.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ToggleTokenRelayProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ToggleTokenRelayProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ToggleTokenRelayProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTokenPairERC20Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTokenPairERC20Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTokenPairERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewErc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewErc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeregisterTokenPairProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregisterTokenPairProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregisterTokenPairProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerConversion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPerConversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflowCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutflowCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodConversionVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodConversionVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodConversionVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRateLimitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRateLimitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRateLimitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerConversion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPerConversion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflowCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutflowCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeERC20ImplementationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeERC20ImplementationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeERC20ImplementationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Implementation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Implementation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Addresses = append(m.Erc20Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateCoinMetadataProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateCoinMetadataProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateCoinMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registrant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registrant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types1.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VetoERC20RegistrationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VetoERC20RegistrationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VetoERC20RegistrationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtendPauseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendPauseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendPauseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateConversionFeeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateConversionFeeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateConversionFeeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseGlobalFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseGlobalFee = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenPairStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPairStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPairStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowConversions", wireType)
+			}
+			m.InflowConversions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflowConversions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowConversions", wireType)
+			}
+			m.OutflowConversions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutflowConversions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastConversionHeight", wireType)
+			}
+			m.LastConversionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastConversionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmHookVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EvmHookVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MsgVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateMintCapProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateMintCapProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateMintCapProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SafetyPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SafetyPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SafetyPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedEventSignatures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedEventSignatures = append(m.AllowedEventSignatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedEmitters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedEmitters = append(m.AllowedEmitters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLogs", wireType)
+			}
+			m.MaxLogs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLogs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateSafetyPolicyProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -929,10 +9517,259 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateSafetyPolicyProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateSafetyPolicyProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedEventSignatures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedEventSignatures = append(m.AllowedEventSignatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedEmitters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedEmitters = append(m.AllowedEmitters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLogs", wireType)
+			}
+			m.MaxLogs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLogs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDefaultPolicy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseDefaultPolicy = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenPairTombstone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPairTombstone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenPairTombstone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1001,9 +9838,9 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
 			}
-			var v int
+			m.ContractOwner = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -1013,17 +9850,16 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ContractOwner |= Owner(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.ContractOwner = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -1033,11 +9869,131 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractOwner |= Owner(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Recovered = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -1059,7 +10015,7 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
+func (m *EscrowRecoveryShare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1082,15 +10038,15 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterCoinProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EscrowRecoveryShare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterCoinProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EscrowRecoveryShare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1118,11 +10074,11 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1150,38 +10106,7 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowErc20
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthErc20
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthErc20
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1206,7 +10131,7 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
+func (m *RecoverTokenPairEscrowProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1229,10 +10154,10 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterERC20Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecoverTokenPairEscrowProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecoverTokenPairEscrowProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1331,6 +10256,40 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Erc20Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, EscrowRecoveryShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -1352,7 +10311,7 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ToggleTokenRelayProposal) Unmarshal(dAtA []byte) error {
+func (m *RegisterWrappedEVMDenomProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1375,10 +10334,10 @@ func (m *ToggleTokenRelayProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ToggleTokenRelayProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterWrappedEVMDenomProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ToggleTokenRelayProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterWrappedEVMDenomProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1445,38 +10404,6 @@ func (m *ToggleTokenRelayProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowErc20
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthErc20
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthErc20
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Token = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -1498,7 +10425,7 @@ func (m *ToggleTokenRelayProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateTokenPairERC20Proposal) Unmarshal(dAtA []byte) error {
+func (m *ERC20Transfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1521,15 +10448,15 @@ func (m *UpdateTokenPairERC20Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateTokenPairERC20Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ERC20Transfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateTokenPairERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ERC20Transfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1557,11 +10484,11 @@ func (m *UpdateTokenPairERC20Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1589,13 +10516,13 @@ func (m *UpdateTokenPairERC20Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var stringLen uint64
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -1605,27 +10532,14 @@ func (m *UpdateTokenPairERC20Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthErc20
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthErc20
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Erc20Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewErc20Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1653,7 +10567,7 @@ func (m *UpdateTokenPairERC20Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewErc20Address = string(dAtA[iNdEx:postIndex])
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16,4 +16,16 @@ var (
 	ErrSuicidedContract         = sdkerrors.Register(ModuleName, 9, "suicided contract pair")
 	ErrInvalidConversionBalance = sdkerrors.Register(ModuleName, 10, "invalid conversion balance")
 	ErrUnexpectedEvent          = sdkerrors.Register(ModuleName, 11, "unexpected event")
+	ErrRateLimitExceeded        = sdkerrors.Register(ModuleName, 12, "conversion rate limit exceeded")
+	ErrUnsupportedBehaviour     = sdkerrors.Register(ModuleName, 13, "unsupported token behaviour")
+	ErrInvalidImplementation    = sdkerrors.Register(ModuleName, 14, "invalid ERC20 implementation")
+	ErrPendingRegistration      = sdkerrors.Register(ModuleName, 15, "pending token pair registration")
+	ErrPaused                   = sdkerrors.Register(ModuleName, 16, "conversions paused by the guardian")
+	ErrInsufficientConversion   = sdkerrors.Register(ModuleName, 17, "converted amount does not cover the conversion fee")
+	ErrInvalidScaling           = sdkerrors.Register(ModuleName, 18, "invalid token pair decimal scaling")
+	ErrInvalidGasLimit          = sdkerrors.Register(ModuleName, 19, "invalid token pair gas limit")
+	ErrMintCapExceeded          = sdkerrors.Register(ModuleName, 20, "token pair mint cap exceeded")
+	ErrTokenPairTombstone       = sdkerrors.Register(ModuleName, 21, "invalid token pair tombstone")
+	ErrInvalidMetadataOverride  = sdkerrors.Register(ModuleName, 22, "invalid coin metadata override")
+	ErrInvalidRateLimit         = sdkerrors.Register(ModuleName, 23, "invalid conversion rate limit")
 )
@@ -8,20 +8,77 @@ import (
 
 // erc20 events
 const (
-	EventTypeTokenLock            = "token_lock"
-	EventTypeTokenUnlock          = "token_unlock"
-	EventTypeMint                 = "mint"
-	EventTypeConvertCoin          = "convert_coin"
-	EventTypeConvertERC20         = "convert_erc20"
-	EventTypeBurn                 = "burn"
-	EventTypeRegisterCoin         = "register_coin"
-	EventTypeRegisterERC20        = "register_erc20"
-	EventTypeToggleTokenRelay     = "toggle_token_relay" // #nosec
-	EventTypeUpdateTokenPairERC20 = "update_token_pair_erc20"
+	EventTypeTokenLock              = "token_lock"
+	EventTypeTokenUnlock            = "token_unlock"
+	EventTypeMint                   = "mint"
+	EventTypeConvertCoin            = "convert_coin"
+	EventTypeConvertERC20           = "convert_erc20"
+	EventTypeConvertERC20WithPermit = "convert_erc20_with_permit"
+	EventTypeConvertERC20From       = "convert_erc20_from"
+	EventTypeTransferERC20          = "transfer_erc20"
+	EventTypeRefundERC20            = "refund_erc20"
+	EventTypeBurn                   = "burn"
+	EventTypeRegisterCoin           = "register_coin"
+	EventTypeRegisterERC20          = "register_erc20"
+	EventTypeToggleTokenRelay       = "toggle_token_relay" // #nosec
+	EventTypeUpdateTokenPairERC20   = "update_token_pair_erc20"
+	EventTypeDeregisterTokenPair    = "deregister_token_pair"
+	EventTypeUpdateRateLimit        = "update_rate_limit"
+	EventTypeUpgradeImplementation  = "upgrade_erc20_implementation"
+	EventTypeUpdateCoinMetadata     = "update_coin_metadata"
+	EventTypeSubmitRegistration     = "submit_erc20_registration"
+	EventTypeActivateRegistration   = "activate_erc20_registration"
+	EventTypeVetoRegistration       = "veto_erc20_registration"
+	EventTypePausePair              = "pause_pair"
+	EventTypePauseModule            = "pause_module"
+	EventTypeExtendPause            = "extend_pause"
+	EventTypePauseExpired           = "pause_expired"
+	EventTypeUpdateConversionFee    = "update_conversion_fee"
+	EventTypeUpdateMintCap          = "update_mint_cap"
+	EventTypeUpdateSafetyPolicy     = "update_safety_policy"
+	EventTypeSafetyViolation        = "conversion_safety_violation"
+	EventTypeTombstoneTokenPair     = "tombstone_token_pair"
+	EventTypeRecoverEscrow          = "recover_token_pair_escrow"
+	EventTypeRegisterWrappedDenom   = "register_wrapped_evm_denom"
 
-	AttributeKeyCosmosCoin = "cosmos_coin"
-	AttributeKeyERC20Token = "erc20_token" // #nosec
-	AttributeKeyReceiver   = "receiver"
+	AttributeKeyCosmosCoin       = "cosmos_coin"
+	AttributeKeyERC20Token       = "erc20_token" // #nosec
+	AttributeKeyReceiver         = "receiver"
+	AttributeKeySrcPort          = "source_port"
+	AttributeKeySrcChannel       = "source_channel"
+	AttributeKeyRefunded         = "refunded"
+	AttributeKeyHolders          = "holders"
+	AttributeKeyBurned           = "burned"
+	AttributeKeyEscrowed         = "escrowed"
+	AttributeKeyMaxPerConversion = "max_per_conversion"
+	AttributeKeyInflowCap        = "inflow_cap"
+	AttributeKeyOutflowCap       = "outflow_cap"
+	AttributeKeyWindow           = "window"
+	AttributeKeyEpochIdentifier  = "epoch_identifier"
+	AttributeKeyBehaviour        = "behaviour"
+	AttributeKeyImplementation   = "implementation"
+	AttributeKeyRelayer          = "relayer"
+	AttributeKeyName             = "name"
+	AttributeKeySymbol           = "symbol"
+	AttributeKeyRegistrant       = "registrant"
+	AttributeKeyDeposit          = "deposit"
+	AttributeKeyActivationTime   = "activation_time"
+	AttributeKeyGuardian         = "guardian"
+	AttributeKeyReason           = "reason"
+	AttributeKeyExpiry           = "expiry"
+	AttributeKeyFee              = "fee"
+	AttributeKeyFeeRate          = "fee_rate"
+	AttributeKeyMinFee           = "min_fee"
+	AttributeKeyScalingExponent  = "scaling_exponent"
+	AttributeKeyMintCap          = "mint_cap"
+	AttributeKeyAllowedEvents    = "allowed_event_signatures"
+	AttributeKeyAllowedEmitters  = "allowed_emitters"
+	AttributeKeyMaxLogs          = "max_logs"
+	AttributeKeyRule             = "rule"
+	AttributeKeyEmitter          = "emitter"
+	AttributeKeyEventTopic       = "event_topic"
+	AttributeKeyRecipients       = "recipients"
+	AttributeKeyCommunityPool    = "community_pool"
 
 	ERC20EventTransfer = "Transfer"
 )
@@ -1,5 +1,7 @@
 package types
 
+import "math/big"
+
 // ERC20Data represents the ERC20 token details used to map
 // the token to a Cosmos Coin
 type ERC20Data struct {
@@ -13,6 +15,11 @@ type ERC20StringResponse struct {
 	Value string
 }
 
+// ERC20Bytes32Response defines the bytes32 value from the call response
+type ERC20Bytes32Response struct {
+	Value [32]byte
+}
+
 // ERC20Uint8Response defines the uint8 value from the call response
 type ERC20Uint8Response struct {
 	Value uint8
@@ -23,6 +30,11 @@ type ERC20BoolResponse struct {
 	Value bool
 }
 
+// ERC20Uint256Response defines the uint256 value from the call response
+type ERC20Uint256Response struct {
+	Value *big.Int
+}
+
 // NewERC20Data creates a new ERC20Data instance
 func NewERC20Data(name, symbol string, decimals uint8) ERC20Data {
 	return ERC20Data{
@@ -1,12 +1,35 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, pairs []TokenPair) GenesisState {
+func NewGenesisState(
+	params Params,
+	pairs []TokenPair,
+	rateLimits []RateLimit,
+	pendingRegistrations []PendingRegistration,
+	pauses []Pause,
+	conversionFees []ConversionFee,
+	tokenPairStats []TokenPairStats,
+	mintCaps []MintCap,
+	safetyPolicies []SafetyPolicy,
+	tombstones []TokenPairTombstone,
+) GenesisState {
 	return GenesisState{
-		Params:     params,
-		TokenPairs: pairs,
+		Params:               params,
+		TokenPairs:           pairs,
+		RateLimits:           rateLimits,
+		PendingRegistrations: pendingRegistrations,
+		Pauses:               pauses,
+		ConversionFees:       conversionFees,
+		TokenPairStats:       tokenPairStats,
+		MintCaps:             mintCaps,
+		SafetyPolicies:       safetyPolicies,
+		TokenPairTombstones:  tombstones,
 	}
 }
 
@@ -23,6 +46,8 @@ func DefaultGenesisState() *GenesisState {
 func (gs GenesisState) Validate() error {
 	seenErc20 := make(map[string]bool)
 	seenDenom := make(map[string]bool)
+	nativeERC20 := make(map[string]bool)
+	nativeCoin := make(map[string]bool)
 
 	for _, b := range gs.TokenPairs {
 		if seenErc20[b.Erc20Address] {
@@ -38,6 +63,222 @@ func (gs GenesisState) Validate() error {
 
 		seenErc20[b.Erc20Address] = true
 		seenDenom[b.Denom] = true
+		nativeERC20[b.Erc20Address] = b.IsNativeERC20()
+		nativeCoin[b.Erc20Address] = b.IsNativeCoin()
+	}
+
+	seenRateLimit := make(map[string]bool)
+
+	for _, rl := range gs.RateLimits {
+		if seenRateLimit[rl.Erc20Address] {
+			return fmt.Errorf("rate limit duplicated on genesis: '%s'", rl.Erc20Address)
+		}
+
+		if !seenErc20[rl.Erc20Address] {
+			return fmt.Errorf("rate limit for unregistered token pair on genesis: '%s'", rl.Erc20Address)
+		}
+
+		if err := rl.Validate(); err != nil {
+			return err
+		}
+
+		seenRateLimit[rl.Erc20Address] = true
+	}
+
+	seenVolume := make(map[string]bool)
+
+	for _, v := range gs.ConversionVolumes {
+		key := string(ConversionVolumeKey(v.GetERC20Contract(), v.Period))
+		if seenVolume[key] {
+			return fmt.Errorf("conversion volume duplicated on genesis: '%s' period %d", v.Erc20Address, v.Period)
+		}
+
+		if !seenRateLimit[v.Erc20Address] {
+			return fmt.Errorf("conversion volume for token pair without rate limit on genesis: '%s'", v.Erc20Address)
+		}
+
+		if err := v.Validate(); err != nil {
+			return err
+		}
+
+		seenVolume[key] = true
+	}
+
+	seenPending := make(map[string]bool)
+
+	for _, pr := range gs.PendingRegistrations {
+		if seenPending[pr.Erc20Address] {
+			return fmt.Errorf("pending registration duplicated on genesis: '%s'", pr.Erc20Address)
+		}
+
+		if !seenErc20[pr.Erc20Address] {
+			return fmt.Errorf("pending registration for unregistered token pair on genesis: '%s'", pr.Erc20Address)
+		}
+
+		if err := pr.Validate(); err != nil {
+			return err
+		}
+
+		seenPending[pr.Erc20Address] = true
+	}
+
+	seenPause := make(map[string]bool)
+
+	for _, p := range gs.Pauses {
+		if seenPause[p.Erc20Address] {
+			return fmt.Errorf("pause duplicated on genesis: '%s'", p.Erc20Address)
+		}
+
+		if !p.IsModulePause() && !seenErc20[p.Erc20Address] {
+			return fmt.Errorf("pause for unregistered token pair on genesis: '%s'", p.Erc20Address)
+		}
+
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		seenPause[p.Erc20Address] = true
+	}
+
+	seenConversionFee := make(map[string]bool)
+
+	for _, cf := range gs.ConversionFees {
+		if seenConversionFee[cf.Erc20Address] {
+			return fmt.Errorf("conversion fee duplicated on genesis: '%s'", cf.Erc20Address)
+		}
+
+		if !seenErc20[cf.Erc20Address] {
+			return fmt.Errorf("conversion fee for unregistered token pair on genesis: '%s'", cf.Erc20Address)
+		}
+
+		if err := cf.Validate(); err != nil {
+			return err
+		}
+
+		seenConversionFee[cf.Erc20Address] = true
+	}
+
+	seenStats := make(map[string]bool)
+
+	for _, s := range gs.TokenPairStats {
+		if seenStats[s.Erc20Address] {
+			return fmt.Errorf("token pair stats duplicated on genesis: '%s'", s.Erc20Address)
+		}
+
+		if !seenErc20[s.Erc20Address] {
+			return fmt.Errorf("token pair stats for unregistered token pair on genesis: '%s'", s.Erc20Address)
+		}
+
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		seenStats[s.Erc20Address] = true
+	}
+
+	seenMintCap := make(map[string]bool)
+
+	for _, mc := range gs.MintCaps {
+		if seenMintCap[mc.Erc20Address] {
+			return fmt.Errorf("mint cap duplicated on genesis: '%s'", mc.Erc20Address)
+		}
+
+		if !seenErc20[mc.Erc20Address] {
+			return fmt.Errorf("mint cap for unregistered token pair on genesis: '%s'", mc.Erc20Address)
+		}
+
+		if !nativeERC20[mc.Erc20Address] {
+			return fmt.Errorf("mint cap for token pair not owned by an external contract on genesis: '%s'", mc.Erc20Address)
+		}
+
+		if err := mc.Validate(); err != nil {
+			return err
+		}
+
+		seenMintCap[mc.Erc20Address] = true
+	}
+
+	seenSafetyPolicy := make(map[string]bool)
+
+	for _, sp := range gs.SafetyPolicies {
+		if seenSafetyPolicy[sp.Erc20Address] {
+			return fmt.Errorf("safety policy duplicated on genesis: '%s'", sp.Erc20Address)
+		}
+
+		if !seenErc20[sp.Erc20Address] {
+			return fmt.Errorf("safety policy for unregistered token pair on genesis: '%s'", sp.Erc20Address)
+		}
+
+		if err := sp.Validate(); err != nil {
+			return err
+		}
+
+		seenSafetyPolicy[sp.Erc20Address] = true
+	}
+
+	seenTombstone := make(map[string]bool)
+
+	for _, t := range gs.TokenPairTombstones {
+		if seenTombstone[t.Erc20Address] {
+			return fmt.Errorf("token pair tombstone duplicated on genesis: '%s'", t.Erc20Address)
+		}
+
+		if err := t.Validate(); err != nil {
+			return err
+		}
+
+		seenTombstone[t.Erc20Address] = true
+	}
+
+	seenDeregistered := make(map[string]bool)
+
+	for _, contract := range gs.DeregisteredErc20S {
+		if seenDeregistered[contract] {
+			return fmt.Errorf("deregistered ERC20 duplicated on genesis: '%s'", contract)
+		}
+
+		if err := ethermint.ValidateAddress(contract); err != nil {
+			return err
+		}
+
+		if seenErc20[contract] {
+			return fmt.Errorf("deregistered ERC20 of registered token pair on genesis: '%s'", contract)
+		}
+
+		seenDeregistered[contract] = true
+	}
+
+	seenTransfer := make(map[string]bool)
+
+	for _, t := range gs.Erc20Transfers {
+		key := string(ERC20TransferKey(t.PortId, t.ChannelId, t.Sequence))
+		if seenTransfer[key] {
+			return fmt.Errorf("ERC20 transfer duplicated on genesis: '%s/%s/%d'", t.PortId, t.ChannelId, t.Sequence)
+		}
+
+		if err := t.Validate(); err != nil {
+			return err
+		}
+
+		seenTransfer[key] = true
+	}
+
+	if gs.WrappedEvmDenomContract != "" {
+		if gs.RegisterWrappedEvmDenom {
+			return fmt.Errorf("wrapped EVM denom contract '%s' can't be set together with its registration on genesis", gs.WrappedEvmDenomContract)
+		}
+
+		if err := ethermint.ValidateAddress(gs.WrappedEvmDenomContract); err != nil {
+			return err
+		}
+
+		if !seenErc20[gs.WrappedEvmDenomContract] {
+			return fmt.Errorf("wrapped EVM denom contract of unregistered token pair on genesis: '%s'", gs.WrappedEvmDenomContract)
+		}
+
+		if !nativeCoin[gs.WrappedEvmDenomContract] {
+			return fmt.Errorf("wrapped EVM denom contract of token pair not owned by the module on genesis: '%s'", gs.WrappedEvmDenomContract)
+		}
 	}
 
 	return gs.Params.Validate()
@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +35,42 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// registered token pairs
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// conversion rate limits of the registered token pairs
+	RateLimits []RateLimit `protobuf:"bytes,3,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// name of the implementation of the ERC20 proxies deployed for the registered
+	// Cosmos coins. If empty, the default implementation is used.
+	Erc20Implementation string `protobuf:"bytes,4,opt,name=erc20_implementation,json=erc20Implementation,proto3" json:"erc20_implementation,omitempty"`
+	// token pairs registered through MsgRegisterERC20 within their challenge
+	// period
+	PendingRegistrations []PendingRegistration `protobuf:"bytes,5,rep,name=pending_registrations,json=pendingRegistrations,proto3" json:"pending_registrations"`
+	// active pauses of the module and of the token pairs
+	Pauses []Pause `protobuf:"bytes,6,rep,name=pauses,proto3" json:"pauses"`
+	// conversion fees of the token pairs overriding the global conversion fee
+	ConversionFees []ConversionFee `protobuf:"bytes,7,rep,name=conversion_fees,json=conversionFees,proto3" json:"conversion_fees"`
+	// cumulative conversion statistics of the token pairs
+	TokenPairStats []TokenPairStats `protobuf:"bytes,8,rep,name=token_pair_stats,json=tokenPairStats,proto3" json:"token_pair_stats"`
+	// mint caps of the token pairs owned by external ERC20 contracts
+	MintCaps []MintCap `protobuf:"bytes,9,rep,name=mint_caps,json=mintCaps,proto3" json:"mint_caps"`
+	// safety policies of the token pairs
+	SafetyPolicies []SafetyPolicy `protobuf:"bytes,10,rep,name=safety_policies,json=safetyPolicies,proto3" json:"safety_policies"`
+	// hex address of the ERC20 contract wrapping the EVM denomination. The
+	// contract must belong to a registered token pair owned by the module.
+	WrappedEvmDenomContract string `protobuf:"bytes,11,opt,name=wrapped_evm_denom_contract,json=wrappedEvmDenomContract,proto3" json:"wrapped_evm_denom_contract,omitempty"`
+	// deploy and register the wrapped EVM denomination token pair on genesis.
+	// It can't be set together with wrapped_evm_denom_contract.
+	RegisterWrappedEvmDenom bool `protobuf:"varint,12,opt,name=register_wrapped_evm_denom,json=registerWrappedEvmDenom,proto3" json:"register_wrapped_evm_denom,omitempty"`
+	// token pairs removed because their ERC20 contract no longer has code and
+	// deregistered native coin token pairs
+	TokenPairTombstones []TokenPairTombstone `protobuf:"bytes,13,rep,name=token_pair_tombstones,json=tokenPairTombstones,proto3" json:"token_pair_tombstones"`
+	// hex addresses of the ERC20 contracts of the vetoed or deregistered native
+	// ERC20 token pairs, whose coin metadata is overwritten on registration
+	DeregisteredErc20S []string `protobuf:"bytes,14,rep,name=deregistered_erc20s,json=deregisteredErc20s,proto3" json:"deregistered_erc20s,omitempty"`
+	// ERC20 tokens sent over IBC through MsgTransferERC20 whose packet is not
+	// acknowledged or timed out yet
+	Erc20Transfers []ERC20Transfer `protobuf:"bytes,15,rep,name=erc20_transfers,json=erc20Transfers,proto3" json:"erc20_transfers"`
+	// amounts converted for the rate limited token pairs within the current
+	// window of their rate limit
+	ConversionVolumes []PeriodConversionVolume `protobuf:"bytes,16,rep,name=conversion_volumes,json=conversionVolumes,proto3" json:"conversion_volumes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +120,104 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetErc20Implementation() string {
+	if m != nil {
+		return m.Erc20Implementation
+	}
+	return ""
+}
+
+func (m *GenesisState) GetPendingRegistrations() []PendingRegistration {
+	if m != nil {
+		return m.PendingRegistrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPauses() []Pause {
+	if m != nil {
+		return m.Pauses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConversionFees() []ConversionFee {
+	if m != nil {
+		return m.ConversionFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenPairStats() []TokenPairStats {
+	if m != nil {
+		return m.TokenPairStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintCaps() []MintCap {
+	if m != nil {
+		return m.MintCaps
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSafetyPolicies() []SafetyPolicy {
+	if m != nil {
+		return m.SafetyPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWrappedEvmDenomContract() string {
+	if m != nil {
+		return m.WrappedEvmDenomContract
+	}
+	return ""
+}
+
+func (m *GenesisState) GetRegisterWrappedEvmDenom() bool {
+	if m != nil {
+		return m.RegisterWrappedEvmDenom
+	}
+	return false
+}
+
+func (m *GenesisState) GetTokenPairTombstones() []TokenPairTombstone {
+	if m != nil {
+		return m.TokenPairTombstones
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeregisteredErc20S() []string {
+	if m != nil {
+		return m.DeregisteredErc20S
+	}
+	return nil
+}
+
+func (m *GenesisState) GetErc20Transfers() []ERC20Transfer {
+	if m != nil {
+		return m.Erc20Transfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConversionVolumes() []PeriodConversionVolume {
+	if m != nil {
+		return m.ConversionVolumes
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// parameter to enable the intrarelaying of Cosmos coins <--> ERC20 tokens.
@@ -86,6 +226,30 @@ type Params struct {
 	// Coin by transferring the Tokens through a MsgEthereumTx to the
 	// ModuleAddress Ethereum address.
 	EnableEVMHook bool `protobuf:"varint,2,opt,name=enable_evm_hook,json=enableEvmHook,proto3" json:"enable_evm_hook,omitempty"`
+	// parameter to enable the automatic conversion of received ICS20 vouchers
+	// into their registered ERC20 token representation.
+	EnableIBCConversion bool `protobuf:"varint,3,opt,name=enable_ibc_conversion,json=enableIbcConversion,proto3" json:"enable_ibc_conversion,omitempty"`
+	// deposit locked by the accounts registering an ERC20 token through
+	// MsgRegisterERC20. It is refunded when the token pair is activated and
+	// burned if governance vetoes the registration.
+	RegistrationDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=registration_deposit,json=registrationDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_deposit"`
+	// period during which governance can veto a token pair registered through
+	// MsgRegisterERC20 before it is activated
+	RegistrationChallengePeriod time.Duration `protobuf:"bytes,5,opt,name=registration_challenge_period,json=registrationChallengePeriod,proto3,stdduration" json:"registration_challenge_period"`
+	// bech32 address of the guardian allowed to pause the conversions of a token
+	// pair or of the whole module. If empty, emergency pauses are disabled.
+	Guardian string `protobuf:"bytes,6,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// maximum duration of a pause set by the guardian
+	MaxPauseDuration time.Duration `protobuf:"bytes,7,opt,name=max_pause_duration,json=maxPauseDuration,proto3,stdduration" json:"max_pause_duration"`
+	// rate of the converted amount charged as fee on the conversions of the token
+	// pairs without a conversion fee
+	ConversionFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=conversion_fee_rate,json=conversionFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"conversion_fee_rate"`
+	// minimum fees charged on the conversions of the token pairs without a
+	// conversion fee, by denomination of the Cosmos coin of the pair. The pairs
+	// whose denomination has no minimum fee are only charged the fee rate.
+	MinConversionFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=min_conversion_fees,json=minConversionFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_conversion_fees"`
+	// destination of the conversion fees
+	ConversionFeeDestination FeeDestination `protobuf:"varint,10,opt,name=conversion_fee_destination,json=conversionFeeDestination,proto3,enum=evmos.erc20.v1.FeeDestination" json:"conversion_fee_destination,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -135,6 +299,55 @@ func (m *Params) GetEnableEVMHook() bool {
 	return false
 }
 
+func (m *Params) GetEnableIBCConversion() bool {
+	if m != nil {
+		return m.EnableIBCConversion
+	}
+	return false
+}
+
+func (m *Params) GetRegistrationDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetRegistrationChallengePeriod() time.Duration {
+	if m != nil {
+		return m.RegistrationChallengePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *Params) GetMaxPauseDuration() time.Duration {
+	if m != nil {
+		return m.MaxPauseDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMinConversionFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinConversionFees
+	}
+	return nil
+}
+
+func (m *Params) GetConversionFeeDestination() FeeDestination {
+	if m != nil {
+		return m.ConversionFeeDestination
+	}
+	return FEE_DESTINATION_COMMUNITY_POOL
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
@@ -143,26 +356,70 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x03, 0xcb, 0xea, 0x81, 0x65, 0xf5,
-	0xca, 0x0c, 0xa5, 0xa4, 0xd0, 0x54, 0x43, 0x24, 0xc0, 0x6a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xd4, 0xc6, 0xc8, 0xc5, 0xe3, 0x0e, 0x31, 0x33, 0xb8,
-	0x24, 0xb1, 0x24, 0x55, 0xc8, 0x84, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51,
-	0x81, 0x51, 0x83, 0xdb, 0x48, 0x4c, 0x0f, 0xd5, 0x0e, 0xbd, 0x00, 0xb0, 0xac, 0x13, 0xcb, 0x89,
-	0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xb5, 0x42, 0x0e, 0x5c, 0xdc, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0xf1,
-	0x05, 0x89, 0x99, 0x45, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x92, 0xe8, 0x5a, 0x43,
-	0x40, 0x4a, 0x02, 0x12, 0x33, 0x8b, 0xa0, 0xba, 0xb9, 0x4a, 0x60, 0x02, 0xc5, 0x4a, 0x69, 0x5c,
-	0x6c, 0x10, 0x93, 0x85, 0x14, 0xb9, 0x78, 0x52, 0xf3, 0x12, 0x93, 0x72, 0x52, 0xe3, 0xc1, 0x1a,
-	0xc1, 0xee, 0xe0, 0x08, 0xe2, 0x86, 0x88, 0xb9, 0x82, 0x84, 0x84, 0x2c, 0xb9, 0xf8, 0x61, 0x4a,
-	0xca, 0x72, 0xe3, 0x33, 0xf2, 0xf3, 0xb3, 0x25, 0x98, 0x40, 0xaa, 0x9c, 0x04, 0x1f, 0xdd, 0x93,
-	0xe7, 0x75, 0x85, 0xa8, 0x0c, 0xf3, 0xf5, 0xc8, 0xcf, 0xcf, 0x0e, 0xe2, 0x85, 0x6a, 0x2c, 0xcb,
-	0x05, 0x71, 0x9d, 0x1c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x2d,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0x24, 0x23, 0xb1, 0xa8, 0x38,
-	0xb3, 0x58, 0x1f, 0x12, 0x9e, 0x15, 0xd0, 0x10, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03,
-	0x87, 0x9c, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xf8, 0xb4, 0xba, 0x9b, 0x01, 0x00, 0x00,
+	// 1000 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
+	0x14, 0xb5, 0x62, 0x47, 0x91, 0xc7, 0xef, 0x91, 0x5d, 0x4f, 0xd4, 0x44, 0x52, 0x5d, 0x20, 0xd0,
+	0xa6, 0xa4, 0xed, 0x74, 0xd3, 0x76, 0x13, 0x48, 0xb6, 0x5b, 0x23, 0x49, 0xe1, 0x32, 0x46, 0x0a,
+	0xb4, 0x41, 0x88, 0x11, 0x75, 0x4d, 0x0f, 0x2c, 0xce, 0x10, 0xbc, 0x23, 0xd5, 0x46, 0x81, 0x7e,
+	0x43, 0x97, 0xfd, 0x86, 0x7e, 0x48, 0x91, 0x65, 0x96, 0x45, 0x17, 0x4e, 0x21, 0x2f, 0xfb, 0x13,
+	0xc5, 0x0c, 0xc9, 0x88, 0x92, 0x12, 0x20, 0x8b, 0xac, 0x24, 0xde, 0x73, 0xee, 0x99, 0xfb, 0x18,
+	0x1d, 0x91, 0xdc, 0x83, 0x61, 0xa4, 0xd0, 0x85, 0x24, 0xd8, 0xdf, 0x75, 0x87, 0x7b, 0x6e, 0x08,
+	0x12, 0x50, 0xa0, 0x13, 0x27, 0x4a, 0x2b, 0xba, 0x6a, 0x51, 0xc7, 0xa2, 0xce, 0x70, 0xaf, 0x56,
+	0x9b, 0x62, 0xa7, 0x80, 0xe5, 0xd6, 0x36, 0x43, 0x15, 0x2a, 0xfb, 0xd5, 0x35, 0xdf, 0xb2, 0x68,
+	0x3d, 0x50, 0x68, 0x52, 0xba, 0x1c, 0xc1, 0x1d, 0xee, 0x75, 0x41, 0xf3, 0x3d, 0x37, 0x50, 0x42,
+	0xe6, 0x78, 0xa8, 0x54, 0xd8, 0x07, 0xd7, 0x3e, 0x75, 0x07, 0x67, 0x6e, 0x6f, 0x90, 0x70, 0x2d,
+	0x54, 0x86, 0xef, 0xfc, 0x57, 0x21, 0xcb, 0xdf, 0xa6, 0x35, 0x3d, 0xd3, 0x5c, 0x03, 0xfd, 0x92,
+	0x94, 0x63, 0x9e, 0xf0, 0x08, 0x59, 0xa9, 0x59, 0x6a, 0x2d, 0xed, 0x7f, 0xe2, 0x4c, 0xd6, 0xe8,
+	0x9c, 0x58, 0xb4, 0xbd, 0xf0, 0xea, 0xba, 0x31, 0xe7, 0x65, 0x5c, 0xfa, 0x88, 0x2c, 0x69, 0x75,
+	0x01, 0xd2, 0x8f, 0xb9, 0x48, 0x90, 0xdd, 0x6a, 0xce, 0xb7, 0x96, 0xf6, 0xef, 0x4e, 0xa7, 0x9e,
+	0x1a, 0xca, 0x09, 0x17, 0x49, 0x96, 0x4d, 0x74, 0x1e, 0xb0, 0x0a, 0x09, 0xd7, 0xe0, 0xf7, 0x45,
+	0x24, 0x34, 0xb2, 0xf9, 0x77, 0x2b, 0x78, 0x5c, 0xc3, 0x13, 0xc3, 0xc8, 0x15, 0x92, 0x3c, 0x80,
+	0x74, 0x8f, 0x6c, 0x5a, 0x9e, 0x2f, 0xa2, 0xb8, 0x0f, 0x11, 0x48, 0x6d, 0x1b, 0x65, 0x0b, 0xcd,
+	0x52, 0x6b, 0xd1, 0xab, 0x5a, 0xec, 0x78, 0x02, 0xa2, 0x2f, 0xc9, 0x56, 0x0c, 0xb2, 0x27, 0x64,
+	0xe8, 0x27, 0x10, 0x0a, 0xd4, 0xe9, 0x6c, 0x90, 0xdd, 0xb6, 0xc7, 0x7f, 0x3e, 0xd3, 0x7b, 0x4a,
+	0xf6, 0x0a, 0xdc, 0xac, 0x90, 0xcd, 0x78, 0x16, 0x42, 0xfa, 0xd0, 0x0c, 0x73, 0x80, 0x80, 0xac,
+	0x6c, 0x05, 0xb7, 0x66, 0x87, 0x39, 0x40, 0x18, 0xcf, 0xd2, 0x50, 0xe9, 0x13, 0xb2, 0x16, 0x28,
+	0x39, 0x84, 0x04, 0x85, 0x92, 0xfe, 0x19, 0x00, 0xb2, 0x3b, 0x36, 0xfb, 0xfe, 0x74, 0x76, 0xe7,
+	0x2d, 0xed, 0x08, 0x72, 0x95, 0xd5, 0xa0, 0x18, 0x44, 0xfa, 0x3d, 0x59, 0x1f, 0x6f, 0xc6, 0x47,
+	0xcd, 0x35, 0xb2, 0x8a, 0x95, 0xab, 0xbf, 0x77, 0x3d, 0xe6, 0x26, 0xe4, 0x1b, 0x5e, 0xd5, 0x13,
+	0x51, 0xfa, 0x35, 0x59, 0x8c, 0x84, 0xd4, 0x7e, 0xc0, 0x63, 0x64, 0x8b, 0x56, 0x68, 0x7b, 0x5a,
+	0xe8, 0xa9, 0x90, 0xba, 0xc3, 0xe3, 0x4c, 0xa1, 0x12, 0xa5, 0x8f, 0x48, 0x1f, 0x93, 0x35, 0xe4,
+	0x67, 0xa0, 0xaf, 0xfc, 0x58, 0xf5, 0x45, 0x20, 0x00, 0x19, 0xb1, 0x0a, 0xf7, 0xa6, 0x15, 0x9e,
+	0x59, 0xda, 0x89, 0x61, 0x5d, 0xe5, 0x85, 0xe0, 0x38, 0x26, 0x00, 0xe9, 0x37, 0xa4, 0xf6, 0x4b,
+	0xc2, 0xe3, 0x18, 0x7a, 0x3e, 0x0c, 0x23, 0xbf, 0x07, 0x52, 0x45, 0x7e, 0xa0, 0xa4, 0x4e, 0x78,
+	0xa0, 0xd9, 0x92, 0x5d, 0xfa, 0x76, 0xc6, 0x38, 0x1c, 0x46, 0x07, 0x06, 0xef, 0x64, 0xb0, 0x49,
+	0x4e, 0x17, 0x0e, 0x89, 0x3f, 0xa3, 0xc2, 0x96, 0x9b, 0xa5, 0x56, 0xc5, 0xdb, 0xce, 0x19, 0x3f,
+	0x4e, 0x8a, 0xd0, 0x17, 0x64, 0xab, 0x30, 0x52, 0xad, 0xa2, 0x2e, 0x6a, 0x25, 0x01, 0xd9, 0x8a,
+	0x6d, 0x66, 0xe7, 0xbd, 0x73, 0x3d, 0xcd, 0xa9, 0x59, 0x4b, 0x55, 0x3d, 0x83, 0x20, 0x75, 0x49,
+	0xb5, 0x07, 0xf9, 0xd1, 0xa6, 0x2c, 0x23, 0x83, 0x6c, 0xb5, 0x39, 0xdf, 0x5a, 0xf4, 0x68, 0x11,
+	0x3a, 0xb4, 0x88, 0xb9, 0x2f, 0xe9, 0xbd, 0xd7, 0x09, 0x97, 0x78, 0x06, 0x09, 0xb2, 0xb5, 0x77,
+	0xdf, 0x97, 0x43, 0xaf, 0xb3, 0xbf, 0x7b, 0x9a, 0xb1, 0xf2, 0xb1, 0x5a, 0x34, 0x0f, 0x22, 0xfd,
+	0x99, 0xd0, 0xc2, 0xed, 0x1b, 0xaa, 0xfe, 0x20, 0x02, 0x64, 0xeb, 0x56, 0xf0, 0xc1, 0xec, 0xef,
+	0x21, 0x11, 0xaa, 0x37, 0xbe, 0x86, 0xcf, 0x2d, 0x3d, 0x53, 0xde, 0x08, 0xa6, 0xe2, 0xb8, 0xf3,
+	0x57, 0x99, 0x94, 0x53, 0xff, 0xa0, 0x9f, 0x91, 0x65, 0x90, 0xbc, 0xdb, 0x87, 0xb4, 0x41, 0xeb,
+	0x36, 0x15, 0x6f, 0x29, 0x8d, 0xd9, 0xce, 0xe8, 0x57, 0x64, 0x2d, 0xa7, 0x0c, 0x23, 0xff, 0x5c,
+	0xa9, 0x0b, 0x76, 0xcb, 0xb0, 0xda, 0x1b, 0xa3, 0xeb, 0xc6, 0xca, 0x61, 0xca, 0x7c, 0xfe, 0xf4,
+	0x3b, 0xa5, 0x2e, 0xbc, 0x95, 0x2c, 0x71, 0x18, 0x99, 0x47, 0xfa, 0x98, 0x6c, 0x65, 0xa9, 0xa2,
+	0x1b, 0xf8, 0xe3, 0x42, 0xd8, 0xbc, 0x15, 0xd8, 0x1e, 0x5d, 0x37, 0xaa, 0xa9, 0xc0, 0x71, 0xbb,
+	0x33, 0xae, 0xdf, 0xab, 0xa6, 0x59, 0xc7, 0xdd, 0x60, 0x1c, 0xa4, 0xbf, 0x91, 0xcd, 0xa2, 0x3b,
+	0xf8, 0x3d, 0x88, 0x15, 0x0a, 0xcd, 0x16, 0x32, 0x8f, 0x4a, 0x2d, 0xd8, 0x31, 0x16, 0xec, 0x64,
+	0x16, 0xec, 0x74, 0x94, 0x90, 0xed, 0x5d, 0x33, 0x87, 0x3f, 0xdf, 0x34, 0x5a, 0xa1, 0xd0, 0xe7,
+	0x83, 0xae, 0x13, 0xa8, 0xc8, 0xcd, 0xfc, 0x3a, 0xfd, 0xf8, 0x02, 0x7b, 0x17, 0xae, 0xbe, 0x8a,
+	0x01, 0x6d, 0x02, 0x7a, 0xd5, 0xe2, 0x41, 0x07, 0xe9, 0x39, 0x34, 0x24, 0xf7, 0x27, 0xce, 0x0f,
+	0xce, 0x79, 0xbf, 0x0f, 0x32, 0x04, 0x3f, 0xb6, 0xf3, 0x67, 0xb7, 0xad, 0x53, 0xdf, 0x75, 0x52,
+	0xaf, 0x77, 0x72, 0xaf, 0x77, 0x0e, 0x32, 0xaf, 0x6f, 0x57, 0x4c, 0x21, 0x7f, 0xbc, 0x69, 0x94,
+	0xbc, 0x4f, 0x8b, 0x4a, 0x9d, 0x5c, 0x28, 0xdd, 0x23, 0xad, 0x91, 0x4a, 0x38, 0xe0, 0x49, 0x4f,
+	0x70, 0xc9, 0xca, 0xf6, 0x07, 0xf4, 0xf6, 0x99, 0xfe, 0x40, 0x68, 0xc4, 0x2f, 0x7d, 0xeb, 0x51,
+	0x7e, 0xfe, 0x27, 0xc2, 0xee, 0x7c, 0xf8, 0xc9, 0xeb, 0x11, 0xbf, 0xb4, 0x76, 0x97, 0x63, 0xf4,
+	0x25, 0xa9, 0x4e, 0x1a, 0x9d, 0x6f, 0xdc, 0x9c, 0x55, 0xcc, 0xc9, 0x6d, 0xc7, 0x24, 0xfe, 0x73,
+	0xdd, 0x78, 0xf0, 0x01, 0xb3, 0x3b, 0x80, 0xa0, 0x78, 0xdb, 0x8e, 0x00, 0xcc, 0xff, 0x04, 0xfd,
+	0x95, 0x54, 0x23, 0x21, 0xfd, 0x69, 0x33, 0x5d, 0xfc, 0xf8, 0x6b, 0xdb, 0x88, 0x84, 0xec, 0x4c,
+	0xfa, 0xee, 0x0b, 0x52, 0x9b, 0x6a, 0xae, 0x07, 0xa8, 0x85, 0x4c, 0xe7, 0x46, 0x9a, 0xa5, 0xd6,
+	0xea, 0xac, 0x03, 0x1f, 0x01, 0x1c, 0x8c, 0x59, 0x1e, 0x9b, 0xe8, 0xa9, 0x80, 0xb4, 0x1f, 0xbd,
+	0x1a, 0xd5, 0x4b, 0xaf, 0x47, 0xf5, 0xd2, 0xbf, 0xa3, 0x7a, 0xe9, 0xf7, 0x9b, 0xfa, 0xdc, 0xeb,
+	0x9b, 0xfa, 0xdc, 0xdf, 0x37, 0xf5, 0xb9, 0x9f, 0x8a, 0xf3, 0xd2, 0xe7, 0x3c, 0x41, 0x81, 0x6e,
+	0xfa, 0x56, 0x71, 0x99, 0xbd, 0x57, 0xd8, 0xc2, 0xbb, 0x65, 0xbb, 0xab, 0x87, 0xff, 0x0f, 0x00,
+	0x78, 0x7d, 0x04, 0x07, 0xa1, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -185,6 +442,181 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConversionVolumes) > 0 {
+		for iNdEx := len(m.ConversionVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Erc20Transfers) > 0 {
+		for iNdEx := len(m.Erc20Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.DeregisteredErc20S) > 0 {
+		for iNdEx := len(m.DeregisteredErc20S) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeregisteredErc20S[iNdEx])
+			copy(dAtA[i:], m.DeregisteredErc20S[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeregisteredErc20S[iNdEx])))
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.TokenPairTombstones) > 0 {
+		for iNdEx := len(m.TokenPairTombstones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenPairTombstones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.RegisterWrappedEvmDenom {
+		i--
+		if m.RegisterWrappedEvmDenom {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.WrappedEvmDenomContract) > 0 {
+		i -= len(m.WrappedEvmDenomContract)
+		copy(dAtA[i:], m.WrappedEvmDenomContract)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WrappedEvmDenomContract)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.SafetyPolicies) > 0 {
+		for iNdEx := len(m.SafetyPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SafetyPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.MintCaps) > 0 {
+		for iNdEx := len(m.MintCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.TokenPairStats) > 0 {
+		for iNdEx := len(m.TokenPairStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenPairStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ConversionFees) > 0 {
+		for iNdEx := len(m.ConversionFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Pauses) > 0 {
+		for iNdEx := len(m.Pauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PendingRegistrations) > 0 {
+		for iNdEx := len(m.PendingRegistrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRegistrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Erc20Implementation) > 0 {
+		i -= len(m.Erc20Implementation)
+		copy(dAtA[i:], m.Erc20Implementation)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Erc20Implementation)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -232,6 +664,82 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ConversionFeeDestination != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ConversionFeeDestination))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.MinConversionFees) > 0 {
+		for iNdEx := len(m.MinConversionFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinConversionFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.ConversionFeeRate.Size()
+		i -= size
+		if _, err := m.ConversionFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxPauseDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPauseDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RegistrationChallengePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RegistrationChallengePeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.RegistrationDeposit) > 0 {
+		for iNdEx := len(m.RegistrationDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EnableIBCConversion {
+		i--
+		if m.EnableIBCConversion {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.EnableEVMHook {
 		i--
 		if m.EnableEVMHook {
@@ -280,6 +788,83 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.Erc20Implementation)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PendingRegistrations) > 0 {
+		for _, e := range m.PendingRegistrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Pauses) > 0 {
+		for _, e := range m.Pauses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConversionFees) > 0 {
+		for _, e := range m.ConversionFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenPairStats) > 0 {
+		for _, e := range m.TokenPairStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintCaps) > 0 {
+		for _, e := range m.MintCaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SafetyPolicies) > 0 {
+		for _, e := range m.SafetyPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.WrappedEvmDenomContract)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RegisterWrappedEvmDenom {
+		n += 2
+	}
+	if len(m.TokenPairTombstones) > 0 {
+		for _, e := range m.TokenPairTombstones {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeregisteredErc20S) > 0 {
+		for _, s := range m.DeregisteredErc20S {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20Transfers) > 0 {
+		for _, e := range m.Erc20Transfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConversionVolumes) > 0 {
+		for _, e := range m.ConversionVolumes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -295,6 +880,34 @@ func (m *Params) Size() (n int) {
 	if m.EnableEVMHook {
 		n += 2
 	}
+	if m.EnableIBCConversion {
+		n += 2
+	}
+	if len(m.RegistrationDeposit) > 0 {
+		for _, e := range m.RegistrationDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RegistrationChallengePeriod)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPauseDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ConversionFeeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MinConversionFees) > 0 {
+		for _, e := range m.MinConversionFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ConversionFeeDestination != 0 {
+		n += 1 + sovGenesis(uint64(m.ConversionFeeDestination))
+	}
 	return n
 }
 
@@ -400,61 +1013,111 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Implementation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.Erc20Implementation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRegistrations", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingRegistrations = append(m.PendingRegistrations, PendingRegistration{})
+			if err := m.PendingRegistrations[len(m.PendingRegistrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableErc20", wireType)
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pauses", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -464,17 +1127,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableErc20 = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableEVMHook", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pauses = append(m.Pauses, Pause{})
+			if err := m.Pauses[len(m.Pauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFees", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -484,12 +1161,643 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionFees = append(m.ConversionFees, ConversionFee{})
+			if err := m.ConversionFees[len(m.ConversionFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairStats = append(m.TokenPairStats, TokenPairStats{})
+			if err := m.TokenPairStats[len(m.TokenPairStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintCaps = append(m.MintCaps, MintCap{})
+			if err := m.MintCaps[len(m.MintCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SafetyPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SafetyPolicies = append(m.SafetyPolicies, SafetyPolicy{})
+			if err := m.SafetyPolicies[len(m.SafetyPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrappedEvmDenomContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WrappedEvmDenomContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterWrappedEvmDenom", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RegisterWrappedEvmDenom = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairTombstones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairTombstones = append(m.TokenPairTombstones, TokenPairTombstone{})
+			if err := m.TokenPairTombstones[len(m.TokenPairTombstones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeregisteredErc20S", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeregisteredErc20S = append(m.DeregisteredErc20S, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Transfers = append(m.Erc20Transfers, ERC20Transfer{})
+			if err := m.Erc20Transfers[len(m.Erc20Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionVolumes = append(m.ConversionVolumes, PeriodConversionVolume{})
+			if err := m.ConversionVolumes[len(m.ConversionVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableErc20", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableErc20 = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableEVMHook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableEVMHook = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableIBCConversion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableIBCConversion = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationDeposit = append(m.RegistrationDeposit, types.Coin{})
+			if err := m.RegistrationDeposit[len(m.RegistrationDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationChallengePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RegistrationChallengePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPauseDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxPauseDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConversionFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinConversionFees = append(m.MinConversionFees, types.Coin{})
+			if err := m.MinConversionFees[len(m.MinConversionFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFeeDestination", wireType)
+			}
+			m.ConversionFeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConversionFeeDestination |= FeeDestination(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableEVMHook = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,8 +2,12 @@ package types
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/tharsis/ethermint/tests"
 )
 
 type GenesisTestSuite struct {
@@ -18,7 +22,8 @@ func TestGenesisTestSuite(t *testing.T) {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
-	newGen := NewGenesisState(DefaultParams(), []TokenPair{})
+	guardian := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	newGen := NewGenesisState(DefaultParams(), []TokenPair{}, []RateLimit{}, []PendingRegistration{}, []Pause{}, []ConversionFee{}, []TokenPairStats{}, []MintCap{}, []SafetyPolicy{}, []TokenPairTombstone{})
 
 	testCases := []struct {
 		name     string
@@ -128,6 +133,748 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with rate limits",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				RateLimits: []RateLimit{
+					{
+						Erc20Address:     "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						MaxPerConversion: sdk.NewInt(100),
+						InflowCap:        sdk.NewInt(1000),
+						OutflowCap:       sdk.ZeroInt(),
+						Window:           10,
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated rate limit",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				RateLimits: []RateLimit{
+					{
+						Erc20Address:     "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						MaxPerConversion: sdk.NewInt(100),
+						InflowCap:        sdk.ZeroInt(),
+						OutflowCap:       sdk.ZeroInt(),
+					},
+					{
+						Erc20Address:     "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						MaxPerConversion: sdk.NewInt(200),
+						InflowCap:        sdk.ZeroInt(),
+						OutflowCap:       sdk.ZeroInt(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - rate limit for unregistered token pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				RateLimits: []RateLimit{
+					{
+						Erc20Address:     "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						MaxPerConversion: sdk.NewInt(100),
+						InflowCap:        sdk.ZeroInt(),
+						OutflowCap:       sdk.ZeroInt(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid rate limit",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				RateLimits: []RateLimit{
+					{
+						Erc20Address:     "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						MaxPerConversion: sdk.ZeroInt(),
+						InflowCap:        sdk.NewInt(1000),
+						OutflowCap:       sdk.ZeroInt(),
+						Window:           0,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with pending registrations",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "erc20/0xdac17f958d2ee523a2206206994597c13d831ec7",
+						ContractOwner: OWNER_EXTERNAL,
+					},
+				},
+				PendingRegistrations: []PendingRegistration{
+					{
+						Erc20Address:   "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Registrant:     sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+						Deposit:        DefaultRegistrationDeposit,
+						ActivationTime: time.Now().UTC(),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated pending registration",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "erc20/0xdac17f958d2ee523a2206206994597c13d831ec7",
+						ContractOwner: OWNER_EXTERNAL,
+					},
+				},
+				PendingRegistrations: []PendingRegistration{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Registrant:   sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+						Deposit:      DefaultRegistrationDeposit,
+					},
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Registrant:   sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+						Deposit:      DefaultRegistrationDeposit,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - pending registration for unregistered token pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				PendingRegistrations: []PendingRegistration{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Registrant:   sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+						Deposit:      DefaultRegistrationDeposit,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid pending registration",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "erc20/0xdac17f958d2ee523a2206206994597c13d831ec7",
+						ContractOwner: OWNER_EXTERNAL,
+					},
+				},
+				PendingRegistrations: []PendingRegistration{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Registrant:   "invalid",
+						Deposit:      DefaultRegistrationDeposit,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with pauses",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				Pauses: []Pause{
+					{Reason: "incident", Guardian: guardian, Expiry: time.Now().UTC()},
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Reason: "incident", Guardian: guardian, Expiry: time.Now().UTC()},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated module pause",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Pauses: []Pause{
+					{Reason: "incident", Guardian: guardian, Expiry: time.Now().UTC()},
+					{Reason: "incident", Guardian: guardian, Expiry: time.Now().UTC()},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated pair pause",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				Pauses: []Pause{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Reason: "incident", Guardian: guardian, Expiry: time.Now().UTC()},
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Reason: "incident", Guardian: guardian, Expiry: time.Now().UTC()},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - pause for unregistered token pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Pauses: []Pause{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Reason: "incident", Guardian: guardian, Expiry: time.Now().UTC()},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid pause",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				Pauses: []Pause{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Reason: "", Guardian: guardian, Expiry: time.Now().UTC()},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with conversion fees",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ConversionFees: []ConversionFee{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Rate: sdk.NewDecWithPrec(1, 3), MinFee: sdk.NewInt(100)},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated conversion fee",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ConversionFees: []ConversionFee{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Rate: sdk.NewDecWithPrec(1, 3), MinFee: sdk.NewInt(100)},
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Rate: sdk.NewDecWithPrec(1, 3), MinFee: sdk.NewInt(100)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - conversion fee for unregistered token pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ConversionFees: []ConversionFee{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Rate: sdk.NewDecWithPrec(1, 3), MinFee: sdk.NewInt(100)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid conversion fee",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				ConversionFees: []ConversionFee{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Rate: sdk.OneDec(), MinFee: sdk.NewInt(100)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with mint caps",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "usdt",
+						Enabled:       true,
+						ContractOwner: OWNER_EXTERNAL,
+					},
+				},
+				MintCaps: []MintCap{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Cap: sdk.NewInt(1000)},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated mint cap",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "usdt",
+						Enabled:       true,
+						ContractOwner: OWNER_EXTERNAL,
+					},
+				},
+				MintCaps: []MintCap{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Cap: sdk.NewInt(1000)},
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Cap: sdk.NewInt(1000)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - mint cap for unregistered token pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				MintCaps: []MintCap{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Cap: sdk.NewInt(1000)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - mint cap for token pair owned by the module",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "usdt",
+						Enabled:       true,
+						ContractOwner: OWNER_MODULE,
+					},
+				},
+				MintCaps: []MintCap{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Cap: sdk.NewInt(1000)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - zero mint cap",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "usdt",
+						Enabled:       true,
+						ContractOwner: OWNER_EXTERNAL,
+					},
+				},
+				MintCaps: []MintCap{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Cap: sdk.NewInt(0)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with safety policies",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				SafetyPolicies: []SafetyPolicy{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", AllowedEventSignatures: []string{"Transfer(address,address,uint256)"}, MaxLogs: 2},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated safety policy",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				SafetyPolicies: []SafetyPolicy{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7"},
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - safety policy for unregistered token pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				SafetyPolicies: []SafetyPolicy{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid safety policy",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				SafetyPolicies: []SafetyPolicy{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", AllowedEmitters: []string{"0x123"}},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with wrapped evm denom contract",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "aevmos",
+						Enabled:       true,
+						ContractOwner: OWNER_MODULE,
+					},
+				},
+				WrappedEvmDenomContract: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+			},
+			expPass: true,
+		},
+		{
+			name: "valid genesis - with wrapped evm denom registration",
+			genState: &GenesisState{
+				Params:                  DefaultParams(),
+				RegisterWrappedEvmDenom: true,
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - wrapped evm denom contract and registration",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "aevmos",
+						Enabled:       true,
+						ContractOwner: OWNER_MODULE,
+					},
+				},
+				WrappedEvmDenomContract: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+				RegisterWrappedEvmDenom: true,
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid wrapped evm denom contract",
+			genState: &GenesisState{
+				Params:                  DefaultParams(),
+				WrappedEvmDenomContract: "0x123",
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - wrapped evm denom contract of unregistered token pair",
+			genState: &GenesisState{
+				Params:                  DefaultParams(),
+				WrappedEvmDenomContract: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - wrapped evm denom contract of token pair not owned by the module",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "usdt",
+						Enabled:       true,
+						ContractOwner: OWNER_EXTERNAL,
+					},
+				},
+				WrappedEvmDenomContract: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with token pair stats",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				TokenPairStats: []TokenPairStats{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", TotalInflow: sdk.NewInt(100), TotalOutflow: sdk.NewInt(50), InflowConversions: 2, OutflowConversions: 1, LastConversionHeight: 10, EvmHookVolume: sdk.NewInt(50), MsgVolume: sdk.NewInt(100)},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated token pair stats",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				TokenPairStats: []TokenPairStats{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", TotalInflow: sdk.NewInt(100), TotalOutflow: sdk.NewInt(50), InflowConversions: 2, OutflowConversions: 1, LastConversionHeight: 10, EvmHookVolume: sdk.NewInt(50), MsgVolume: sdk.NewInt(100)},
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", TotalInflow: sdk.NewInt(100), TotalOutflow: sdk.NewInt(50), InflowConversions: 2, OutflowConversions: 1, LastConversionHeight: 10, EvmHookVolume: sdk.NewInt(50), MsgVolume: sdk.NewInt(100)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - token pair stats for unregistered token pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairStats: []TokenPairStats{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", TotalInflow: sdk.NewInt(100), TotalOutflow: sdk.NewInt(50), InflowConversions: 2, OutflowConversions: 1, LastConversionHeight: 10, EvmHookVolume: sdk.NewInt(50), MsgVolume: sdk.NewInt(100)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid token pair stats",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				TokenPairStats: []TokenPairStats{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", TotalInflow: sdk.NewInt(100), TotalOutflow: sdk.NewInt(50), InflowConversions: 2, OutflowConversions: 1, LastConversionHeight: 10, EvmHookVolume: sdk.NewInt(50), MsgVolume: sdk.NewInt(10)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with token pair tombstones",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairTombstones: []TokenPairTombstone{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Denom: "usdt", ContractOwner: OWNER_MODULE, Height: 10, Reason: "ERC20 contract has no code", Escrow: sdk.NewInt(100), Supply: sdk.NewInt(100)},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated token pair tombstone",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairTombstones: []TokenPairTombstone{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Denom: "usdt", ContractOwner: OWNER_MODULE, Height: 10, Reason: "ERC20 contract has no code", Escrow: sdk.NewInt(100), Supply: sdk.NewInt(100)},
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Denom: "usdt", ContractOwner: OWNER_MODULE, Height: 10, Reason: "ERC20 contract has no code", Escrow: sdk.NewInt(100), Supply: sdk.NewInt(100)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid token pair tombstone",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairTombstones: []TokenPairTombstone{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", Denom: "usdt", ContractOwner: OWNER_MODULE, Height: 10, Reason: "ERC20 contract has no code", Escrow: sdk.NewInt(-1), Supply: sdk.NewInt(100)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with deregistered ERC20s",
+			genState: &GenesisState{
+				Params:             DefaultParams(),
+				DeregisteredErc20S: []string{"0xdac17f958d2ee523a2206206994597c13d831ec7"},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated deregistered ERC20",
+			genState: &GenesisState{
+				Params:             DefaultParams(),
+				DeregisteredErc20S: []string{"0xdac17f958d2ee523a2206206994597c13d831ec7", "0xdac17f958d2ee523a2206206994597c13d831ec7"},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid deregistered ERC20",
+			genState: &GenesisState{
+				Params:             DefaultParams(),
+				DeregisteredErc20S: []string{"0xinvalid"},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - deregistered ERC20 of registered token pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				DeregisteredErc20S: []string{"0xdac17f958d2ee523a2206206994597c13d831ec7"},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with conversion volumes",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				RateLimits: []RateLimit{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", MaxPerConversion: sdk.ZeroInt(), InflowCap: sdk.NewInt(100), OutflowCap: sdk.ZeroInt(), Window: 10},
+				},
+				ConversionVolumes: []PeriodConversionVolume{
+					{"0xdac17f958d2ee523a2206206994597c13d831ec7", 1, ConversionVolume{Inflow: sdk.NewInt(10), Outflow: sdk.ZeroInt()}},
+					{"0xdac17f958d2ee523a2206206994597c13d831ec7", 2, ConversionVolume{Inflow: sdk.NewInt(10), Outflow: sdk.ZeroInt()}},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated conversion volume",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				RateLimits: []RateLimit{
+					{Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7", MaxPerConversion: sdk.ZeroInt(), InflowCap: sdk.NewInt(100), OutflowCap: sdk.ZeroInt(), Window: 10},
+				},
+				ConversionVolumes: []PeriodConversionVolume{
+					{"0xdac17f958d2ee523a2206206994597c13d831ec7", 1, ConversionVolume{Inflow: sdk.NewInt(10), Outflow: sdk.ZeroInt()}},
+					{"0xdac17f958d2ee523a2206206994597c13d831ec7", 1, ConversionVolume{Inflow: sdk.NewInt(10), Outflow: sdk.ZeroInt()}},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - conversion volume without rate limit",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ConversionVolumes: []PeriodConversionVolume{
+					{"0xdac17f958d2ee523a2206206994597c13d831ec7", 1, ConversionVolume{Inflow: sdk.NewInt(10), Outflow: sdk.ZeroInt()}},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with ERC20 transfers",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Erc20Transfers: []ERC20Transfer{
+					{"transfer", "channel-0", 1, "0xdac17f958d2ee523a2206206994597c13d831ec7"},
+					{"transfer", "channel-0", 2, "0xdac17f958d2ee523a2206206994597c13d831ec7"},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated ERC20 transfer",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Erc20Transfers: []ERC20Transfer{
+					{"transfer", "channel-0", 1, "0xdac17f958d2ee523a2206206994597c13d831ec7"},
+					{"transfer", "channel-0", 1, "0xdac17f958d2ee523a2206206994597c13d831ec7"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid ERC20 transfer",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Erc20Transfers: []ERC20Transfer{
+					{"transfer", "channel-0", 0, "0xdac17f958d2ee523a2206206994597c13d831ec7"},
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
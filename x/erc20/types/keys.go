@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -29,11 +33,61 @@ const (
 	prefixTokenPair = iota + 1
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
+	prefixERC20Transfer
+	prefixRateLimit
+	prefixConversionVolume
+	prefixERC20Implementation
+	prefixPendingRegistration
+	prefixPairPause
+	prefixModulePause
+	prefixConversionFee
+	prefixTokenPairStats
+	prefixMintCap
+	prefixSafetyPolicy
+	prefixWrappedEVMDenom
+	prefixTokenPairTombstone
+	prefixDeregisteredERC20
+	prefixPendingRegistrationQueue
+	prefixPendingDeposit
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixTokenPair        = []byte{prefixTokenPair}
-	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
-	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+	KeyPrefixTokenPair                = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC20         = []byte{prefixTokenPairByERC20}
+	KeyPrefixTokenPairByDenom         = []byte{prefixTokenPairByDenom}
+	KeyPrefixERC20Transfer            = []byte{prefixERC20Transfer}
+	KeyPrefixRateLimit                = []byte{prefixRateLimit}
+	KeyPrefixConversionVolume         = []byte{prefixConversionVolume}
+	KeyERC20Implementation            = []byte{prefixERC20Implementation}
+	KeyPrefixPendingRegistration      = []byte{prefixPendingRegistration}
+	KeyPrefixPairPause                = []byte{prefixPairPause}
+	KeyModulePause                    = []byte{prefixModulePause}
+	KeyPrefixConversionFee            = []byte{prefixConversionFee}
+	KeyPrefixTokenPairStats           = []byte{prefixTokenPairStats}
+	KeyPrefixMintCap                  = []byte{prefixMintCap}
+	KeyPrefixSafetyPolicy             = []byte{prefixSafetyPolicy}
+	KeyWrappedEVMDenom                = []byte{prefixWrappedEVMDenom}
+	KeyPrefixTokenPairTombstone       = []byte{prefixTokenPairTombstone}
+	KeyPrefixDeregisteredERC20        = []byte{prefixDeregisteredERC20}
+	KeyPrefixPendingRegistrationQueue = []byte{prefixPendingRegistrationQueue}
+	KeyPrefixPendingDeposit           = []byte{prefixPendingDeposit}
 )
+
+// ERC20TransferKey returns the key of the ERC20 transfer sent on the packet
+// with the given source port, source channel and sequence.
+func ERC20TransferKey(portID, channelID string, sequence uint64) []byte {
+	return append([]byte(fmt.Sprintf("%s/%s/", portID, channelID)), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// ConversionVolumeKey returns the key of the conversion volume of the token
+// pair with the given ERC20 contract at the given block height or epoch number.
+func ConversionVolumeKey(contract common.Address, period uint64) []byte {
+	return append(contract.Bytes(), sdk.Uint64ToBigEndian(period)...)
+}
+
+// PendingRegistrationQueueKey returns the key of the pending registration of
+// the given ERC20 contract on the queue ordered by activation time.
+func PendingRegistrationQueueKey(activationTime time.Time, contract common.Address) []byte {
+	return append(sdk.FormatTimeBytes(activationTime), contract.Bytes()...)
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// NewMintCap returns an instance of MintCap
+func NewMintCap(erc20Address common.Address, mintCap sdk.Int) MintCap {
+	return MintCap{
+		Erc20Address: erc20Address.String(),
+		Cap:          mintCap,
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (mc MintCap) GetERC20Contract() common.Address {
+	return common.HexToAddress(mc.Erc20Address)
+}
+
+// Validate performs a stateless validation of a MintCap
+func (mc MintCap) Validate() error {
+	if err := ethermint.ValidateAddress(mc.Erc20Address); err != nil {
+		return err
+	}
+
+	if mc.Cap.IsNil() || !mc.Cap.IsPositive() {
+		return fmt.Errorf("mint cap must be positive: %v", mc.Cap)
+	}
+
+	return nil
+}
+
+// NewMintCapUtilization returns the utilization of the mint cap given the
+// current supply of the Cosmos coin of the token pair
+func NewMintCapUtilization(mintCap MintCap, supply sdk.Int) MintCapUtilization {
+	return MintCapUtilization{
+		MintCap:     mintCap,
+		Supply:      supply,
+		Remaining:   remaining(mintCap.Cap, supply),
+		Utilization: sdk.NewDecFromInt(supply).QuoInt(mintCap.Cap),
+	}
+}
+
+func validateMintCap(mintCap sdk.Int) error {
+	if mintCap.IsNil() || mintCap.IsNegative() {
+		return fmt.Errorf("mint cap cannot be nil or negative: %v", mintCap)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tharsis/ethermint/tests"
+)
+
+type MintCapTestSuite struct {
+	suite.Suite
+}
+
+func TestMintCapSuite(t *testing.T) {
+	suite.Run(t, new(MintCapTestSuite))
+}
+
+func (suite *MintCapTestSuite) TestMintCapNew() {
+	addr := tests.GenerateAddress()
+	mc := NewMintCap(addr, sdk.NewInt(1000))
+
+	suite.Require().Equal(addr, mc.GetERC20Contract())
+	suite.Require().NoError(mc.Validate())
+}
+
+func (suite *MintCapTestSuite) TestMintCap() {
+	testCases := []struct {
+		msg        string
+		mintCap    MintCap
+		expectPass bool
+	}{
+		{msg: "invalid address", mintCap: MintCap{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", sdk.NewInt(1000)}, expectPass: false},
+		{msg: "nil cap", mintCap: MintCap{tests.GenerateAddress().String(), sdk.Int{}}, expectPass: false},
+		{msg: "zero cap", mintCap: MintCap{tests.GenerateAddress().String(), sdk.ZeroInt()}, expectPass: false},
+		{msg: "negative cap", mintCap: MintCap{tests.GenerateAddress().String(), sdk.NewInt(-1)}, expectPass: false},
+		{msg: "pass", mintCap: MintCap{tests.GenerateAddress().String(), sdk.NewInt(1000)}, expectPass: true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.mintCap.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MintCapTestSuite) TestMintCapUtilization() {
+	testCases := []struct {
+		msg            string
+		supply         sdk.Int
+		expRemaining   sdk.Int
+		expUtilization sdk.Dec
+	}{
+		{"no supply", sdk.ZeroInt(), sdk.NewInt(1000), sdk.ZeroDec()},
+		{"partially used", sdk.NewInt(250), sdk.NewInt(750), sdk.NewDecWithPrec(25, 2)},
+		{"cap reached", sdk.NewInt(1000), sdk.ZeroInt(), sdk.OneDec()},
+		{"supply over the cap", sdk.NewInt(1500), sdk.ZeroInt(), sdk.NewDecWithPrec(15, 1)},
+	}
+
+	for _, tc := range testCases {
+		mc := NewMintCap(tests.GenerateAddress(), sdk.NewInt(1000))
+		utilization := NewMintCapUtilization(mc, tc.supply)
+
+		suite.Require().Equal(mc, utilization.MintCap, tc.msg)
+		suite.Require().Equal(tc.supply.String(), utilization.Supply.String(), tc.msg)
+		suite.Require().Equal(tc.expRemaining.String(), utilization.Remaining.String(), tc.msg)
+		suite.Require().True(tc.expUtilization.Equal(utilization.Utilization), tc.msg)
+	}
+}
@@ -1,21 +1,45 @@
 package types
 
 import (
+	"strings"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethermint "github.com/tharsis/ethermint/types"
 )
 
 var (
 	_ sdk.Msg = &MsgConvertCoin{}
 	_ sdk.Msg = &MsgConvertERC20{}
+	_ sdk.Msg = &MsgTransferERC20{}
+	_ sdk.Msg = &MsgConvertCoins{}
+	_ sdk.Msg = &MsgConvertERC20S{}
+	_ sdk.Msg = &MsgConvertERC20WithPermit{}
+	_ sdk.Msg = &MsgConvertERC20From{}
+	_ sdk.Msg = &MsgRegisterERC20{}
+	_ sdk.Msg = &MsgPausePair{}
+	_ sdk.Msg = &MsgPauseModule{}
 )
 
 const (
-	TypeMsgConvertCoin  = "convert_coin"
-	TypeMsgConvertERC20 = "convert_ERC20"
+	TypeMsgConvertCoin   = "convert_coin"
+	TypeMsgConvertERC20  = "convert_ERC20"
+	TypeMsgTransferERC20 = "transfer_ERC20"
+	TypeMsgConvertCoins  = "convert_coins"
+	TypeMsgConvertERC20S = "convert_ERC20s"
+
+	TypeMsgConvertERC20WithPermit = "convert_ERC20_with_permit"
+	TypeMsgConvertERC20From       = "convert_ERC20_from"
+	TypeMsgRegisterERC20          = "register_ERC20"
+	TypeMsgPausePair              = "pause_pair"
+	TypeMsgPauseModule            = "pause_module"
 )
 
 // NewMsgConvertCoin creates a new instance of MsgConvertCoin
@@ -113,3 +137,446 @@ func (msg MsgConvertERC20) GetSigners() []sdk.AccAddress {
 	addr := common.HexToAddress(msg.Sender)
 	return []sdk.AccAddress{addr.Bytes()}
 }
+
+// NewMsgTransferERC20 creates a new instance of MsgTransferERC20
+func NewMsgTransferERC20( // nolint: interfacer
+	amount sdk.Int, contract, sender common.Address,
+	sourcePort, sourceChannel, receiver string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+) *MsgTransferERC20 {
+	return &MsgTransferERC20{
+		ContractAddress:  contract.String(),
+		Amount:           amount,
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Sender:           sender.Hex(),
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTransferERC20) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTransferERC20) Type() string { return TypeMsgTransferERC20 }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTransferERC20) ValidateBasic() error {
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	if !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot transfer a non-positive amount")
+	}
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if !common.IsHexAddress(msg.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender hex address %s", msg.Sender)
+	}
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgTransferERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgTransferERC20) GetSigners() []sdk.AccAddress {
+	addr := common.HexToAddress(msg.Sender)
+	return []sdk.AccAddress{addr.Bytes()}
+}
+
+// NewMsgConvertCoins creates a new instance of MsgConvertCoins
+func NewMsgConvertCoins(coins sdk.Coins, receiver common.Address, sender sdk.AccAddress) *MsgConvertCoins { // nolint: interfacer
+	return &MsgConvertCoins{
+		Coins:    coins,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertCoins) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertCoins) Type() string { return TypeMsgConvertCoins }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertCoins) ValidateBasic() error {
+	if msg.Coins.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "coins cannot be empty")
+	}
+	if err := msg.Coins.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	for _, coin := range msg.Coins {
+		if err := ValidateErc20Denom(coin.Denom); err != nil {
+			if err := ibctransfertypes.ValidateIBCDenom(coin.Denom); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(msg.Receiver) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver hex address %s", msg.Receiver)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConvertCoins) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertCoins) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// NewERC20Amount creates a new instance of ERC20Amount
+func NewERC20Amount(contract common.Address, amount sdk.Int) ERC20Amount {
+	return ERC20Amount{
+		ContractAddress: contract.String(),
+		Amount:          amount,
+	}
+}
+
+// NewMsgConvertERC20S creates a new instance of MsgConvertERC20S
+func NewMsgConvertERC20S(tokens []ERC20Amount, receiver sdk.AccAddress, sender common.Address) *MsgConvertERC20S { // nolint: interfacer
+	return &MsgConvertERC20S{
+		Tokens:   tokens,
+		Receiver: receiver.String(),
+		Sender:   sender.Hex(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertERC20S) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertERC20S) Type() string { return TypeMsgConvertERC20S }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertERC20S) ValidateBasic() error {
+	if len(msg.Tokens) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "tokens cannot be empty")
+	}
+
+	seenContracts := make(map[common.Address]bool)
+	for _, token := range msg.Tokens {
+		if !common.IsHexAddress(token.ContractAddress) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract hex address '%s'", token.ContractAddress)
+		}
+		contract := common.HexToAddress(token.ContractAddress)
+		if seenContracts[contract] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "duplicate contract address '%s'", token.ContractAddress)
+		}
+		if token.Amount.IsNil() || !token.Amount.IsPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot mint a non-positive amount")
+		}
+		seenContracts[contract] = true
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid reciver address")
+	}
+	if !common.IsHexAddress(msg.Sender) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender hex address %s", msg.Sender)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConvertERC20S) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertERC20S) GetSigners() []sdk.AccAddress {
+	addr := common.HexToAddress(msg.Sender)
+	return []sdk.AccAddress{addr.Bytes()}
+}
+
+// NewMsgConvertERC20WithPermit creates a new instance of MsgConvertERC20WithPermit
+func NewMsgConvertERC20WithPermit( // nolint: interfacer
+	amount sdk.Int, contract, owner common.Address,
+	deadline uint64, signature []byte, relayer sdk.AccAddress,
+) *MsgConvertERC20WithPermit {
+	return &MsgConvertERC20WithPermit{
+		ContractAddress: contract.String(),
+		Amount:          amount,
+		Owner:           owner.Hex(),
+		Deadline:        deadline,
+		Signature:       signature,
+		Relayer:         relayer.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertERC20WithPermit) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertERC20WithPermit) Type() string { return TypeMsgConvertERC20WithPermit }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertERC20WithPermit) ValidateBasic() error {
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot mint a non-positive amount")
+	}
+	if !common.IsHexAddress(msg.Owner) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner hex address %s", msg.Owner)
+	}
+	if msg.Deadline == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "permit deadline cannot be zero")
+	}
+	if len(msg.Signature) != crypto.SignatureLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid permit signature length %d, expected %d", len(msg.Signature), crypto.SignatureLength)
+	}
+	if v := msg.Signature[crypto.RecoveryIDOffset]; v != 0 && v != 1 && v != 27 && v != 28 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid permit signature recovery id %d", v)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Relayer); err != nil {
+		return sdkerrors.Wrap(err, "invalid relayer address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConvertERC20WithPermit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required. The relayer signs the
+// transaction, as the owner authorizes the conversion with the permit.
+func (msg MsgConvertERC20WithPermit) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// PermitSignature returns the V, R and S values of the permit signature, with V
+// in the 27/28 format expected by the ERC20 contract
+func (msg MsgConvertERC20WithPermit) PermitSignature() (uint8, [32]byte, [32]byte) {
+	var r, s [32]byte
+	copy(r[:], msg.Signature[:32])
+	copy(s[:], msg.Signature[32:64])
+
+	v := msg.Signature[crypto.RecoveryIDOffset]
+	if v < 27 {
+		v += 27
+	}
+	return v, r, s
+}
+
+// NewMsgConvertERC20From creates a new instance of MsgConvertERC20From
+func NewMsgConvertERC20From(amount sdk.Int, contract, owner common.Address, relayer sdk.AccAddress) *MsgConvertERC20From { // nolint: interfacer
+	return &MsgConvertERC20From{
+		ContractAddress: contract.String(),
+		Amount:          amount,
+		Owner:           owner.Hex(),
+		Relayer:         relayer.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertERC20From) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertERC20From) Type() string { return TypeMsgConvertERC20From }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertERC20From) ValidateBasic() error {
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot mint a non-positive amount")
+	}
+	if !common.IsHexAddress(msg.Owner) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner hex address %s", msg.Owner)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Relayer); err != nil {
+		return sdkerrors.Wrap(err, "invalid relayer address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConvertERC20From) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required. The relayer signs the
+// transaction, as the owner authorizes the conversion with its allowance.
+func (msg MsgConvertERC20From) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRegisterERC20 creates a new instance of MsgRegisterERC20
+func NewMsgRegisterERC20(contract common.Address, sender sdk.AccAddress) *MsgRegisterERC20 { // nolint: interfacer
+	return &MsgRegisterERC20{
+		ContractAddress: contract.String(),
+		Sender:          sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRegisterERC20) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterERC20) Type() string { return TypeMsgRegisterERC20 }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterERC20) ValidateBasic() error {
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRegisterERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterERC20) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgPausePair creates a new instance of MsgPausePair
+func NewMsgPausePair(token, reason string, duration time.Duration, guardian sdk.AccAddress) *MsgPausePair { // nolint: interfacer
+	return &MsgPausePair{
+		Token:    token,
+		Reason:   reason,
+		Duration: duration,
+		Guardian: guardian.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgPausePair) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgPausePair) Type() string { return TypeMsgPausePair }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgPausePair) ValidateBasic() error {
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(msg.Token); err != nil {
+		if err := sdk.ValidateDenom(msg.Token); err != nil {
+			return err
+		}
+	}
+	if err := ValidatePauseReason(msg.Reason); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pause duration must be positive: %s", msg.Duration)
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Guardian)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid guardian address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgPausePair) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgPausePair) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Guardian)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgPauseModule creates a new instance of MsgPauseModule
+func NewMsgPauseModule(reason string, duration time.Duration, guardian sdk.AccAddress) *MsgPauseModule { // nolint: interfacer
+	return &MsgPauseModule{
+		Reason:   reason,
+		Duration: duration,
+		Guardian: guardian.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgPauseModule) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgPauseModule) Type() string { return TypeMsgPauseModule }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgPauseModule) ValidateBasic() error {
+	if err := ValidatePauseReason(msg.Reason); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "pause duration must be positive: %s", msg.Duration)
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Guardian)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid guardian address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgPauseModule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgPauseModule) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Guardian)
+	if err != nil {
+		return nil
+	}
+
+	return []sdk.AccAddress{addr}
+}
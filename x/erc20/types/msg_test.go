@@ -1,11 +1,14 @@
 package types
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/tharsis/ethermint/tests"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -246,3 +249,553 @@ func (suite *MsgsTestSuite) TestMsgConvertERC20() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgTransferERC20Getters() {
+	msgInvalid := MsgTransferERC20{}
+	msg := NewMsgTransferERC20(
+		sdk.NewInt(100),
+		tests.GenerateAddress(),
+		tests.GenerateAddress(),
+		"transfer",
+		"channel-0",
+		"cosmos1receiver",
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgTransferERC20, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgTransferERC20() {
+	testCases := []struct {
+		msg           string
+		amount        sdk.Int
+		contract      string
+		sourcePort    string
+		sourceChannel string
+		sender        string
+		receiver      string
+		expectPass    bool
+	}{
+		{
+			"invalid contract hex address",
+			sdk.NewInt(100),
+			sdk.AccAddress{}.String(),
+			"transfer",
+			"channel-0",
+			tests.GenerateAddress().String(),
+			"cosmos1receiver",
+			false,
+		},
+		{
+			"negative amount",
+			sdk.NewInt(-100),
+			tests.GenerateAddress().String(),
+			"transfer",
+			"channel-0",
+			tests.GenerateAddress().String(),
+			"cosmos1receiver",
+			false,
+		},
+		{
+			"invalid source port",
+			sdk.NewInt(100),
+			tests.GenerateAddress().String(),
+			"",
+			"channel-0",
+			tests.GenerateAddress().String(),
+			"cosmos1receiver",
+			false,
+		},
+		{
+			"invalid source channel",
+			sdk.NewInt(100),
+			tests.GenerateAddress().String(),
+			"transfer",
+			"(channel)",
+			tests.GenerateAddress().String(),
+			"cosmos1receiver",
+			false,
+		},
+		{
+			"invalid sender address",
+			sdk.NewInt(100),
+			tests.GenerateAddress().String(),
+			"transfer",
+			"channel-0",
+			sdk.AccAddress{}.String(),
+			"cosmos1receiver",
+			false,
+		},
+		{
+			"empty receiver",
+			sdk.NewInt(100),
+			tests.GenerateAddress().String(),
+			"transfer",
+			"channel-0",
+			tests.GenerateAddress().String(),
+			"  ",
+			false,
+		},
+		{
+			"msg transfer erc20 - pass",
+			sdk.NewInt(100),
+			tests.GenerateAddress().String(),
+			"transfer",
+			"channel-0",
+			tests.GenerateAddress().String(),
+			"cosmos1receiver",
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgTransferERC20{
+			ContractAddress: tc.contract,
+			Amount:          tc.amount,
+			SourcePort:      tc.sourcePort,
+			SourceChannel:   tc.sourceChannel,
+			Sender:          tc.sender,
+			Receiver:        tc.receiver,
+			TimeoutHeight:   clienttypes.NewHeight(0, 100),
+		}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertCoinsGetters() {
+	msgInvalid := MsgConvertCoins{}
+	msg := NewMsgConvertCoins(
+		sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+		tests.GenerateAddress(),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgConvertCoins, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().Nil(msgInvalid.GetSigners())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertCoins() {
+	testCases := []struct {
+		msg        string
+		coins      sdk.Coins
+		receiver   string
+		sender     string
+		expectPass bool
+	}{
+		{
+			"empty coins",
+			sdk.Coins{},
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"unsorted coins",
+			sdk.Coins{sdk.NewInt64Coin("test", 100), sdk.NewInt64Coin("acoin", 100)},
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"zero coin amount",
+			sdk.Coins{sdk.NewInt64Coin("acoin", 100), sdk.NewInt64Coin("test", 0)},
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"invalid erc20 denom",
+			sdk.NewCoins(sdk.NewInt64Coin("acoin", 100), sdk.NewInt64Coin("erc20/0xinvalid", 100)),
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"invalid sender address",
+			sdk.NewCoins(sdk.NewInt64Coin("acoin", 100), sdk.NewInt64Coin("test", 100)),
+			tests.GenerateAddress().String(),
+			"invalid",
+			false,
+		},
+		{
+			"invalid receiver address",
+			sdk.NewCoins(sdk.NewInt64Coin("acoin", 100), sdk.NewInt64Coin("test", 100)),
+			"invalid",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"msg convert coins - pass",
+			sdk.NewCoins(
+				sdk.NewInt64Coin("acoin", 100),
+				sdk.NewInt64Coin("ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", 100),
+				sdk.NewInt64Coin(CreateDenom(tests.GenerateAddress().String()), 100),
+			),
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgConvertCoins{tc.coins, tc.receiver, tc.sender}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20SGetters() {
+	msgInvalid := MsgConvertERC20S{}
+	msg := NewMsgConvertERC20S(
+		[]ERC20Amount{NewERC20Amount(tests.GenerateAddress(), sdk.NewInt(100))},
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		tests.GenerateAddress(),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgConvertERC20S, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20S() {
+	contract := tests.GenerateAddress()
+
+	testCases := []struct {
+		msg        string
+		tokens     []ERC20Amount
+		receiver   string
+		sender     string
+		expectPass bool
+	}{
+		{
+			"empty tokens",
+			[]ERC20Amount{},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"invalid contract hex address",
+			[]ERC20Amount{{ContractAddress: "invalid", Amount: sdk.NewInt(100)}},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"nil amount",
+			[]ERC20Amount{{ContractAddress: contract.String()}},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"negative amount",
+			[]ERC20Amount{NewERC20Amount(contract, sdk.NewInt(-100))},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"duplicate contract",
+			[]ERC20Amount{
+				NewERC20Amount(contract, sdk.NewInt(100)),
+				{ContractAddress: strings.ToLower(contract.String()), Amount: sdk.NewInt(100)},
+			},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"invalid receiver address",
+			[]ERC20Amount{NewERC20Amount(contract, sdk.NewInt(100))},
+			sdk.AccAddress{}.String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"invalid sender address",
+			[]ERC20Amount{NewERC20Amount(contract, sdk.NewInt(100))},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress{}.String(),
+			false,
+		},
+		{
+			"msg convert erc20s - pass",
+			[]ERC20Amount{
+				NewERC20Amount(contract, sdk.NewInt(100)),
+				NewERC20Amount(tests.GenerateAddress(), sdk.NewInt(100)),
+			},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgConvertERC20S{tc.tokens, tc.receiver, tc.sender}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20WithPermitGetters() {
+	msgInvalid := MsgConvertERC20WithPermit{}
+	msg := NewMsgConvertERC20WithPermit(
+		sdk.NewInt(100),
+		tests.GenerateAddress(),
+		tests.GenerateAddress(),
+		1,
+		make([]byte, 65),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgConvertERC20WithPermit, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+	suite.Require().Nil(msgInvalid.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20WithPermit() {
+	contract := tests.GenerateAddress().String()
+	owner := tests.GenerateAddress().String()
+	relayer := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	signature := func(v byte) []byte {
+		return append(make([]byte, 64), v)
+	}
+
+	testCases := []struct {
+		msg        string
+		contract   string
+		amount     sdk.Int
+		owner      string
+		deadline   uint64
+		signature  []byte
+		relayer    string
+		expectPass bool
+	}{
+		{"invalid contract hex address", "invalid", sdk.NewInt(100), owner, 1, signature(0), relayer, false},
+		{"nil amount", contract, sdk.Int{}, owner, 1, signature(0), relayer, false},
+		{"zero amount", contract, sdk.ZeroInt(), owner, 1, signature(0), relayer, false},
+		{"invalid owner hex address", contract, sdk.NewInt(100), "invalid", 1, signature(0), relayer, false},
+		{"zero deadline", contract, sdk.NewInt(100), owner, 0, signature(0), relayer, false},
+		{"short signature", contract, sdk.NewInt(100), owner, 1, make([]byte, 64), relayer, false},
+		{"invalid recovery id", contract, sdk.NewInt(100), owner, 1, signature(2), relayer, false},
+		{"invalid relayer address", contract, sdk.NewInt(100), owner, 1, signature(0), sdk.AccAddress{}.String(), false},
+		{"msg convert erc20 with permit - pass", contract, sdk.NewInt(100), owner, 1, signature(1), relayer, true},
+		{"msg convert erc20 with permit - pass with 27/28 recovery id", contract, sdk.NewInt(100), owner, 1, signature(28), relayer, true},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgConvertERC20WithPermit{tc.contract, tc.amount, tc.owner, tc.deadline, tc.signature, tc.relayer}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+
+			v, _, _ := tx.PermitSignature()
+			suite.Require().True(v == 27 || v == 28)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20FromGetters() {
+	msgInvalid := MsgConvertERC20From{}
+	msg := NewMsgConvertERC20From(
+		sdk.NewInt(100),
+		tests.GenerateAddress(),
+		tests.GenerateAddress(),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgConvertERC20From, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+	suite.Require().Nil(msgInvalid.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20From() {
+	contract := tests.GenerateAddress().String()
+	owner := tests.GenerateAddress().String()
+	relayer := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+
+	testCases := []struct {
+		msg        string
+		contract   string
+		amount     sdk.Int
+		owner      string
+		relayer    string
+		expectPass bool
+	}{
+		{"invalid contract hex address", "invalid", sdk.NewInt(100), owner, relayer, false},
+		{"nil amount", contract, sdk.Int{}, owner, relayer, false},
+		{"zero amount", contract, sdk.ZeroInt(), owner, relayer, false},
+		{"negative amount", contract, sdk.NewInt(-100), owner, relayer, false},
+		{"invalid owner hex address", contract, sdk.NewInt(100), "invalid", relayer, false},
+		{"invalid relayer address", contract, sdk.NewInt(100), owner, sdk.AccAddress{}.String(), false},
+		{"msg convert erc20 from - pass", contract, sdk.NewInt(100), owner, relayer, true},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgConvertERC20From{tc.contract, tc.amount, tc.owner, tc.relayer}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterERC20Getters() {
+	msgInvalid := MsgRegisterERC20{}
+	msg := NewMsgRegisterERC20(
+		tests.GenerateAddress(),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgRegisterERC20, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+	suite.Require().Nil(msgInvalid.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterERC20() {
+	contract := tests.GenerateAddress().String()
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+
+	testCases := []struct {
+		msg        string
+		contract   string
+		sender     string
+		expectPass bool
+	}{
+		{"invalid contract hex address", "invalid", sender, false},
+		{"invalid sender address", contract, "invalid", false},
+		{"msg register erc20 - pass", contract, sender, true},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgRegisterERC20{tc.contract, tc.sender}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgPausePairGetters() {
+	msgInvalid := MsgPausePair{}
+	msg := NewMsgPausePair(
+		tests.GenerateAddress().String(),
+		"incident",
+		time.Hour,
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgPausePair, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+	suite.Require().Nil(msgInvalid.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgPausePair() {
+	contract := tests.GenerateAddress().String()
+	guardian := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+
+	testCases := []struct {
+		msg        string
+		token      string
+		reason     string
+		duration   time.Duration
+		guardian   string
+		expectPass bool
+	}{
+		{"invalid token", "(invalid)", "incident", time.Hour, guardian, false},
+		{"blank reason", contract, " ", time.Hour, guardian, false},
+		{"reason too long", contract, strings.Repeat("a", MaxPauseReasonLength+1), time.Hour, guardian, false},
+		{"zero duration", contract, "incident", 0, guardian, false},
+		{"invalid guardian address", contract, "incident", time.Hour, "invalid", false},
+		{"msg pause pair - pass with contract", contract, "incident", time.Hour, guardian, true},
+		{"msg pause pair - pass with denom", "acoin", "incident", time.Hour, guardian, true},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgPausePair{tc.token, tc.reason, tc.duration, tc.guardian}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgPauseModuleGetters() {
+	msgInvalid := MsgPauseModule{}
+	msg := NewMsgPauseModule(
+		"incident",
+		time.Hour,
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgPauseModule, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+	suite.Require().Nil(msgInvalid.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgPauseModule() {
+	guardian := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+
+	testCases := []struct {
+		msg        string
+		reason     string
+		duration   time.Duration
+		guardian   string
+		expectPass bool
+	}{
+		{"blank reason", "", time.Hour, guardian, false},
+		{"negative duration", "incident", -time.Hour, guardian, false},
+		{"invalid guardian address", "incident", time.Hour, "invalid", false},
+		{"msg pause module - pass", "incident", time.Hour, guardian, true},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgPauseModule{tc.reason, tc.duration, tc.guardian}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
@@ -2,14 +2,33 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+var (
+	// DefaultRegistrationDeposit is 100 evmos
+	DefaultRegistrationDeposit = sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewIntWithDecimal(100, 18)))
+	// DefaultRegistrationChallengePeriod is one week
+	DefaultRegistrationChallengePeriod = 7 * 24 * time.Hour
+	// DefaultMaxPauseDuration is three days
+	DefaultMaxPauseDuration = 3 * 24 * time.Hour
+)
+
 // Parameter store key
 var (
-	ParamStoreKeyEnableErc20   = []byte("EnableErc20")
-	ParamStoreKeyEnableEVMHook = []byte("EnableEVMHook")
+	ParamStoreKeyEnableErc20                 = []byte("EnableErc20")
+	ParamStoreKeyEnableEVMHook               = []byte("EnableEVMHook")
+	ParamStoreKeyEnableIBCConversion         = []byte("EnableIBCConversion")
+	ParamStoreKeyRegistrationDeposit         = []byte("RegistrationDeposit")
+	ParamStoreKeyRegistrationChallengePeriod = []byte("RegistrationChallengePeriod")
+	ParamStoreKeyGuardian                    = []byte("Guardian")
+	ParamStoreKeyMaxPauseDuration            = []byte("MaxPauseDuration")
+	ParamStoreKeyConversionFeeRate           = []byte("ConversionFeeRate")
+	ParamStoreKeyMinConversionFees           = []byte("MinConversionFees")
+	ParamStoreKeyConversionFeeDestination    = []byte("ConversionFeeDestination")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -21,17 +40,40 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	enableErc20 bool,
 	enableEVMHook bool,
+	enableIBCConversion bool,
+	registrationDeposit sdk.Coins,
+	registrationChallengePeriod time.Duration,
+	guardian string,
+	maxPauseDuration time.Duration,
+	conversionFeeRate sdk.Dec,
+	minConversionFees sdk.Coins,
+	conversionFeeDestination FeeDestination,
 ) Params {
 	return Params{
-		EnableErc20:   enableErc20,
-		EnableEVMHook: enableEVMHook,
+		EnableErc20:                 enableErc20,
+		EnableEVMHook:               enableEVMHook,
+		EnableIBCConversion:         enableIBCConversion,
+		RegistrationDeposit:         registrationDeposit,
+		RegistrationChallengePeriod: registrationChallengePeriod,
+		Guardian:                    guardian,
+		MaxPauseDuration:            maxPauseDuration,
+		ConversionFeeRate:           conversionFeeRate,
+		MinConversionFees:           minConversionFees,
+		ConversionFeeDestination:    conversionFeeDestination,
 	}
 }
 
 func DefaultParams() Params {
 	return Params{
-		EnableErc20:   true,
-		EnableEVMHook: true,
+		EnableErc20:                 true,
+		EnableEVMHook:               true,
+		EnableIBCConversion:         true,
+		RegistrationDeposit:         DefaultRegistrationDeposit,
+		RegistrationChallengePeriod: DefaultRegistrationChallengePeriod,
+		Guardian:                    "",
+		MaxPauseDuration:            DefaultMaxPauseDuration,
+		ConversionFeeRate:           sdk.ZeroDec(),
+		ConversionFeeDestination:    FEE_DESTINATION_COMMUNITY_POOL,
 	}
 }
 
@@ -44,12 +86,128 @@ func validateBool(i interface{}) error {
 	return nil
 }
 
+func validateCoins(i interface{}) error {
+	coins, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return coins.Validate()
+}
+
+func validateDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("duration cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateGuardian(i interface{}) error {
+	guardian, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an empty guardian disables the emergency pauses
+	if guardian == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(guardian); err != nil {
+		return fmt.Errorf("invalid guardian address %s: %w", guardian, err)
+	}
+
+	return nil
+}
+
+func validateFeeRate(i interface{}) error {
+	rate, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a nil rate is equivalent to a zero rate
+	if rate.IsNil() {
+		return nil
+	}
+
+	return ValidateConversionFeeRate(rate)
+}
+
+func validateFeeDestination(i interface{}) error {
+	destination, ok := i.(FeeDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := FeeDestination_name[int32(destination)]; !ok {
+		return fmt.Errorf("invalid conversion fee destination: %d", destination)
+	}
+
+	return nil
+}
+
 // ParamSetPairs returns the parameter set pairs.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableErc20, &p.EnableErc20, validateBool),
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableEVMHook, &p.EnableEVMHook, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableIBCConversion, &p.EnableIBCConversion, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyRegistrationDeposit, &p.RegistrationDeposit, validateCoins),
+		paramtypes.NewParamSetPair(ParamStoreKeyRegistrationChallengePeriod, &p.RegistrationChallengePeriod, validateDuration),
+		paramtypes.NewParamSetPair(ParamStoreKeyGuardian, &p.Guardian, validateGuardian),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxPauseDuration, &p.MaxPauseDuration, validateDuration),
+		paramtypes.NewParamSetPair(ParamStoreKeyConversionFeeRate, &p.ConversionFeeRate, validateFeeRate),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinConversionFees, &p.MinConversionFees, validateCoins),
+		paramtypes.NewParamSetPair(ParamStoreKeyConversionFeeDestination, &p.ConversionFeeDestination, validateFeeDestination),
+	}
+}
+
+func (p Params) Validate() error {
+	if err := validateCoins(p.RegistrationDeposit); err != nil {
+		return err
+	}
+
+	if err := validateDuration(p.RegistrationChallengePeriod); err != nil {
+		return err
+	}
+
+	if err := validateGuardian(p.Guardian); err != nil {
+		return err
+	}
+
+	if err := validateDuration(p.MaxPauseDuration); err != nil {
+		return err
+	}
+
+	if err := validateFeeRate(p.ConversionFeeRate); err != nil {
+		return err
+	}
+
+	if err := validateCoins(p.MinConversionFees); err != nil {
+		return err
 	}
+
+	return validateFeeDestination(p.ConversionFeeDestination)
 }
 
-func (p Params) Validate() error { return nil }
+// GetConversionFee returns the global conversion fee of the module for the
+// given coin denomination, which applies to the token pairs without a
+// conversion fee
+func (p Params) GetConversionFee(denom string) ConversionFee {
+	rate, minFee := p.ConversionFeeRate, p.MinConversionFees.AmountOf(denom)
+	if rate.IsNil() {
+		rate = sdk.ZeroDec()
+	}
+
+	return ConversionFee{
+		Rate:   rate,
+		MinFee: minFee,
+	}
+}
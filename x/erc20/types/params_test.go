@@ -2,9 +2,13 @@ package types
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/tharsis/ethermint/tests"
 )
 
 type ParamsTestSuite struct {
@@ -28,9 +32,64 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{"default", DefaultParams(), false},
 		{
 			"valid",
-			NewParams(true, true),
+			NewParams(true, true, true, DefaultRegistrationDeposit, DefaultRegistrationChallengePeriod, "", DefaultMaxPauseDuration, sdk.ZeroDec(), sdk.Coins{}, FEE_DESTINATION_COMMUNITY_POOL),
+			false,
+		},
+		{
+			"no deposit",
+			NewParams(true, true, true, sdk.Coins{}, 0, "", 0, sdk.ZeroDec(), sdk.Coins{}, FEE_DESTINATION_COMMUNITY_POOL),
 			false,
 		},
+		{
+			"invalid deposit",
+			NewParams(true, true, true, sdk.Coins{{Denom: "aevmos", Amount: sdk.NewInt(-1)}}, DefaultRegistrationChallengePeriod, "", DefaultMaxPauseDuration, sdk.ZeroDec(), sdk.Coins{}, FEE_DESTINATION_COMMUNITY_POOL),
+			true,
+		},
+		{
+			"negative challenge period",
+			NewParams(true, true, true, DefaultRegistrationDeposit, -time.Hour, "", DefaultMaxPauseDuration, sdk.ZeroDec(), sdk.Coins{}, FEE_DESTINATION_COMMUNITY_POOL),
+			true,
+		},
+		{
+			"valid guardian",
+			NewParams(true, true, true, DefaultRegistrationDeposit, DefaultRegistrationChallengePeriod, sdk.AccAddress(tests.GenerateAddress().Bytes()).String(), DefaultMaxPauseDuration, sdk.ZeroDec(), sdk.Coins{}, FEE_DESTINATION_COMMUNITY_POOL),
+			false,
+		},
+		{
+			"invalid guardian",
+			NewParams(true, true, true, DefaultRegistrationDeposit, DefaultRegistrationChallengePeriod, "invalid", DefaultMaxPauseDuration, sdk.ZeroDec(), sdk.Coins{}, FEE_DESTINATION_COMMUNITY_POOL),
+			true,
+		},
+		{
+			"negative max pause duration",
+			NewParams(true, true, true, DefaultRegistrationDeposit, DefaultRegistrationChallengePeriod, "", -time.Hour, sdk.ZeroDec(), sdk.Coins{}, FEE_DESTINATION_COMMUNITY_POOL),
+			true,
+		},
+		{
+			"valid conversion fee",
+			NewParams(true, true, true, DefaultRegistrationDeposit, DefaultRegistrationChallengePeriod, "", DefaultMaxPauseDuration, sdk.NewDecWithPrec(1, 3), sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)), FEE_DESTINATION_BURN),
+			false,
+		},
+		{
+			"negative conversion fee rate",
+			NewParams(true, true, true, DefaultRegistrationDeposit, DefaultRegistrationChallengePeriod, "", DefaultMaxPauseDuration, sdk.NewDec(-1), sdk.Coins{}, FEE_DESTINATION_COMMUNITY_POOL),
+			true,
+		},
+		{
+			"conversion fee rate of 100%",
+			NewParams(true, true, true, DefaultRegistrationDeposit, DefaultRegistrationChallengePeriod, "", DefaultMaxPauseDuration, sdk.OneDec(), sdk.Coins{}, FEE_DESTINATION_COMMUNITY_POOL),
+			true,
+		},
+		{
+			"negative min conversion fee",
+			NewParams(true, true, true, DefaultRegistrationDeposit, DefaultRegistrationChallengePeriod, "", DefaultMaxPauseDuration, sdk.ZeroDec(), sdk.Coins{{Denom: "aevmos", Amount: sdk.NewInt(-1)}}, FEE_DESTINATION_COMMUNITY_POOL),
+			true,
+		},
+		{
+			"invalid conversion fee destination",
+			NewParams(true, true, true, DefaultRegistrationDeposit, DefaultRegistrationChallengePeriod, "", DefaultMaxPauseDuration, sdk.ZeroDec(), sdk.Coins{}, FeeDestination(10)),
+			true,
+		},
 		{
 			"empty",
 			Params{},
@@ -52,4 +111,24 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(validateBool(1))
 	suite.Require().NoError(validateBool(true))
+	suite.Require().Error(validateCoins(1))
+	suite.Require().NoError(validateCoins(DefaultRegistrationDeposit))
+	suite.Require().Error(validateDuration(1))
+	suite.Require().NoError(validateDuration(time.Hour))
+	suite.Require().Error(validateGuardian(1))
+	suite.Require().NoError(validateGuardian(""))
+	suite.Require().Error(validateFeeRate(1))
+	suite.Require().NoError(validateFeeRate(sdk.NewDecWithPrec(1, 3)))
+	suite.Require().Error(validateFeeDestination(1))
+	suite.Require().NoError(validateFeeDestination(FEE_DESTINATION_FEE_COLLECTOR))
+}
+
+func (suite *ParamsTestSuite) TestParamsGetConversionFee() {
+	params := DefaultParams()
+	params.ConversionFeeRate = sdk.NewDecWithPrec(1, 2)
+	params.MinConversionFees = sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100))
+
+	suite.Require().Equal(sdk.NewInt(100), params.GetConversionFee("aevmos").MinFee)
+	suite.Require().True(params.GetConversionFee("uatom").MinFee.IsZero())
+	suite.Require().Equal(params.ConversionFeeRate, params.GetConversionFee("uatom").Rate)
 }
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// MaxPauseReasonLength is the maximum length of the reason of a pause
+const MaxPauseReasonLength = 256
+
+// NewPairPause returns a pause of the token pair with the given ERC20 contract
+func NewPairPause(erc20Address common.Address, reason string, guardian sdk.AccAddress, expiry time.Time) Pause {
+	return Pause{
+		Erc20Address: erc20Address.String(),
+		Reason:       reason,
+		Guardian:     guardian.String(),
+		Expiry:       expiry,
+	}
+}
+
+// NewModulePause returns a pause of the whole module
+func NewModulePause(reason string, guardian sdk.AccAddress, expiry time.Time) Pause {
+	return Pause{
+		Reason:   reason,
+		Guardian: guardian.String(),
+		Expiry:   expiry,
+	}
+}
+
+// IsModulePause returns true if the pause applies to the whole module
+func (p Pause) IsModulePause() bool {
+	return p.Erc20Address == ""
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (p Pause) GetERC20Contract() common.Address {
+	return common.HexToAddress(p.Erc20Address)
+}
+
+// IsActive returns true if the pause hasn't expired at the given block time
+func (p Pause) IsActive(blockTime time.Time) bool {
+	return blockTime.Before(p.Expiry)
+}
+
+// Validate performs a stateless validation of a Pause
+func (p Pause) Validate() error {
+	if !p.IsModulePause() {
+		if err := ethermint.ValidateAddress(p.Erc20Address); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidatePauseReason(p.Reason); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Guardian); err != nil {
+		return sdkerrors.Wrap(err, "invalid guardian address")
+	}
+
+	if p.Expiry.IsZero() {
+		return errors.New("pause expiry cannot be zero")
+	}
+
+	return nil
+}
+
+// ValidatePauseReason checks that the reason of a pause is not blank and
+// doesn't exceed the maximum length
+func ValidatePauseReason(reason string) error {
+	if strings.TrimSpace(reason) == "" {
+		return errors.New("pause reason cannot be blank")
+	}
+
+	if len(reason) > MaxPauseReasonLength {
+		return fmt.Errorf("pause reason is longer than max length of %d", MaxPauseReasonLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tharsis/ethermint/tests"
+)
+
+type PauseTestSuite struct {
+	suite.Suite
+}
+
+func TestPauseSuite(t *testing.T) {
+	suite.Run(t, new(PauseTestSuite))
+}
+
+func (suite *PauseTestSuite) TestPauseNew() {
+	addr := tests.GenerateAddress()
+	guardian := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	expiry := time.Unix(1000, 0).UTC()
+
+	pairPause := NewPairPause(addr, "incident", guardian, expiry)
+	suite.Require().False(pairPause.IsModulePause())
+	suite.Require().Equal(addr, pairPause.GetERC20Contract())
+	suite.Require().NoError(pairPause.Validate())
+
+	modulePause := NewModulePause("incident", guardian, expiry)
+	suite.Require().True(modulePause.IsModulePause())
+	suite.Require().NoError(modulePause.Validate())
+
+	suite.Require().True(pairPause.IsActive(expiry.Add(-time.Second)))
+	suite.Require().False(pairPause.IsActive(expiry))
+	suite.Require().False(pairPause.IsActive(expiry.Add(time.Second)))
+}
+
+func (suite *PauseTestSuite) TestPause() {
+	guardian := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	expiry := time.Unix(1000, 0).UTC()
+
+	testCases := []struct {
+		msg        string
+		pause      Pause
+		expectPass bool
+	}{
+		{msg: "invalid address", pause: Pause{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "incident", guardian, expiry}, expectPass: false},
+		{msg: "blank reason", pause: Pause{tests.GenerateAddress().String(), " ", guardian, expiry}, expectPass: false},
+		{msg: "reason too long", pause: Pause{tests.GenerateAddress().String(), strings.Repeat("a", MaxPauseReasonLength+1), guardian, expiry}, expectPass: false},
+		{msg: "invalid guardian", pause: Pause{tests.GenerateAddress().String(), "incident", "invalid", expiry}, expectPass: false},
+		{msg: "zero expiry", pause: Pause{tests.GenerateAddress().String(), "incident", guardian, time.Time{}}, expectPass: false},
+		{msg: "pass - module pause", pause: Pause{"", "incident", guardian, expiry}, expectPass: true},
+		{msg: "pass", pause: Pause{tests.GenerateAddress().String(), "incident", guardian, expiry}, expectPass: true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.pause.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// NewPendingRegistration returns an instance of PendingRegistration
+func NewPendingRegistration(
+	erc20Address common.Address,
+	registrant sdk.AccAddress,
+	deposit sdk.Coins,
+	activationTime time.Time,
+) PendingRegistration {
+	return PendingRegistration{
+		Erc20Address:   erc20Address.String(),
+		Registrant:     registrant.String(),
+		Deposit:        deposit,
+		ActivationTime: activationTime,
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (pr PendingRegistration) GetERC20Contract() common.Address {
+	return common.HexToAddress(pr.Erc20Address)
+}
+
+// IsActivated returns true if the challenge period of the registration is
+// over at the given block time
+func (pr PendingRegistration) IsActivated(blockTime time.Time) bool {
+	return !blockTime.Before(pr.ActivationTime)
+}
+
+// Validate performs a stateless validation of a PendingRegistration
+func (pr PendingRegistration) Validate() error {
+	if err := ethermint.ValidateAddress(pr.Erc20Address); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(pr.Registrant); err != nil {
+		return sdkerrors.Wrap(err, "invalid registrant address")
+	}
+
+	return pr.Deposit.Validate()
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tharsis/ethermint/tests"
+)
+
+type PendingRegistrationTestSuite struct {
+	suite.Suite
+}
+
+func TestPendingRegistrationSuite(t *testing.T) {
+	suite.Run(t, new(PendingRegistrationTestSuite))
+}
+
+func (suite *PendingRegistrationTestSuite) TestPendingRegistrationNew() {
+	addr := tests.GenerateAddress()
+	activation := time.Unix(1000, 0).UTC()
+	pr := NewPendingRegistration(addr, sdk.AccAddress(tests.GenerateAddress().Bytes()), DefaultRegistrationDeposit, activation)
+
+	suite.Require().Equal(addr, pr.GetERC20Contract())
+	suite.Require().NoError(pr.Validate())
+	suite.Require().False(pr.IsActivated(activation.Add(-time.Second)))
+	suite.Require().True(pr.IsActivated(activation))
+	suite.Require().True(pr.IsActivated(activation.Add(time.Second)))
+}
+
+func (suite *PendingRegistrationTestSuite) TestPendingRegistration() {
+	registrant := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+
+	testCases := []struct {
+		msg        string
+		pending    PendingRegistration
+		expectPass bool
+	}{
+		{msg: "invalid address", pending: PendingRegistration{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", registrant, DefaultRegistrationDeposit, time.Time{}}, expectPass: false},
+		{msg: "invalid registrant", pending: PendingRegistration{tests.GenerateAddress().String(), "invalid", DefaultRegistrationDeposit, time.Time{}}, expectPass: false},
+		{msg: "invalid deposit", pending: PendingRegistration{tests.GenerateAddress().String(), registrant, sdk.Coins{{Denom: "aevmos", Amount: sdk.NewInt(-1)}}, time.Time{}}, expectPass: false},
+		{msg: "pass - no deposit", pending: PendingRegistration{tests.GenerateAddress().String(), registrant, sdk.Coins{}, time.Time{}}, expectPass: true},
+		{msg: "pass", pending: PendingRegistration{tests.GenerateAddress().String(), registrant, DefaultRegistrationDeposit, time.Time{}}, expectPass: true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.pending.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
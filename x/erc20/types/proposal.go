@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -15,10 +16,21 @@ import (
 
 // constants
 const (
-	ProposalTypeRegisterCoin         string = "RegisterCoin"
-	ProposalTypeRegisterERC20        string = "RegisterERC20"
-	ProposalTypeToggleTokenRelay     string = "ToggleTokenRelay" // #nosec
-	ProposalTypeUpdateTokenPairERC20 string = "UpdateTokenPairERC20"
+	ProposalTypeRegisterCoin               string = "RegisterCoin"
+	ProposalTypeRegisterERC20              string = "RegisterERC20"
+	ProposalTypeToggleTokenRelay           string = "ToggleTokenRelay" // #nosec
+	ProposalTypeUpdateTokenPairERC20       string = "UpdateTokenPairERC20"
+	ProposalTypeDeregisterTokenPair        string = "DeregisterTokenPair"
+	ProposalTypeUpdateRateLimit            string = "UpdateRateLimit"
+	ProposalTypeUpgradeERC20Implementation string = "UpgradeERC20Implementation"
+	ProposalTypeUpdateCoinMetadata         string = "UpdateCoinMetadata"
+	ProposalTypeVetoERC20Registration      string = "VetoERC20Registration"
+	ProposalTypeExtendPause                string = "ExtendPause"
+	ProposalTypeUpdateConversionFee        string = "UpdateConversionFee"
+	ProposalTypeUpdateMintCap              string = "UpdateMintCap"
+	ProposalTypeUpdateSafetyPolicy         string = "UpdateSafetyPolicy"
+	ProposalTypeRecoverTokenPairEscrow     string = "RecoverTokenPairEscrow"
+	ProposalTypeRegisterWrappedEVMDenom    string = "RegisterWrappedEVMDenom"
 )
 
 // Implements Proposal Interface
@@ -27,6 +39,17 @@ var (
 	_ govtypes.Content = &RegisterERC20Proposal{}
 	_ govtypes.Content = &ToggleTokenRelayProposal{}
 	_ govtypes.Content = &UpdateTokenPairERC20Proposal{}
+	_ govtypes.Content = &DeregisterTokenPairProposal{}
+	_ govtypes.Content = &UpdateRateLimitProposal{}
+	_ govtypes.Content = &UpgradeERC20ImplementationProposal{}
+	_ govtypes.Content = &UpdateCoinMetadataProposal{}
+	_ govtypes.Content = &VetoERC20RegistrationProposal{}
+	_ govtypes.Content = &ExtendPauseProposal{}
+	_ govtypes.Content = &UpdateConversionFeeProposal{}
+	_ govtypes.Content = &UpdateMintCapProposal{}
+	_ govtypes.Content = &UpdateSafetyPolicyProposal{}
+	_ govtypes.Content = &RecoverTokenPairEscrowProposal{}
+	_ govtypes.Content = &RegisterWrappedEVMDenomProposal{}
 )
 
 func init() {
@@ -34,10 +57,32 @@ func init() {
 	govtypes.RegisterProposalType(ProposalTypeRegisterERC20)
 	govtypes.RegisterProposalType(ProposalTypeToggleTokenRelay)
 	govtypes.RegisterProposalType(ProposalTypeUpdateTokenPairERC20)
+	govtypes.RegisterProposalType(ProposalTypeDeregisterTokenPair)
+	govtypes.RegisterProposalType(ProposalTypeUpdateRateLimit)
+	govtypes.RegisterProposalType(ProposalTypeUpgradeERC20Implementation)
+	govtypes.RegisterProposalType(ProposalTypeUpdateCoinMetadata)
+	govtypes.RegisterProposalType(ProposalTypeVetoERC20Registration)
+	govtypes.RegisterProposalType(ProposalTypeExtendPause)
+	govtypes.RegisterProposalType(ProposalTypeUpdateConversionFee)
+	govtypes.RegisterProposalType(ProposalTypeUpdateMintCap)
+	govtypes.RegisterProposalType(ProposalTypeUpdateSafetyPolicy)
+	govtypes.RegisterProposalType(ProposalTypeRecoverTokenPairEscrow)
+	govtypes.RegisterProposalType(ProposalTypeRegisterWrappedEVMDenom)
 	govtypes.RegisterProposalTypeCodec(&RegisterCoinProposal{}, "erc20/RegisterCoinProposal")
 	govtypes.RegisterProposalTypeCodec(&RegisterERC20Proposal{}, "erc20/RegisterERC20Proposal")
 	govtypes.RegisterProposalTypeCodec(&ToggleTokenRelayProposal{}, "erc20/ToggleTokenRelayProposal")
 	govtypes.RegisterProposalTypeCodec(&UpdateTokenPairERC20Proposal{}, "erc20/UpdateTokenPairERC20Proposal")
+	govtypes.RegisterProposalTypeCodec(&DeregisterTokenPairProposal{}, "erc20/DeregisterTokenPairProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateRateLimitProposal{}, "erc20/UpdateRateLimitProposal")
+	govtypes.RegisterProposalTypeCodec(&UpgradeERC20ImplementationProposal{}, "erc20/UpgradeERC20ImplementationProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateCoinMetadataProposal{}, "erc20/UpdateCoinMetadataProposal")
+	govtypes.RegisterProposalTypeCodec(&VetoERC20RegistrationProposal{}, "erc20/VetoERC20RegistrationProposal")
+	govtypes.RegisterProposalTypeCodec(&ExtendPauseProposal{}, "erc20/ExtendPauseProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateConversionFeeProposal{}, "erc20/UpdateConversionFeeProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateMintCapProposal{}, "erc20/UpdateMintCapProposal")
+	govtypes.RegisterProposalTypeCodec(&UpdateSafetyPolicyProposal{}, "erc20/UpdateSafetyPolicyProposal")
+	govtypes.RegisterProposalTypeCodec(&RecoverTokenPairEscrowProposal{}, "erc20/RecoverTokenPairEscrowProposal")
+	govtypes.RegisterProposalTypeCodec(&RegisterWrappedEVMDenomProposal{}, "erc20/RegisterWrappedEVMDenomProposal")
 }
 
 // CreateDenomDescription generates a string with the coin description
@@ -51,11 +96,13 @@ func CreateDenom(address string) string {
 }
 
 // NewRegisterCoinProposal returns new instance of RegisterCoinProposal
-func NewRegisterCoinProposal(title, description string, coinMetadata banktypes.Metadata) govtypes.Content {
+func NewRegisterCoinProposal(title, description string, coinMetadata banktypes.Metadata, scalingExponent uint32, gasLimit uint64) govtypes.Content {
 	return &RegisterCoinProposal{
-		Title:       title,
-		Description: description,
-		Metadata:    coinMetadata,
+		Title:           title,
+		Description:     description,
+		Metadata:        coinMetadata,
+		ScalingExponent: scalingExponent,
+		GasLimit:        gasLimit,
 	}
 }
 
@@ -81,6 +128,14 @@ func (rtbp *RegisterCoinProposal) ValidateBasic() error {
 		return err
 	}
 
+	if err := ValidateScalingExponent(rtbp.ScalingExponent); err != nil {
+		return err
+	}
+
+	if err := ValidateGasLimit(rtbp.GasLimit); err != nil {
+		return err
+	}
+
 	return govtypes.ValidateAbstract(rtbp)
 }
 
@@ -121,12 +176,39 @@ func ValidateErc20Denom(denom string) error {
 	return ethermint.ValidateAddress(denomSplit[1])
 }
 
-// NewRegisterERC20Proposal returns new instance of RegisterERC20Proposal
-func NewRegisterERC20Proposal(title, description, erc20Addr string) govtypes.Content {
+// ValidateMetadataOverride checks that the coin metadata overriding the one
+// derived from the details of an ERC20 token is valid and that its base is the
+// erc20/{address} denomination of the token
+func ValidateMetadataOverride(metadata banktypes.Metadata, contract common.Address) error {
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMetadataOverride, err.Error())
+	}
+
+	if base := CreateDenom(contract.String()); metadata.Base != base {
+		return sdkerrors.Wrapf(ErrInvalidMetadataOverride, "base %s must be %s", metadata.Base, base)
+	}
+
+	return nil
+}
+
+// NewRegisterERC20Proposal returns new instance of RegisterERC20Proposal. The
+// metadata overrides the coin metadata derived from the ERC20 details if it
+// isn't nil.
+func NewRegisterERC20Proposal(
+	title, description, erc20Addr string,
+	behaviour TokenBehaviour,
+	scalingExponent uint32,
+	gasLimit uint64,
+	metadata *banktypes.Metadata,
+) govtypes.Content {
 	return &RegisterERC20Proposal{
-		Title:        title,
-		Description:  description,
-		Erc20Address: erc20Addr,
+		Title:           title,
+		Description:     description,
+		Erc20Address:    erc20Addr,
+		Behaviour:       behaviour,
+		ScalingExponent: scalingExponent,
+		GasLimit:        gasLimit,
+		Metadata:        metadata,
 	}
 }
 
@@ -143,6 +225,20 @@ func (rtbp *RegisterERC20Proposal) ValidateBasic() error {
 	if err := ethermint.ValidateAddress(rtbp.Erc20Address); err != nil {
 		return sdkerrors.Wrap(err, "ERC20 address")
 	}
+	if err := ValidateTokenBehaviour(rtbp.Behaviour); err != nil {
+		return err
+	}
+	if err := ValidateScalingExponent(rtbp.ScalingExponent); err != nil {
+		return err
+	}
+	if err := ValidateGasLimit(rtbp.GasLimit); err != nil {
+		return err
+	}
+	if rtbp.Metadata != nil {
+		if err := ValidateMetadataOverride(*rtbp.Metadata, common.HexToAddress(rtbp.Erc20Address)); err != nil {
+			return err
+		}
+	}
 	return govtypes.ValidateAbstract(rtbp)
 }
 
@@ -216,3 +312,428 @@ func (p UpdateTokenPairERC20Proposal) GetERC20Address() common.Address {
 func (p UpdateTokenPairERC20Proposal) GetNewERC20Address() common.Address {
 	return common.HexToAddress(p.NewErc20Address)
 }
+
+// NewDeregisterTokenPairProposal returns new instance of DeregisterTokenPairProposal
+func NewDeregisterTokenPairProposal(title, description string, token string, holders []string) govtypes.Content {
+	return &DeregisterTokenPairProposal{
+		Title:       title,
+		Description: description,
+		Token:       token,
+		Holders:     holders,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*DeregisterTokenPairProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*DeregisterTokenPairProposal) ProposalType() string {
+	return ProposalTypeDeregisterTokenPair
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *DeregisterTokenPairProposal) ValidateBasic() error {
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(p.Token); err != nil {
+		if err := sdk.ValidateDenom(p.Token); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, holder := range p.Holders {
+		addr, err := parseReceiver(holder)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "invalid holder %s", holder)
+		}
+
+		if seen[string(addr)] {
+			return fmt.Errorf("duplicated holder %s", holder)
+		}
+
+		seen[string(addr)] = true
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+// GetHolderAddresses returns the account addresses of the holders to refund
+func (p DeregisterTokenPairProposal) GetHolderAddresses() ([]sdk.AccAddress, error) {
+	holders := make([]sdk.AccAddress, len(p.Holders))
+	for i, holder := range p.Holders {
+		addr, err := parseReceiver(holder)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid holder %s", holder)
+		}
+		holders[i] = addr
+	}
+
+	return holders, nil
+}
+
+// NewUpdateRateLimitProposal returns new instance of UpdateRateLimitProposal
+func NewUpdateRateLimitProposal(
+	title, description, token string,
+	maxPerConversion, inflowCap, outflowCap sdk.Int,
+	window uint64,
+	epochIdentifier string,
+) govtypes.Content {
+	return &UpdateRateLimitProposal{
+		Title:            title,
+		Description:      description,
+		Token:            token,
+		MaxPerConversion: maxPerConversion,
+		InflowCap:        inflowCap,
+		OutflowCap:       outflowCap,
+		Window:           window,
+		EpochIdentifier:  epochIdentifier,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpdateRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpdateRateLimitProposal) ProposalType() string {
+	return ProposalTypeUpdateRateLimit
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *UpdateRateLimitProposal) ValidateBasic() error {
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(p.Token); err != nil {
+		if err := sdk.ValidateDenom(p.Token); err != nil {
+			return err
+		}
+	}
+
+	if err := validateRateLimit(p.MaxPerConversion, p.InflowCap, p.OutflowCap, p.Window, p.EpochIdentifier); err != nil {
+		return err
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+// NewUpgradeERC20ImplementationProposal returns new instance of UpgradeERC20ImplementationProposal
+func NewUpgradeERC20ImplementationProposal(title, description, implementation string, erc20Addresses ...string) govtypes.Content {
+	return &UpgradeERC20ImplementationProposal{
+		Title:          title,
+		Description:    description,
+		Implementation: implementation,
+		Erc20Addresses: erc20Addresses,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpgradeERC20ImplementationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpgradeERC20ImplementationProposal) ProposalType() string {
+	return ProposalTypeUpgradeERC20Implementation
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *UpgradeERC20ImplementationProposal) ValidateBasic() error {
+	if strings.TrimSpace(p.Implementation) == "" {
+		return sdkerrors.Wrap(ErrInvalidImplementation, "implementation name cannot be blank")
+	}
+
+	seen := make(map[common.Address]bool)
+	for _, address := range p.Erc20Addresses {
+		if err := ethermint.ValidateAddress(address); err != nil {
+			return sdkerrors.Wrap(err, "ERC20 address")
+		}
+
+		contract := common.HexToAddress(address)
+		if seen[contract] {
+			return fmt.Errorf("duplicated ERC20 address %s", address)
+		}
+		seen[contract] = true
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+// NewUpdateCoinMetadataProposal returns new instance of UpdateCoinMetadataProposal
+func NewUpdateCoinMetadataProposal(title, description string, coinMetadata banktypes.Metadata) govtypes.Content {
+	return &UpdateCoinMetadataProposal{
+		Title:       title,
+		Description: description,
+		Metadata:    coinMetadata,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpdateCoinMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpdateCoinMetadataProposal) ProposalType() string {
+	return ProposalTypeUpdateCoinMetadata
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *UpdateCoinMetadataProposal) ValidateBasic() error {
+	if err := p.Metadata.Validate(); err != nil {
+		return err
+	}
+
+	// the coins of native ERC20 token pairs use the erc20/ denomination
+	if err := ValidateErc20Denom(p.Metadata.Base); err != nil {
+		if err := ibctransfertypes.ValidateIBCDenom(p.Metadata.Base); err != nil {
+			return err
+		}
+
+		if err := validateIBC(p.Metadata); err != nil {
+			return err
+		}
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+// NewVetoERC20RegistrationProposal returns new instance of VetoERC20RegistrationProposal
+func NewVetoERC20RegistrationProposal(title, description, erc20Addr string) govtypes.Content {
+	return &VetoERC20RegistrationProposal{
+		Title:        title,
+		Description:  description,
+		Erc20Address: erc20Addr,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*VetoERC20RegistrationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*VetoERC20RegistrationProposal) ProposalType() string {
+	return ProposalTypeVetoERC20Registration
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *VetoERC20RegistrationProposal) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(p.Erc20Address); err != nil {
+		return sdkerrors.Wrap(err, "ERC20 address")
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+// NewExtendPauseProposal returns new instance of ExtendPauseProposal
+func NewExtendPauseProposal(title, description, token string, duration time.Duration) govtypes.Content {
+	return &ExtendPauseProposal{
+		Title:       title,
+		Description: description,
+		Token:       token,
+		Duration:    duration,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*ExtendPauseProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*ExtendPauseProposal) ProposalType() string {
+	return ProposalTypeExtendPause
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *ExtendPauseProposal) ValidateBasic() error {
+	// an empty token refers to the pause of the whole module. Otherwise, check
+	// if the token is a hex address, if not, check if it is a valid SDK denom
+	if p.Token != "" {
+		if err := ethermint.ValidateAddress(p.Token); err != nil {
+			if err := sdk.ValidateDenom(p.Token); err != nil {
+				return err
+			}
+		}
+	}
+
+	if p.Duration < 0 {
+		return fmt.Errorf("pause duration cannot be negative: %s", p.Duration)
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+// NewUpdateConversionFeeProposal returns new instance of UpdateConversionFeeProposal
+func NewUpdateConversionFeeProposal(
+	title, description, token string,
+	rate sdk.Dec,
+	minFee sdk.Int,
+	useGlobalFee bool,
+) govtypes.Content {
+	return &UpdateConversionFeeProposal{
+		Title:        title,
+		Description:  description,
+		Token:        token,
+		Rate:         rate,
+		MinFee:       minFee,
+		UseGlobalFee: useGlobalFee,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpdateConversionFeeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpdateConversionFeeProposal) ProposalType() string {
+	return ProposalTypeUpdateConversionFee
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *UpdateConversionFeeProposal) ValidateBasic() error {
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(p.Token); err != nil {
+		if err := sdk.ValidateDenom(p.Token); err != nil {
+			return err
+		}
+	}
+
+	if !p.UseGlobalFee {
+		if err := validateConversionFee(p.Rate, p.MinFee); err != nil {
+			return err
+		}
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+// NewUpdateMintCapProposal returns new instance of UpdateMintCapProposal
+func NewUpdateMintCapProposal(title, description, token string, mintCap sdk.Int) govtypes.Content {
+	return &UpdateMintCapProposal{
+		Title:       title,
+		Description: description,
+		Token:       token,
+		Cap:         mintCap,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpdateMintCapProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpdateMintCapProposal) ProposalType() string {
+	return ProposalTypeUpdateMintCap
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *UpdateMintCapProposal) ValidateBasic() error {
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(p.Token); err != nil {
+		if err := sdk.ValidateDenom(p.Token); err != nil {
+			return err
+		}
+	}
+
+	if err := validateMintCap(p.Cap); err != nil {
+		return err
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+// NewUpdateSafetyPolicyProposal returns new instance of UpdateSafetyPolicyProposal
+func NewUpdateSafetyPolicyProposal(
+	title, description, token string,
+	allowedEventSignatures, allowedEmitters []string,
+	maxLogs uint32,
+	useDefaultPolicy bool,
+) govtypes.Content {
+	return &UpdateSafetyPolicyProposal{
+		Title:                  title,
+		Description:            description,
+		Token:                  token,
+		AllowedEventSignatures: allowedEventSignatures,
+		AllowedEmitters:        allowedEmitters,
+		MaxLogs:                maxLogs,
+		UseDefaultPolicy:       useDefaultPolicy,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpdateSafetyPolicyProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpdateSafetyPolicyProposal) ProposalType() string {
+	return ProposalTypeUpdateSafetyPolicy
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *UpdateSafetyPolicyProposal) ValidateBasic() error {
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(p.Token); err != nil {
+		if err := sdk.ValidateDenom(p.Token); err != nil {
+			return err
+		}
+	}
+
+	if !p.UseDefaultPolicy {
+		if err := validateSafetyPolicy(p.AllowedEventSignatures, p.AllowedEmitters); err != nil {
+			return err
+		}
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+// NewRecoverTokenPairEscrowProposal returns new instance of
+// RecoverTokenPairEscrowProposal
+func NewRecoverTokenPairEscrowProposal(
+	title, description string,
+	contract common.Address,
+	shares []EscrowRecoveryShare,
+) govtypes.Content {
+	return &RecoverTokenPairEscrowProposal{
+		Title:        title,
+		Description:  description,
+		Erc20Address: contract.String(),
+		Shares:       shares,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RecoverTokenPairEscrowProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RecoverTokenPairEscrowProposal) ProposalType() string {
+	return ProposalTypeRecoverTokenPairEscrow
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *RecoverTokenPairEscrowProposal) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(p.Erc20Address); err != nil {
+		return sdkerrors.Wrap(err, "ERC20 address")
+	}
+
+	if err := validateEscrowRecoveryShares(p.Shares); err != nil {
+		return err
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+// NewRegisterWrappedEVMDenomProposal returns new instance of
+// RegisterWrappedEVMDenomProposal
+func NewRegisterWrappedEVMDenomProposal(title, description string) govtypes.Content {
+	return &RegisterWrappedEVMDenomProposal{
+		Title:       title,
+		Description: description,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RegisterWrappedEVMDenomProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RegisterWrappedEVMDenomProposal) ProposalType() string {
+	return ProposalTypeRegisterWrappedEVMDenom
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *RegisterWrappedEVMDenomProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
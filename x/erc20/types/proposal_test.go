@@ -3,11 +3,13 @@ package types
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	"github.com/tharsis/ethermint/tests"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	length "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/ethereum/go-ethereum/common"
@@ -30,6 +32,20 @@ func (suite *ProposalTestSuite) TestKeysTypes() {
 	suite.Require().Equal("UpdateTokenPairERC20", (&UpdateTokenPairERC20Proposal{}).ProposalType())
 	suite.Require().Equal("erc20", (&ToggleTokenRelayProposal{}).ProposalRoute())
 	suite.Require().Equal("ToggleTokenRelay", (&ToggleTokenRelayProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&DeregisterTokenPairProposal{}).ProposalRoute())
+	suite.Require().Equal("DeregisterTokenPair", (&DeregisterTokenPairProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&UpdateRateLimitProposal{}).ProposalRoute())
+	suite.Require().Equal("UpdateRateLimit", (&UpdateRateLimitProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&UpgradeERC20ImplementationProposal{}).ProposalRoute())
+	suite.Require().Equal("UpgradeERC20Implementation", (&UpgradeERC20ImplementationProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&UpdateCoinMetadataProposal{}).ProposalRoute())
+	suite.Require().Equal("UpdateCoinMetadata", (&UpdateCoinMetadataProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&VetoERC20RegistrationProposal{}).ProposalRoute())
+	suite.Require().Equal("VetoERC20Registration", (&VetoERC20RegistrationProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&ExtendPauseProposal{}).ProposalRoute())
+	suite.Require().Equal("ExtendPause", (&ExtendPauseProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&UpdateConversionFeeProposal{}).ProposalRoute())
+	suite.Require().Equal("UpdateConversionFee", (&UpdateConversionFeeProposal{}).ProposalType())
 }
 
 func (suite *ProposalTestSuite) TestValidateErc20Denom() {
@@ -84,20 +100,88 @@ func (suite *ProposalTestSuite) TestRegisterERC20Proposal() {
 		expectPass  bool
 	}{
 		// Valid tests
-		{msg: "Register token pair - valid pair enabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE}, expectPass: true},
-		{msg: "Register token pair - valid pair dissabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE}, expectPass: true},
+		{msg: "Register token pair - valid pair enabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, 0, 0}, expectPass: true},
+		{msg: "Register token pair - valid pair dissabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, 0, 0}, expectPass: true},
 		// Missing params valid
-		{msg: "Register token pair - invalid missing title ", title: "", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid missing description ", title: "test", description: "", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE}, expectPass: false},
+		{msg: "Register token pair - invalid missing title ", title: "", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, 0, 0}, expectPass: false},
+		{msg: "Register token pair - invalid missing description ", title: "test", description: "", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, 0, 0}, expectPass: false},
 		// Invalid address
-		{msg: "Register token pair - invalid address (no hex)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 1)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 2)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid prefix)", title: "test", description: "test desc", pair: TokenPair{"1x5dCA2483280D9727c80b5518faC4556617fb19F", "test", true, OWNER_MODULE}, expectPass: false},
+		{msg: "Register token pair - invalid address (no hex)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, 0, 0}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 1)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, 0, 0}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 2)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, 0, 0}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid prefix)", title: "test", description: "test desc", pair: TokenPair{"1x5dCA2483280D9727c80b5518faC4556617fb19F", "test", true, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, 0, 0}, expectPass: false},
+		// Token behaviours
+		{msg: "Register token pair - valid safe transfer behaviour", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TOKEN_BEHAVIOUR_SAFE_TRANSFER, 0, 0}, expectPass: true},
+		{msg: "Register token pair - valid fee on transfer behaviour", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TOKEN_BEHAVIOUR_FEE_ON_TRANSFER, 0, 0}, expectPass: true},
+		{msg: "Register token pair - invalid rebasing behaviour", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TOKEN_BEHAVIOUR_REBASING, 0, 0}, expectPass: false},
+		{msg: "Register token pair - invalid unknown behaviour", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TokenBehaviour(10), 0, 0}, expectPass: false},
+		// Scaling
+		{msg: "Register token pair - valid scaling exponent", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TOKEN_BEHAVIOUR_STANDARD, 12, 0}, expectPass: true},
+		{msg: "Register token pair - invalid scaling exponent", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TOKEN_BEHAVIOUR_STANDARD, MaxScalingExponent + 1, 0}, expectPass: false},
+
+		// Gas limit
+		{msg: "Register token pair - valid gas limit", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TOKEN_BEHAVIOUR_STANDARD, 0, 200000}, expectPass: true},
+		{msg: "Register token pair - gas limit lower than the intrinsic gas", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TOKEN_BEHAVIOUR_STANDARD, 0, 20000}, expectPass: false},
 	}
 
 	for i, tc := range testCases {
-		tx := NewRegisterERC20Proposal(tc.title, tc.description, tc.pair.Erc20Address)
+		tx := NewRegisterERC20Proposal(tc.title, tc.description, tc.pair.Erc20Address, tc.pair.Behaviour, tc.pair.ScalingExponent, tc.pair.GasLimit, nil)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestRegisterERC20ProposalMetadataOverride() {
+	contract := tests.GenerateAddress()
+	base := CreateDenom(contract.String())
+
+	testCases := []struct {
+		msg        string
+		metadata   banktypes.Metadata
+		expectPass bool
+	}{
+		{
+			msg: "valid metadata override",
+			metadata: banktypes.Metadata{
+				Description: "Wrapped Ether", Base: base, Display: "weth", Name: "Wrapped Ether (PoS)", Symbol: "WETH",
+				DenomUnits: []*banktypes.DenomUnit{{Denom: base, Exponent: 0}, {Denom: "weth", Exponent: 18}},
+			},
+			expectPass: true,
+		},
+		{
+			msg: "base is not the erc20 denomination",
+			metadata: banktypes.Metadata{
+				Description: "Wrapped Ether", Base: "aweth", Display: "weth", Name: "Wrapped Ether (PoS)", Symbol: "WETH",
+				DenomUnits: []*banktypes.DenomUnit{{Denom: "aweth", Exponent: 0}, {Denom: "weth", Exponent: 18}},
+			},
+			expectPass: false,
+		},
+		{
+			msg: "base of another contract",
+			metadata: banktypes.Metadata{
+				Description: "Wrapped Ether", Base: CreateDenom(tests.GenerateAddress().String()), Display: "weth", Name: "Wrapped Ether (PoS)", Symbol: "WETH",
+				DenomUnits: []*banktypes.DenomUnit{{Denom: base, Exponent: 0}, {Denom: "weth", Exponent: 18}},
+			},
+			expectPass: false,
+		},
+		{
+			msg: "display unit not found",
+			metadata: banktypes.Metadata{
+				Description: "Wrapped Ether", Base: base, Display: "eth", Name: "Wrapped Ether (PoS)", Symbol: "WETH",
+				DenomUnits: []*banktypes.DenomUnit{{Denom: base, Exponent: 0}, {Denom: "weth", Exponent: 18}},
+			},
+			expectPass: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		metadata := tc.metadata
+		tx := NewRegisterERC20Proposal("test", "test desc", contract.String(), TOKEN_BEHAVIOUR_STANDARD, 0, 0, &metadata)
 		err := tx.ValidateBasic()
 
 		if tc.expectPass {
@@ -162,6 +246,8 @@ func (suite *ProposalTestSuite) TestRegisterCoinProposal() {
 		title       string
 		description string
 		metadata    banktypes.Metadata
+		scaling     uint32
+		gasLimit    uint64
 		expectPass  bool
 	}{
 		// Valid tests
@@ -184,10 +270,18 @@ func (suite *ProposalTestSuite) TestRegisterCoinProposal() {
 		{msg: "Register token pair - ibc invalid denom", title: "test", description: "test desc", metadata: createFullMetadata("ibc/", validIBCSymbol, validIBCName), expectPass: false},
 		{msg: "Register token pair - ibc invalid symbol", title: "test", description: "test desc", metadata: createFullMetadata(validIBCDenom, "badSymbol", validIBCName), expectPass: false},
 		{msg: "Register token pair - ibc invalid name", title: "test", description: "test desc", metadata: createFullMetadata(validIBCDenom, validIBCSymbol, validIBCDenom), expectPass: false},
+
+		// Scaling
+		{msg: "Register token pair - valid scaling exponent", title: "test", description: "test desc", metadata: validMetadata, scaling: 12, expectPass: true},
+		{msg: "Register token pair - invalid scaling exponent", title: "test", description: "test desc", metadata: validMetadata, scaling: MaxScalingExponent + 1, expectPass: false},
+
+		// Gas limit
+		{msg: "Register token pair - valid gas limit", title: "test", description: "test desc", metadata: validMetadata, gasLimit: 200000, expectPass: true},
+		{msg: "Register token pair - gas limit lower than the intrinsic gas", title: "test", description: "test desc", metadata: validMetadata, gasLimit: 20000, expectPass: false},
 	}
 
 	for i, tc := range testCases {
-		tx := NewRegisterCoinProposal(tc.title, tc.description, tc.metadata)
+		tx := NewRegisterCoinProposal(tc.title, tc.description, tc.metadata, tc.scaling, tc.gasLimit)
 		err := tx.ValidateBasic()
 
 		if tc.expectPass {
@@ -301,3 +395,403 @@ func (suite *ProposalTestSuite) TestUpdateTokenPairERC20ProposalGetERC20Addresse
 	suite.Require().Equal(addr, proposal.GetERC20Address())
 	suite.Require().Equal(addrNew, proposal.GetNewERC20Address())
 }
+
+func (suite *ProposalTestSuite) TestDeregisterTokenPairProposal() {
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		token       string
+		holders     []string
+		expectPass  bool
+	}{
+		{msg: "deregister token pair - valid holders", title: "test", description: "test desc", token: "test", holders: []string{"0x5dCA2483280D9727c80b5518faC4556617fb194F", sdk.AccAddress(tests.GenerateAddress().Bytes()).String()}, expectPass: true},
+		{msg: "deregister token pair - invalid holder", title: "test", description: "test desc", token: "test", holders: []string{"0x123"}, expectPass: false},
+		{msg: "deregister token pair - duplicated holder", title: "test", description: "test desc", token: "test", holders: []string{"0x5dCA2483280D9727c80b5518faC4556617fb194F", "0x5dca2483280d9727c80b5518fac4556617fb194f"}, expectPass: false},
+		{msg: "deregister token pair - valid denom", title: "test", description: "test desc", token: "test", expectPass: true},
+		{msg: "deregister token pair - valid address", title: "test", description: "test desc", token: "0x5dCA2483280D9727c80b5518faC4556617fb194F", expectPass: true},
+		{msg: "deregister token pair - invalid address", title: "test", description: "test desc", token: "0x123", expectPass: false},
+		{msg: "deregister token pair - missing title", title: "", description: "test desc", token: "test", expectPass: false},
+		{msg: "deregister token pair - missing description", title: "test", description: "", token: "test", expectPass: false},
+		{msg: "deregister token pair - missing token", title: "test", description: "test desc", token: "", expectPass: false},
+		{msg: "deregister token pair - invalid denom", title: "test", description: "test desc", token: "^test", expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewDeregisterTokenPairProposal(tc.title, tc.description, tc.token, tc.holders)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestUpdateRateLimitProposal() {
+	testCases := []struct {
+		msg              string
+		title            string
+		description      string
+		token            string
+		maxPerConversion sdk.Int
+		inflowCap        sdk.Int
+		outflowCap       sdk.Int
+		window           uint64
+		epochIdentifier  string
+		expectPass       bool
+	}{
+		{msg: "update rate limit - valid denom", title: "test", description: "test desc", token: "test", maxPerConversion: sdk.NewInt(10), inflowCap: sdk.NewInt(100), outflowCap: sdk.NewInt(100), window: 10, expectPass: true},
+		{msg: "update rate limit - valid address", title: "test", description: "test desc", token: "0x5dCA2483280D9727c80b5518faC4556617fb194F", maxPerConversion: sdk.NewInt(10), inflowCap: sdk.ZeroInt(), outflowCap: sdk.ZeroInt(), window: 0, expectPass: true},
+		{msg: "update rate limit - remove limits", title: "test", description: "test desc", token: "test", maxPerConversion: sdk.ZeroInt(), inflowCap: sdk.ZeroInt(), outflowCap: sdk.ZeroInt(), window: 0, expectPass: true},
+		{msg: "update rate limit - invalid address", title: "test", description: "test desc", token: "0x123", maxPerConversion: sdk.NewInt(10), inflowCap: sdk.ZeroInt(), outflowCap: sdk.ZeroInt(), window: 0, expectPass: false},
+		{msg: "update rate limit - missing title", title: "", description: "test desc", token: "test", maxPerConversion: sdk.NewInt(10), inflowCap: sdk.ZeroInt(), outflowCap: sdk.ZeroInt(), window: 0, expectPass: false},
+		{msg: "update rate limit - negative max per conversion", title: "test", description: "test desc", token: "test", maxPerConversion: sdk.NewInt(-1), inflowCap: sdk.ZeroInt(), outflowCap: sdk.ZeroInt(), window: 0, expectPass: false},
+		{msg: "update rate limit - negative inflow cap", title: "test", description: "test desc", token: "test", maxPerConversion: sdk.ZeroInt(), inflowCap: sdk.NewInt(-1), outflowCap: sdk.ZeroInt(), window: 10, expectPass: false},
+		{msg: "update rate limit - nil outflow cap", title: "test", description: "test desc", token: "test", maxPerConversion: sdk.ZeroInt(), inflowCap: sdk.ZeroInt(), outflowCap: sdk.Int{}, window: 10, expectPass: false},
+		{msg: "update rate limit - zero window", title: "test", description: "test desc", token: "test", maxPerConversion: sdk.ZeroInt(), inflowCap: sdk.ZeroInt(), outflowCap: sdk.NewInt(100), window: 0, expectPass: false},
+		{msg: "update rate limit - epoch window", title: "test", description: "test desc", token: "test", maxPerConversion: sdk.ZeroInt(), inflowCap: sdk.NewInt(100), outflowCap: sdk.NewInt(100), window: 7, epochIdentifier: "day", expectPass: true},
+		{msg: "update rate limit - zero epoch window", title: "test", description: "test desc", token: "test", maxPerConversion: sdk.NewInt(10), inflowCap: sdk.ZeroInt(), outflowCap: sdk.ZeroInt(), window: 0, epochIdentifier: "day", expectPass: false},
+		{msg: "update rate limit - blank epoch identifier", title: "test", description: "test desc", token: "test", maxPerConversion: sdk.ZeroInt(), inflowCap: sdk.NewInt(100), outflowCap: sdk.ZeroInt(), window: 7, epochIdentifier: " ", expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewUpdateRateLimitProposal(tc.title, tc.description, tc.token, tc.maxPerConversion, tc.inflowCap, tc.outflowCap, tc.window, tc.epochIdentifier)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestUpdateConversionFeeProposal() {
+	testCases := []struct {
+		msg          string
+		title        string
+		description  string
+		token        string
+		rate         sdk.Dec
+		minFee       sdk.Int
+		useGlobalFee bool
+		expectPass   bool
+	}{
+		{msg: "update conversion fee - valid denom", title: "test", description: "test desc", token: "test", rate: sdk.NewDecWithPrec(1, 3), minFee: sdk.NewInt(10), expectPass: true},
+		{msg: "update conversion fee - valid address", title: "test", description: "test desc", token: "0x5dCA2483280D9727c80b5518faC4556617fb194F", rate: sdk.ZeroDec(), minFee: sdk.ZeroInt(), expectPass: true},
+		{msg: "update conversion fee - use global fee", title: "test", description: "test desc", token: "test", rate: sdk.Dec{}, minFee: sdk.Int{}, useGlobalFee: true, expectPass: true},
+		{msg: "update conversion fee - invalid address", title: "test", description: "test desc", token: "0x123", rate: sdk.NewDecWithPrec(1, 3), minFee: sdk.ZeroInt(), expectPass: false},
+		{msg: "update conversion fee - missing title", title: "", description: "test desc", token: "test", rate: sdk.NewDecWithPrec(1, 3), minFee: sdk.ZeroInt(), expectPass: false},
+		{msg: "update conversion fee - rate of 100%", title: "test", description: "test desc", token: "test", rate: sdk.OneDec(), minFee: sdk.ZeroInt(), expectPass: false},
+		{msg: "update conversion fee - negative min fee", title: "test", description: "test desc", token: "test", rate: sdk.ZeroDec(), minFee: sdk.NewInt(-1), expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewUpdateConversionFeeProposal(tc.title, tc.description, tc.token, tc.rate, tc.minFee, tc.useGlobalFee)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestUpdateMintCapProposal() {
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		token       string
+		mintCap     sdk.Int
+		expectPass  bool
+	}{
+		{msg: "update mint cap - valid denom", title: "test", description: "test desc", token: "test", mintCap: sdk.NewInt(1000), expectPass: true},
+		{msg: "update mint cap - valid address", title: "test", description: "test desc", token: "0x5dCA2483280D9727c80b5518faC4556617fb194F", mintCap: sdk.NewInt(1000), expectPass: true},
+		{msg: "update mint cap - remove cap", title: "test", description: "test desc", token: "test", mintCap: sdk.ZeroInt(), expectPass: true},
+		{msg: "update mint cap - invalid address", title: "test", description: "test desc", token: "0x123", mintCap: sdk.NewInt(1000), expectPass: false},
+		{msg: "update mint cap - missing title", title: "", description: "test desc", token: "test", mintCap: sdk.NewInt(1000), expectPass: false},
+		{msg: "update mint cap - negative cap", title: "test", description: "test desc", token: "test", mintCap: sdk.NewInt(-1), expectPass: false},
+		{msg: "update mint cap - nil cap", title: "test", description: "test desc", token: "test", mintCap: sdk.Int{}, expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewUpdateMintCapProposal(tc.title, tc.description, tc.token, tc.mintCap)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestRecoverTokenPairEscrowProposal() {
+	contract := tests.GenerateAddress()
+	holder := tests.GenerateAddress()
+	bech32 := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		contract    string
+		shares      []EscrowRecoveryShare
+		expectPass  bool
+	}{
+		{msg: "recover escrow - community pool", title: "test", description: "test desc", contract: contract.String(), expectPass: true},
+		{
+			msg: "recover escrow - hex and bech32 shares", title: "test", description: "test desc", contract: contract.String(),
+			shares:     []EscrowRecoveryShare{{holder.String(), sdk.NewInt(70)}, {bech32, sdk.NewInt(30)}},
+			expectPass: true,
+		},
+		{msg: "recover escrow - invalid address", title: "test", description: "test desc", contract: "0x123", expectPass: false},
+		{msg: "recover escrow - missing title", title: "", description: "test desc", contract: contract.String(), expectPass: false},
+		{
+			msg: "recover escrow - invalid share address", title: "test", description: "test desc", contract: contract.String(),
+			shares:     []EscrowRecoveryShare{{"invalid", sdk.NewInt(70)}},
+			expectPass: false,
+		},
+		{
+			msg: "recover escrow - zero share", title: "test", description: "test desc", contract: contract.String(),
+			shares:     []EscrowRecoveryShare{{holder.String(), sdk.ZeroInt()}},
+			expectPass: false,
+		},
+		{
+			msg: "recover escrow - nil share", title: "test", description: "test desc", contract: contract.String(),
+			shares:     []EscrowRecoveryShare{{holder.String(), sdk.Int{}}},
+			expectPass: false,
+		},
+		{
+			msg: "recover escrow - duplicated hex and bech32 share", title: "test", description: "test desc", contract: contract.String(),
+			shares:     []EscrowRecoveryShare{{holder.String(), sdk.NewInt(70)}, {sdk.AccAddress(holder.Bytes()).String(), sdk.NewInt(30)}},
+			expectPass: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := &RecoverTokenPairEscrowProposal{tc.title, tc.description, tc.contract, tc.shares}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+
+	content := NewRecoverTokenPairEscrowProposal("test", "test desc", contract, nil)
+	suite.Require().Equal(RouterKey, content.ProposalRoute())
+	suite.Require().Equal(ProposalTypeRecoverTokenPairEscrow, content.ProposalType())
+}
+
+func (suite *ProposalTestSuite) TestUpdateSafetyPolicyProposal() {
+	testCases := []struct {
+		msg              string
+		title            string
+		description      string
+		token            string
+		allowedEvents    []string
+		allowedEmitters  []string
+		maxLogs          uint32
+		useDefaultPolicy bool
+		expectPass       bool
+	}{
+		{msg: "update safety policy - valid denom", title: "test", description: "test desc", token: "test", allowedEvents: []string{"Transfer(address,address,uint256)"}, maxLogs: 2, expectPass: true},
+		{msg: "update safety policy - valid address", title: "test", description: "test desc", token: "0x5dCA2483280D9727c80b5518faC4556617fb194F", allowedEmitters: []string{"0xB8A0BA0A2ce1A3Bd6B5F7Fb2cE8B7BF0dEe4E5e8"}, expectPass: true},
+		{msg: "update safety policy - use default policy", title: "test", description: "test desc", token: "test", useDefaultPolicy: true, expectPass: true},
+		{msg: "update safety policy - invalid address", title: "test", description: "test desc", token: "0x123", expectPass: false},
+		{msg: "update safety policy - missing title", title: "", description: "test desc", token: "test", expectPass: false},
+		{msg: "update safety policy - invalid event signature", title: "test", description: "test desc", token: "test", allowedEvents: []string{"Transfer"}, expectPass: false},
+		{msg: "update safety policy - invalid emitter", title: "test", description: "test desc", token: "test", allowedEmitters: []string{"0x123"}, expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewUpdateSafetyPolicyProposal(tc.title, tc.description, tc.token, tc.allowedEvents, tc.allowedEmitters, tc.maxLogs, tc.useDefaultPolicy)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestUpgradeERC20ImplementationProposal() {
+	testCases := []struct {
+		msg            string
+		title          string
+		description    string
+		implementation string
+		erc20Addresses []string
+		expectPass     bool
+	}{
+		{msg: "upgrade implementation - all proxies", title: "test", description: "test desc", implementation: "ERC20MinterBurnerDecimals", expectPass: true},
+		{msg: "upgrade implementation - given proxies", title: "test", description: "test desc", implementation: "ERC20MinterBurnerDecimals", erc20Addresses: []string{"0x5dCA2483280D9727c80b5518faC4556617fb194F", "0xB8A0BA0A2ce1A3Bd6B5F7Fb2cE8B7BF0dEe4E5e8"}, expectPass: true},
+		{msg: "upgrade implementation - missing implementation", title: "test", description: "test desc", implementation: " ", expectPass: false},
+		{msg: "upgrade implementation - invalid address", title: "test", description: "test desc", implementation: "ERC20MinterBurnerDecimals", erc20Addresses: []string{"0x123"}, expectPass: false},
+		{msg: "upgrade implementation - duplicated address", title: "test", description: "test desc", implementation: "ERC20MinterBurnerDecimals", erc20Addresses: []string{"0x5dCA2483280D9727c80b5518faC4556617fb194F", "0x5dca2483280d9727c80b5518fac4556617fb194f"}, expectPass: false},
+		{msg: "upgrade implementation - missing title", title: "", description: "test desc", implementation: "ERC20MinterBurnerDecimals", expectPass: false},
+		{msg: "upgrade implementation - missing description", title: "test", description: "", implementation: "ERC20MinterBurnerDecimals", expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewUpgradeERC20ImplementationProposal(tc.title, tc.description, tc.implementation, tc.erc20Addresses...)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestUpdateCoinMetadataProposal() {
+	validMetadata := banktypes.Metadata{
+		Description: "desc",
+		Base:        "acoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "acoin", Exponent: 0},
+			{Denom: "coin", Exponent: 18},
+		},
+		Name:    "coin",
+		Symbol:  "token",
+		Display: "coin",
+	}
+	contract := tests.GenerateAddress().String()
+	erc20Metadata := banktypes.Metadata{
+		Description: CreateDenomDescription(contract),
+		Base:        CreateDenom(contract),
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: CreateDenom(contract), Exponent: 0}},
+		Name:        CreateDenom(contract),
+		Symbol:      "token",
+		Display:     CreateDenom(contract),
+	}
+	ibcMetadata := banktypes.Metadata{
+		Description: "desc",
+		Base:        "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", Exponent: 0},
+		},
+		Name:    "Atom channel-0",
+		Symbol:  "ibcATOM-0",
+		Display: "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2",
+	}
+	invalidIBCMetadata := ibcMetadata
+	invalidIBCMetadata.Name = "Atom"
+	invalidMetadata := validMetadata
+	invalidMetadata.Symbol = ""
+
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		metadata    banktypes.Metadata
+		expectPass  bool
+	}{
+		{msg: "update coin metadata - valid metadata", title: "test", description: "test desc", metadata: validMetadata, expectPass: true},
+		{msg: "update coin metadata - native ERC20 metadata", title: "test", description: "test desc", metadata: erc20Metadata, expectPass: true},
+		{msg: "update coin metadata - IBC metadata", title: "test", description: "test desc", metadata: ibcMetadata, expectPass: true},
+		{msg: "update coin metadata - invalid IBC metadata", title: "test", description: "test desc", metadata: invalidIBCMetadata, expectPass: false},
+		{msg: "update coin metadata - invalid metadata", title: "test", description: "test desc", metadata: invalidMetadata, expectPass: false},
+		{msg: "update coin metadata - missing title", title: "", description: "test desc", metadata: validMetadata, expectPass: false},
+		{msg: "update coin metadata - missing description", title: "test", description: "", metadata: validMetadata, expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewUpdateCoinMetadataProposal(tc.title, tc.description, tc.metadata)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestVetoERC20RegistrationProposal() {
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		erc20Addr   string
+		expectPass  bool
+	}{
+		{msg: "veto erc20 registration - valid", title: "test", description: "test desc", erc20Addr: tests.GenerateAddress().String(), expectPass: true},
+		{msg: "veto erc20 registration - invalid address", title: "test", description: "test desc", erc20Addr: "0x123", expectPass: false},
+		{msg: "veto erc20 registration - missing title", title: "", description: "test desc", erc20Addr: tests.GenerateAddress().String(), expectPass: false},
+		{msg: "veto erc20 registration - missing description", title: "test", description: "", erc20Addr: tests.GenerateAddress().String(), expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewVetoERC20RegistrationProposal(tc.title, tc.description, tc.erc20Addr)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestExtendPauseProposal() {
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		token       string
+		duration    time.Duration
+		expectPass  bool
+	}{
+		{msg: "extend pause - valid module pause", title: "test", description: "test desc", token: "", duration: time.Hour, expectPass: true},
+		{msg: "extend pause - valid pair pause", title: "test", description: "test desc", token: tests.GenerateAddress().String(), duration: time.Hour, expectPass: true},
+		{msg: "extend pause - valid lift", title: "test", description: "test desc", token: "acoin", duration: 0, expectPass: true},
+		{msg: "extend pause - invalid token", title: "test", description: "test desc", token: "(invalid)", duration: time.Hour, expectPass: false},
+		{msg: "extend pause - negative duration", title: "test", description: "test desc", token: "", duration: -time.Hour, expectPass: false},
+		{msg: "extend pause - missing title", title: "", description: "test desc", token: "", duration: time.Hour, expectPass: false},
+		{msg: "extend pause - missing description", title: "test", description: "", token: "", duration: time.Hour, expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewExtendPauseProposal(tc.title, tc.description, tc.token, tc.duration)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestRegisterWrappedEVMDenomProposal() {
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		expectPass  bool
+	}{
+		{msg: "register wrapped evm denom - valid", title: "test", description: "test desc", expectPass: true},
+		{msg: "register wrapped evm denom - missing title", title: "", description: "test desc", expectPass: false},
+		{msg: "register wrapped evm denom - missing description", title: "test", description: "", expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewRegisterWrappedEVMDenomProposal(tc.title, tc.description)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/tharsis/ethermint/types"
+
+	epochstypes "github.com/tharsis/evmos/x/epochs/types"
+)
+
+// ConversionDirection defines the direction of a token pair conversion
+type ConversionDirection int
+
+const (
+	// ConversionInflow defines the conversion of Cosmos coins into ERC20 tokens
+	ConversionInflow ConversionDirection = iota + 1
+	// ConversionOutflow defines the conversion of ERC20 tokens into Cosmos coins
+	ConversionOutflow
+)
+
+// NewRateLimit returns an instance of RateLimit
+func NewRateLimit(
+	erc20Address common.Address,
+	maxPerConversion, inflowCap, outflowCap sdk.Int,
+	window uint64,
+	epochIdentifier string,
+) RateLimit {
+	return RateLimit{
+		Erc20Address:     erc20Address.String(),
+		MaxPerConversion: maxPerConversion,
+		InflowCap:        inflowCap,
+		OutflowCap:       outflowCap,
+		Window:           window,
+		EpochIdentifier:  epochIdentifier,
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (rl RateLimit) GetERC20Contract() common.Address {
+	return common.HexToAddress(rl.Erc20Address)
+}
+
+// Validate performs a stateless validation of a RateLimit
+func (rl RateLimit) Validate() error {
+	if err := ethermint.ValidateAddress(rl.Erc20Address); err != nil {
+		return err
+	}
+
+	return validateRateLimit(rl.MaxPerConversion, rl.InflowCap, rl.OutflowCap, rl.Window, rl.EpochIdentifier)
+}
+
+// IsEmpty returns true if all the limits are disabled
+func (rl RateLimit) IsEmpty() bool {
+	return isEmptyRateLimit(rl.MaxPerConversion, rl.InflowCap, rl.OutflowCap)
+}
+
+// IsEpochWindow returns true if the window is measured in epochs instead of
+// blocks
+func (rl RateLimit) IsEpochWindow() bool {
+	return rl.EpochIdentifier != ""
+}
+
+// WindowString returns the human readable length of the window
+func (rl RateLimit) WindowString() string {
+	if rl.IsEpochWindow() {
+		return fmt.Sprintf("%d %s epochs", rl.Window, rl.EpochIdentifier)
+	}
+
+	return fmt.Sprintf("%d blocks", rl.Window)
+}
+
+// NewConversionVolume returns an empty ConversionVolume
+func NewConversionVolume() ConversionVolume {
+	return ConversionVolume{
+		Inflow:  sdk.ZeroInt(),
+		Outflow: sdk.ZeroInt(),
+	}
+}
+
+// Add returns the conversion volume increased by the amount on the given
+// direction
+func (cv ConversionVolume) Add(amount sdk.Int, direction ConversionDirection) ConversionVolume {
+	switch direction {
+	case ConversionInflow:
+		cv.Inflow = cv.Inflow.Add(amount)
+	case ConversionOutflow:
+		cv.Outflow = cv.Outflow.Add(amount)
+	}
+
+	return cv
+}
+
+// NewPeriodConversionVolume returns an instance of PeriodConversionVolume
+func NewPeriodConversionVolume(erc20Address common.Address, period uint64, volume ConversionVolume) PeriodConversionVolume {
+	return PeriodConversionVolume{
+		Erc20Address: erc20Address.String(),
+		Period:       period,
+		Volume:       volume,
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (pcv PeriodConversionVolume) GetERC20Contract() common.Address {
+	return common.HexToAddress(pcv.Erc20Address)
+}
+
+// Validate performs a stateless validation of a PeriodConversionVolume
+func (pcv PeriodConversionVolume) Validate() error {
+	if err := ethermint.ValidateAddress(pcv.Erc20Address); err != nil {
+		return err
+	}
+
+	if pcv.Volume.Inflow.IsNil() || pcv.Volume.Inflow.IsNegative() {
+		return fmt.Errorf("inflow cannot be nil or negative: %v", pcv.Volume.Inflow)
+	}
+
+	if pcv.Volume.Outflow.IsNil() || pcv.Volume.Outflow.IsNegative() {
+		return fmt.Errorf("outflow cannot be nil or negative: %v", pcv.Volume.Outflow)
+	}
+
+	return nil
+}
+
+// NewRateLimitCapacity returns the capacity of the rate limit given the volume
+// converted within the current window
+func NewRateLimitCapacity(rateLimit RateLimit, volume ConversionVolume) RateLimitCapacity {
+	return RateLimitCapacity{
+		RateLimit:        rateLimit,
+		Inflow:           volume.Inflow,
+		Outflow:          volume.Outflow,
+		RemainingInflow:  remainingCapacity(rateLimit.InflowCap, volume.Inflow),
+		RemainingOutflow: remainingCapacity(rateLimit.OutflowCap, volume.Outflow),
+	}
+}
+
+// remaining returns the amount left until the cap is reached
+func remaining(limit, used sdk.Int) sdk.Int {
+	if used.GTE(limit) {
+		return sdk.ZeroInt()
+	}
+
+	return limit.Sub(used)
+}
+
+// remainingCapacity returns the amount left until the rate limit cap is
+// reached. It returns nil if the cap is disabled, as the capacity is
+// unlimited.
+func remainingCapacity(limit, used sdk.Int) *sdk.Int {
+	if limit.IsZero() {
+		return nil
+	}
+
+	left := remaining(limit, used)
+	return &left
+}
+
+func isEmptyRateLimit(maxPerConversion, inflowCap, outflowCap sdk.Int) bool {
+	return maxPerConversion.IsZero() && inflowCap.IsZero() && outflowCap.IsZero()
+}
+
+func validateRateLimit(maxPerConversion, inflowCap, outflowCap sdk.Int, window uint64, epochIdentifier string) error {
+	for _, limit := range []struct {
+		name  string
+		value sdk.Int
+	}{
+		{"max per conversion", maxPerConversion},
+		{"inflow cap", inflowCap},
+		{"outflow cap", outflowCap},
+	} {
+		if limit.value.IsNil() || limit.value.IsNegative() {
+			return fmt.Errorf("%s cannot be nil or negative: %v", limit.name, limit.value)
+		}
+	}
+
+	if (!inflowCap.IsZero() || !outflowCap.IsZero()) && window == 0 {
+		return fmt.Errorf("window cannot be zero if the inflow or outflow caps are set")
+	}
+
+	if epochIdentifier == "" {
+		return nil
+	}
+
+	if err := epochstypes.ValidateEpochIdentifierString(epochIdentifier); err != nil {
+		return err
+	}
+
+	if window == 0 {
+		return fmt.Errorf("window cannot be zero if the epoch identifier is set")
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tharsis/ethermint/tests"
+)
+
+type RateLimitTestSuite struct {
+	suite.Suite
+}
+
+func TestRateLimitSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
+
+func (suite *RateLimitTestSuite) TestRateLimitNew() {
+	addr := tests.GenerateAddress()
+	rl := NewRateLimit(addr, sdk.NewInt(10), sdk.NewInt(100), sdk.NewInt(200), 5, "")
+
+	suite.Require().Equal(addr, rl.GetERC20Contract())
+	suite.Require().NoError(rl.Validate())
+	suite.Require().False(rl.IsEmpty())
+}
+
+func (suite *RateLimitTestSuite) TestRateLimit() {
+	testCases := []struct {
+		msg        string
+		rateLimit  RateLimit
+		expectPass bool
+	}{
+		{msg: "invalid address", rateLimit: RateLimit{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", sdk.NewInt(10), sdk.ZeroInt(), sdk.ZeroInt(), 0, ""}, expectPass: false},
+		{msg: "negative max per conversion", rateLimit: RateLimit{tests.GenerateAddress().String(), sdk.NewInt(-10), sdk.ZeroInt(), sdk.ZeroInt(), 0, ""}, expectPass: false},
+		{msg: "nil inflow cap", rateLimit: RateLimit{tests.GenerateAddress().String(), sdk.NewInt(10), sdk.Int{}, sdk.ZeroInt(), 0, ""}, expectPass: false},
+		{msg: "zero window with outflow cap", rateLimit: RateLimit{tests.GenerateAddress().String(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(10), 0, ""}, expectPass: false},
+		{msg: "pass - max per conversion", rateLimit: RateLimit{tests.GenerateAddress().String(), sdk.NewInt(10), sdk.ZeroInt(), sdk.ZeroInt(), 0, ""}, expectPass: true},
+		{msg: "pass - caps", rateLimit: RateLimit{tests.GenerateAddress().String(), sdk.ZeroInt(), sdk.NewInt(10), sdk.NewInt(10), 100, ""}, expectPass: true},
+		{msg: "zero epoch window", rateLimit: RateLimit{tests.GenerateAddress().String(), sdk.NewInt(10), sdk.ZeroInt(), sdk.ZeroInt(), 0, "day"}, expectPass: false},
+		{msg: "blank epoch identifier", rateLimit: RateLimit{tests.GenerateAddress().String(), sdk.ZeroInt(), sdk.NewInt(10), sdk.NewInt(10), 7, " "}, expectPass: false},
+		{msg: "pass - epoch window", rateLimit: RateLimit{tests.GenerateAddress().String(), sdk.ZeroInt(), sdk.NewInt(10), sdk.NewInt(10), 7, "day"}, expectPass: true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.rateLimit.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *RateLimitTestSuite) TestIsEmpty() {
+	rl := NewRateLimit(tests.GenerateAddress(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), 10, "")
+	suite.Require().True(rl.IsEmpty())
+
+	rl.OutflowCap = sdk.NewInt(1)
+	suite.Require().False(rl.IsEmpty())
+}
+
+func (suite *RateLimitTestSuite) TestConversionVolumeAdd() {
+	volume := NewConversionVolume()
+	volume = volume.Add(sdk.NewInt(10), ConversionInflow)
+	volume = volume.Add(sdk.NewInt(5), ConversionOutflow)
+	volume = volume.Add(sdk.NewInt(1), ConversionInflow)
+
+	suite.Require().Equal(sdk.NewInt(11).String(), volume.Inflow.String())
+	suite.Require().Equal(sdk.NewInt(5).String(), volume.Outflow.String())
+}
+
+func (suite *RateLimitTestSuite) TestPeriodConversionVolume() {
+	testCases := []struct {
+		msg        string
+		volume     PeriodConversionVolume
+		expectPass bool
+	}{
+		{msg: "invalid address", volume: PeriodConversionVolume{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", 1, NewConversionVolume()}, expectPass: false},
+		{msg: "nil inflow", volume: PeriodConversionVolume{tests.GenerateAddress().String(), 1, ConversionVolume{Outflow: sdk.ZeroInt()}}, expectPass: false},
+		{msg: "negative outflow", volume: PeriodConversionVolume{tests.GenerateAddress().String(), 1, ConversionVolume{Inflow: sdk.ZeroInt(), Outflow: sdk.NewInt(-1)}}, expectPass: false},
+		{msg: "pass", volume: NewPeriodConversionVolume(tests.GenerateAddress(), 1, ConversionVolume{Inflow: sdk.NewInt(40), Outflow: sdk.NewInt(20)}), expectPass: true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.volume.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *RateLimitTestSuite) TestNewRateLimitCapacity() {
+	rl := NewRateLimit(tests.GenerateAddress(), sdk.ZeroInt(), sdk.NewInt(100), sdk.NewInt(10), 10, "")
+	volume := ConversionVolume{Inflow: sdk.NewInt(40), Outflow: sdk.NewInt(20)}
+
+	capacity := NewRateLimitCapacity(rl, volume)
+	suite.Require().Equal(rl, capacity.RateLimit)
+	suite.Require().Equal(sdk.NewInt(60).String(), capacity.RemainingInflow.String())
+	suite.Require().Equal(sdk.ZeroInt().String(), capacity.RemainingOutflow.String())
+}
+
+func (suite *RateLimitTestSuite) TestNewRateLimitCapacityUnlimited() {
+	rl := NewRateLimit(tests.GenerateAddress(), sdk.NewInt(10), sdk.ZeroInt(), sdk.NewInt(100), 10, "")
+	volume := ConversionVolume{Inflow: sdk.NewInt(40), Outflow: sdk.NewInt(20)}
+
+	capacity := NewRateLimitCapacity(rl, volume)
+	suite.Require().Nil(capacity.RemainingInflow)
+	suite.Require().Equal(sdk.NewInt(80).String(), capacity.RemainingOutflow.String())
+}
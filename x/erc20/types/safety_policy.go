@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// eventSignatureRegex matches canonical event signatures, i.e. the event name
+// followed by the comma separated parameter types without spaces or names.
+var eventSignatureRegex = regexp.MustCompile(`^[a-zA-Z_$][a-zA-Z0-9_$]*\([a-zA-Z0-9,\[\]()]*\)$`)
+
+// NewSafetyPolicy returns an instance of SafetyPolicy
+func NewSafetyPolicy(
+	erc20Address common.Address,
+	allowedEventSignatures []string,
+	allowedEmitters []string,
+	maxLogs uint32,
+) SafetyPolicy {
+	return SafetyPolicy{
+		Erc20Address:           erc20Address.String(),
+		AllowedEventSignatures: allowedEventSignatures,
+		AllowedEmitters:        allowedEmitters,
+		MaxLogs:                maxLogs,
+	}
+}
+
+// DefaultSafetyPolicy returns the policy that applies to a token pair without
+// a safety policy set by governance.
+func DefaultSafetyPolicy(erc20Address common.Address) SafetyPolicy {
+	return SafetyPolicy{
+		Erc20Address: erc20Address.String(),
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (sp SafetyPolicy) GetERC20Contract() common.Address {
+	return common.HexToAddress(sp.Erc20Address)
+}
+
+// AllowsEvent returns true if a log with the given event topic is allowed by
+// the policy.
+func (sp SafetyPolicy) AllowsEvent(topic common.Hash) bool {
+	if len(sp.AllowedEventSignatures) == 0 {
+		return true
+	}
+
+	for _, signature := range sp.AllowedEventSignatures {
+		if crypto.Keccak256Hash([]byte(signature)) == topic {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllowsEmitter returns true if a log emitted by the given contract is allowed
+// by the policy. The ERC20 contract of the token pair is always allowed.
+func (sp SafetyPolicy) AllowsEmitter(emitter common.Address) bool {
+	if emitter == sp.GetERC20Contract() {
+		return true
+	}
+
+	for _, allowed := range sp.AllowedEmitters {
+		if common.HexToAddress(allowed) == emitter {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate performs a stateless validation of a SafetyPolicy
+func (sp SafetyPolicy) Validate() error {
+	if err := ethermint.ValidateAddress(sp.Erc20Address); err != nil {
+		return err
+	}
+
+	return validateSafetyPolicy(sp.AllowedEventSignatures, sp.AllowedEmitters)
+}
+
+func validateSafetyPolicy(allowedEventSignatures, allowedEmitters []string) error {
+	seenSignatures := make(map[string]bool)
+
+	for _, signature := range allowedEventSignatures {
+		if !eventSignatureRegex.MatchString(signature) {
+			return fmt.Errorf("invalid event signature: '%s'", signature)
+		}
+
+		if seenSignatures[signature] {
+			return fmt.Errorf("duplicated event signature: '%s'", signature)
+		}
+
+		seenSignatures[signature] = true
+	}
+
+	seenEmitters := make(map[common.Address]bool)
+
+	for _, emitter := range allowedEmitters {
+		if err := ethermint.ValidateAddress(emitter); err != nil {
+			return fmt.Errorf("invalid emitter address: %w", err)
+		}
+
+		address := common.HexToAddress(emitter)
+		if seenEmitters[address] {
+			return fmt.Errorf("duplicated emitter address: '%s'", emitter)
+		}
+
+		seenEmitters[address] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tharsis/ethermint/tests"
+)
+
+type SafetyPolicyTestSuite struct {
+	suite.Suite
+}
+
+func TestSafetyPolicySuite(t *testing.T) {
+	suite.Run(t, new(SafetyPolicyTestSuite))
+}
+
+func (suite *SafetyPolicyTestSuite) TestSafetyPolicyNew() {
+	addr := tests.GenerateAddress()
+	sp := NewSafetyPolicy(addr, []string{"Transfer(address,address,uint256)"}, []string{tests.GenerateAddress().String()}, 2)
+
+	suite.Require().Equal(addr, sp.GetERC20Contract())
+	suite.Require().NoError(sp.Validate())
+	suite.Require().NoError(DefaultSafetyPolicy(addr).Validate())
+}
+
+func (suite *SafetyPolicyTestSuite) TestSafetyPolicy() {
+	emitter := "0x5dCA2483280D9727c80b5518faC4556617fb194F"
+
+	testCases := []struct {
+		msg        string
+		policy     SafetyPolicy
+		expectPass bool
+	}{
+		{msg: "invalid address", policy: SafetyPolicy{Erc20Address: "0x5dCA2483280D9727c80b5518faC4556617fb19ZZ"}, expectPass: false},
+		{msg: "invalid event signature - spaces", policy: SafetyPolicy{Erc20Address: tests.GenerateAddress().String(), AllowedEventSignatures: []string{"Transfer(address from, address to, uint256 value)"}}, expectPass: false},
+		{msg: "invalid event signature - no parameters", policy: SafetyPolicy{Erc20Address: tests.GenerateAddress().String(), AllowedEventSignatures: []string{"Transfer"}}, expectPass: false},
+		{msg: "duplicated event signature", policy: SafetyPolicy{Erc20Address: tests.GenerateAddress().String(), AllowedEventSignatures: []string{"Transfer(address,address,uint256)", "Transfer(address,address,uint256)"}}, expectPass: false},
+		{msg: "invalid emitter", policy: SafetyPolicy{Erc20Address: tests.GenerateAddress().String(), AllowedEmitters: []string{"0x123"}}, expectPass: false},
+		{msg: "duplicated emitter", policy: SafetyPolicy{Erc20Address: tests.GenerateAddress().String(), AllowedEmitters: []string{emitter, "0x5dca2483280d9727c80b5518fac4556617fb194f"}}, expectPass: false},
+		{msg: "pass - default policy", policy: SafetyPolicy{Erc20Address: tests.GenerateAddress().String()}, expectPass: true},
+		{msg: "pass", policy: SafetyPolicy{Erc20Address: tests.GenerateAddress().String(), AllowedEventSignatures: []string{"Transfer(address,address,uint256)", "Swap(address,uint256[],(uint256,bytes32))"}, AllowedEmitters: []string{emitter}, MaxLogs: 3}, expectPass: true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.policy.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *SafetyPolicyTestSuite) TestAllowsEvent() {
+	transfer := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+	approval := crypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))
+
+	sp := DefaultSafetyPolicy(tests.GenerateAddress())
+	suite.Require().True(sp.AllowsEvent(transfer))
+	suite.Require().True(sp.AllowsEvent(approval))
+
+	sp.AllowedEventSignatures = []string{"Transfer(address,address,uint256)"}
+	suite.Require().True(sp.AllowsEvent(transfer))
+	suite.Require().False(sp.AllowsEvent(approval))
+}
+
+func (suite *SafetyPolicyTestSuite) TestAllowsEmitter() {
+	erc20 := tests.GenerateAddress()
+	allowed := tests.GenerateAddress()
+
+	sp := DefaultSafetyPolicy(erc20)
+	suite.Require().True(sp.AllowsEmitter(erc20))
+	suite.Require().False(sp.AllowsEmitter(allowed))
+
+	sp.AllowedEmitters = []string{allowed.Hex()}
+	suite.Require().True(sp.AllowsEmitter(erc20))
+	suite.Require().True(sp.AllowsEmitter(allowed))
+	suite.Require().False(sp.AllowsEmitter(tests.GenerateAddress()))
+}
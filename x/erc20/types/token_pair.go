@@ -1,12 +1,22 @@
 package types
 
 import (
+	"fmt"
+	"math/big"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	ethermint "github.com/tharsis/ethermint/types"
 )
 
+// MaxScalingExponent is the maximum decimal scaling exponent between the
+// Cosmos coin and the ERC20 token of a token pair
+const MaxScalingExponent = 18
+
 // NewTokenPair returns an instance of TokenPair
 func NewTokenPair(erc20Address common.Address, denom string, enabled bool, contractOwner Owner) TokenPair {
 	return TokenPair{
@@ -38,9 +48,109 @@ func (tp TokenPair) Validate() error {
 		return err
 	}
 
+	if err := ValidateTokenBehaviour(tp.Behaviour); err != nil {
+		return err
+	}
+
+	if tp.IsNativeCoin() && tp.Behaviour != TOKEN_BEHAVIOUR_STANDARD {
+		return fmt.Errorf("token pair %s owned by the module must have a standard behaviour, got %s", tp.Erc20Address, tp.Behaviour)
+	}
+
+	if err := ValidateScalingExponent(tp.ScalingExponent); err != nil {
+		return err
+	}
+
+	return ValidateGasLimit(tp.GasLimit)
+}
+
+// ValidateScalingExponent returns an error if the decimal scaling exponent is
+// greater than the maximum one
+func ValidateScalingExponent(scalingExponent uint32) error {
+	if scalingExponent > MaxScalingExponent {
+		return sdkerrors.Wrapf(ErrInvalidScaling, "scaling exponent %d greater than the maximum %d", scalingExponent, MaxScalingExponent)
+	}
+	return nil
+}
+
+// ValidateGasLimit returns an error if the gas limit of the EVM calls to the
+// ERC20 contract of a token pair can't cover the intrinsic gas of a call. A
+// zero gas limit is valid and falls back to the EVM gas cap.
+func ValidateGasLimit(gasLimit uint64) error {
+	if gasLimit != 0 && gasLimit < params.TxGas {
+		return sdkerrors.Wrapf(ErrInvalidGasLimit, "gas limit %d lower than the intrinsic gas %d", gasLimit, params.TxGas)
+	}
 	return nil
 }
 
+// ScalingFactor returns the amount of ERC20 token units that a base unit of the
+// Cosmos coin maps to
+func (tp TokenPair) ScalingFactor() sdk.Int {
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(tp.ScalingExponent)), nil)
+	return sdk.NewIntFromBigInt(factor)
+}
+
+// CoinsToTokens returns the amount of ERC20 tokens that the given amount of
+// Cosmos coins converts to
+func (tp TokenPair) CoinsToTokens(amount sdk.Int) sdk.Int {
+	if tp.ScalingExponent == 0 {
+		return amount
+	}
+	return amount.Mul(tp.ScalingFactor())
+}
+
+// TokensToCoins returns the amount of Cosmos coins that the given amount of
+// ERC20 tokens converts to, along with the remainder of tokens that can't be
+// converted into a whole base unit of the coin
+func (tp TokenPair) TokensToCoins(amount sdk.Int) (sdk.Int, sdk.Int) {
+	if tp.ScalingExponent == 0 {
+		return amount, sdk.ZeroInt()
+	}
+
+	factor := tp.ScalingFactor()
+	return amount.Quo(factor), amount.Mod(factor)
+}
+
+// ValidateTokenBehaviour returns an error if the transfer behaviour is unknown
+// or not supported by the module
+func ValidateTokenBehaviour(behaviour TokenBehaviour) error {
+	switch behaviour {
+	case TOKEN_BEHAVIOUR_STANDARD, TOKEN_BEHAVIOUR_SAFE_TRANSFER, TOKEN_BEHAVIOUR_FEE_ON_TRANSFER:
+		return nil
+	case TOKEN_BEHAVIOUR_REBASING:
+		return sdkerrors.Wrap(ErrUnsupportedBehaviour, "rebasing tokens cannot be registered")
+	default:
+		return sdkerrors.Wrapf(ErrUnsupportedBehaviour, "unknown token behaviour %d", behaviour)
+	}
+}
+
+// ParseTokenBehaviour returns the token behaviour from its enum name, with or
+// without the TOKEN_BEHAVIOUR prefix (eg: "fee-on-transfer")
+func ParseTokenBehaviour(str string) (TokenBehaviour, error) {
+	name := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(str), "-", "_"))
+	if !strings.HasPrefix(name, "TOKEN_BEHAVIOUR_") {
+		name = "TOKEN_BEHAVIOUR_" + name
+	}
+
+	behaviour, ok := TokenBehaviour_value[name]
+	if !ok {
+		return TOKEN_BEHAVIOUR_STANDARD, sdkerrors.Wrapf(ErrUnsupportedBehaviour, "unknown token behaviour %s", str)
+	}
+
+	return TokenBehaviour(behaviour), nil
+}
+
+// AcceptsEmptyTransferReturn returns true if the ERC20 contract of the token
+// pair may return no data on transfer
+func (tp TokenPair) AcceptsEmptyTransferReturn() bool {
+	return tp.Behaviour == TOKEN_BEHAVIOUR_SAFE_TRANSFER || tp.Behaviour == TOKEN_BEHAVIOUR_FEE_ON_TRANSFER
+}
+
+// IsFeeOnTransfer returns true if the ERC20 contract of the token pair charges
+// a fee on transfer
+func (tp TokenPair) IsFeeOnTransfer() bool {
+	return tp.Behaviour == TOKEN_BEHAVIOUR_FEE_ON_TRANSFER
+}
+
 // IsNativeCoin returns true if the owner of the ERC20 contract is the
 // erc20 module account
 func (tp TokenPair) IsNativeCoin() bool {
@@ -52,3 +162,23 @@ func (tp TokenPair) IsNativeCoin() bool {
 func (tp TokenPair) IsNativeERC20() bool {
 	return tp.ContractOwner == OWNER_EXTERNAL
 }
+
+// TokenPairSettlement defines the escrow settled on the deregistration of a
+// token pair.
+type TokenPairSettlement struct {
+	// amount of escrowed ERC20 tokens refunded to the Cosmos coin holders
+	Refunded sdk.Int
+	// number of Cosmos coin holders refunded
+	Holders uint64
+	// amount that remains escrowed on the module account, in Cosmos coins for
+	// native coin pairs and in ERC20 tokens for native ERC20 pairs
+	Escrowed sdk.Int
+}
+
+// NewTokenPairSettlement returns an empty TokenPairSettlement
+func NewTokenPairSettlement() TokenPairSettlement {
+	return TokenPairSettlement{
+		Refunded: sdk.ZeroInt(),
+		Escrowed: sdk.ZeroInt(),
+	}
+}
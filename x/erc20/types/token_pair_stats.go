@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// NewTokenPairStats returns an instance of TokenPairStats with no conversions
+func NewTokenPairStats(erc20Address common.Address) TokenPairStats {
+	return TokenPairStats{
+		Erc20Address:  erc20Address.String(),
+		TotalInflow:   sdk.ZeroInt(),
+		TotalOutflow:  sdk.ZeroInt(),
+		EvmHookVolume: sdk.ZeroInt(),
+		MsgVolume:     sdk.ZeroInt(),
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (s TokenPairStats) GetERC20Contract() common.Address {
+	return common.HexToAddress(s.Erc20Address)
+}
+
+// Validate performs a stateless validation of a TokenPairStats
+func (s TokenPairStats) Validate() error {
+	if err := ethermint.ValidateAddress(s.Erc20Address); err != nil {
+		return err
+	}
+
+	for _, amount := range []sdk.Int{s.TotalInflow, s.TotalOutflow, s.EvmHookVolume, s.MsgVolume} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("conversion volume cannot be nil or negative: %s", amount)
+		}
+	}
+
+	if s.LastConversionHeight < 0 {
+		return fmt.Errorf("last conversion height cannot be negative: %d", s.LastConversionHeight)
+	}
+
+	total := s.TotalInflow.Add(s.TotalOutflow)
+	if !total.Equal(s.EvmHookVolume.Add(s.MsgVolume)) {
+		return fmt.Errorf(
+			"volume converted through the EVM hook and messages doesn't match the total converted volume %s", total,
+		)
+	}
+
+	return nil
+}
+
+// Add returns the stats updated with a conversion of the amount on the given
+// direction at the given block height
+func (s TokenPairStats) Add(amount sdk.Int, direction ConversionDirection, height int64, evmHook bool) TokenPairStats {
+	switch direction {
+	case ConversionInflow:
+		s.TotalInflow = s.TotalInflow.Add(amount)
+		s.InflowConversions++
+	case ConversionOutflow:
+		s.TotalOutflow = s.TotalOutflow.Add(amount)
+		s.OutflowConversions++
+	}
+
+	if evmHook {
+		s.EvmHookVolume = s.EvmHookVolume.Add(amount)
+	} else {
+		s.MsgVolume = s.MsgVolume.Add(amount)
+	}
+
+	s.LastConversionHeight = height
+	return s
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tharsis/ethermint/tests"
+)
+
+type TokenPairStatsTestSuite struct {
+	suite.Suite
+}
+
+func TestTokenPairStatsSuite(t *testing.T) {
+	suite.Run(t, new(TokenPairStatsTestSuite))
+}
+
+func (suite *TokenPairStatsTestSuite) TestTokenPairStatsNew() {
+	addr := tests.GenerateAddress()
+	stats := NewTokenPairStats(addr)
+
+	suite.Require().Equal(addr, stats.GetERC20Contract())
+	suite.Require().NoError(stats.Validate())
+	suite.Require().True(stats.TotalInflow.IsZero())
+	suite.Require().Zero(stats.LastConversionHeight)
+}
+
+func (suite *TokenPairStatsTestSuite) TestTokenPairStats() {
+	testCases := []struct {
+		msg        string
+		stats      TokenPairStats
+		expectPass bool
+	}{
+		{msg: "invalid address", stats: TokenPairStats{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", sdk.ZeroInt(), sdk.ZeroInt(), 0, 0, 0, sdk.ZeroInt(), sdk.ZeroInt()}, expectPass: false},
+		{msg: "nil inflow", stats: TokenPairStats{tests.GenerateAddress().String(), sdk.Int{}, sdk.ZeroInt(), 0, 0, 0, sdk.ZeroInt(), sdk.ZeroInt()}, expectPass: false},
+		{msg: "negative outflow", stats: TokenPairStats{tests.GenerateAddress().String(), sdk.ZeroInt(), sdk.NewInt(-1), 0, 1, 1, sdk.ZeroInt(), sdk.NewInt(-1)}, expectPass: false},
+		{msg: "negative height", stats: TokenPairStats{tests.GenerateAddress().String(), sdk.ZeroInt(), sdk.ZeroInt(), 0, 0, -1, sdk.ZeroInt(), sdk.ZeroInt()}, expectPass: false},
+		{msg: "volumes don't match the total", stats: TokenPairStats{tests.GenerateAddress().String(), sdk.NewInt(10), sdk.NewInt(10), 1, 1, 1, sdk.NewInt(10), sdk.ZeroInt()}, expectPass: false},
+		{msg: "pass", stats: TokenPairStats{tests.GenerateAddress().String(), sdk.NewInt(10), sdk.NewInt(5), 1, 1, 1, sdk.NewInt(5), sdk.NewInt(10)}, expectPass: true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.stats.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *TokenPairStatsTestSuite) TestAdd() {
+	stats := NewTokenPairStats(tests.GenerateAddress())
+
+	stats = stats.Add(sdk.NewInt(100), ConversionInflow, 10, false)
+	stats = stats.Add(sdk.NewInt(30), ConversionOutflow, 11, false)
+	stats = stats.Add(sdk.NewInt(20), ConversionOutflow, 12, true)
+
+	suite.Require().Equal(sdk.NewInt(100), stats.TotalInflow)
+	suite.Require().Equal(sdk.NewInt(50), stats.TotalOutflow)
+	suite.Require().Equal(uint64(1), stats.InflowConversions)
+	suite.Require().Equal(uint64(2), stats.OutflowConversions)
+	suite.Require().Equal(int64(12), stats.LastConversionHeight)
+	suite.Require().Equal(sdk.NewInt(20), stats.EvmHookVolume)
+	suite.Require().Equal(sdk.NewInt(130), stats.MsgVolume)
+	suite.Require().NoError(stats.Validate())
+}
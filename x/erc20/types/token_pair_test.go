@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 	"github.com/tendermint/tendermint/crypto/tmhash"
@@ -58,10 +59,19 @@ func (suite *TokenPairTestSuite) TestTokenPair() {
 		pair       TokenPair
 		expectPass bool
 	}{
-		{msg: "Register token pair - invalid address (no hex)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 1)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 2)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "pass", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE}, expectPass: true},
+		{msg: "Register token pair - invalid address (no hex)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, 0, 0}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 1)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, 0, 0}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 2)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, 0, 0}, expectPass: false},
+		{msg: "Register token pair - rebasing behaviour", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TOKEN_BEHAVIOUR_REBASING, 0, 0}, expectPass: false},
+		{msg: "Register token pair - unknown behaviour", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TokenBehaviour(10), 0, 0}, expectPass: false},
+		{msg: "Register token pair - module owned pair with fee on transfer behaviour", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, TOKEN_BEHAVIOUR_FEE_ON_TRANSFER, 0, 0}, expectPass: false},
+		{msg: "pass", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, 0, 0}, expectPass: true},
+		{msg: "Register token pair - scaling exponent too large", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TOKEN_BEHAVIOUR_STANDARD, MaxScalingExponent + 1, 0}, expectPass: false},
+		{msg: "Register token pair - gas limit lower than the intrinsic gas", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TOKEN_BEHAVIOUR_STANDARD, 0, 20000}, expectPass: false},
+		{msg: "pass", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, 0, 0}, expectPass: true},
+		{msg: "pass - fee on transfer behaviour", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TOKEN_BEHAVIOUR_FEE_ON_TRANSFER, 0, 0}, expectPass: true},
+		{msg: "pass - scaling exponent", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TOKEN_BEHAVIOUR_STANDARD, MaxScalingExponent, 0}, expectPass: true},
+		{msg: "pass - gas limit", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TOKEN_BEHAVIOUR_STANDARD, 0, 200000}, expectPass: true},
 	}
 
 	for i, tc := range testCases {
@@ -100,17 +110,17 @@ func (suite *TokenPairTestSuite) TestIsNativeCoin() {
 	}{
 		{
 			"no owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED, TOKEN_BEHAVIOUR_STANDARD, 0, 0},
 			false,
 		},
 		{
 			"external ERC20 owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TOKEN_BEHAVIOUR_STANDARD, 0, 0},
 			false,
 		},
 		{
 			"pass",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, 0, 0},
 			true,
 		},
 	}
@@ -133,17 +143,17 @@ func (suite *TokenPairTestSuite) TestIsNativeERC20() {
 	}{
 		{
 			"no owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED, TOKEN_BEHAVIOUR_STANDARD, 0, 0},
 			false,
 		},
 		{
 			"module owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, 0, 0},
 			false,
 		},
 		{
 			"pass",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, TOKEN_BEHAVIOUR_STANDARD, 0, 0},
 			true,
 		},
 	}
@@ -157,3 +167,55 @@ func (suite *TokenPairTestSuite) TestIsNativeERC20() {
 		}
 	}
 }
+
+func (suite *TokenPairTestSuite) TestParseTokenBehaviour() {
+	testCases := []struct {
+		name      string
+		str       string
+		expected  TokenBehaviour
+		expectErr bool
+	}{
+		{"enum name", "TOKEN_BEHAVIOUR_SAFE_TRANSFER", TOKEN_BEHAVIOUR_SAFE_TRANSFER, false},
+		{"short name", "standard", TOKEN_BEHAVIOUR_STANDARD, false},
+		{"short name with dashes", "fee-on-transfer", TOKEN_BEHAVIOUR_FEE_ON_TRANSFER, false},
+		{"unknown behaviour", "inflationary", TOKEN_BEHAVIOUR_STANDARD, true},
+	}
+
+	for _, tc := range testCases {
+		behaviour, err := ParseTokenBehaviour(tc.str)
+		if tc.expectErr {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(tc.expected, behaviour, tc.name)
+		}
+	}
+}
+
+func (suite *TokenPairTestSuite) TestScaling() {
+	testCases := []struct {
+		name         string
+		exponent     uint32
+		coins        sdk.Int
+		expTokens    sdk.Int
+		tokens       sdk.Int
+		expCoins     sdk.Int
+		expRemainder sdk.Int
+	}{
+		{"no scaling", 0, sdk.NewInt(100), sdk.NewInt(100), sdk.NewInt(100), sdk.NewInt(100), sdk.ZeroInt()},
+		{"scaling without remainder", 2, sdk.NewInt(5), sdk.NewInt(500), sdk.NewInt(500), sdk.NewInt(5), sdk.ZeroInt()},
+		{"scaling with remainder", 2, sdk.NewInt(5), sdk.NewInt(500), sdk.NewInt(542), sdk.NewInt(5), sdk.NewInt(42)},
+		{"tokens lower than the scaling factor", 12, sdk.NewInt(1), sdk.NewIntWithDecimal(1, 12), sdk.NewInt(999), sdk.ZeroInt(), sdk.NewInt(999)},
+	}
+
+	for _, tc := range testCases {
+		pair := TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, TOKEN_BEHAVIOUR_STANDARD, tc.exponent, 0}
+
+		suite.Require().Equal(sdk.NewIntWithDecimal(1, int(tc.exponent)).String(), pair.ScalingFactor().String(), tc.name)
+		suite.Require().Equal(tc.expTokens.String(), pair.CoinsToTokens(tc.coins).String(), tc.name)
+
+		coins, remainder := pair.TokensToCoins(tc.tokens)
+		suite.Require().Equal(tc.expCoins.String(), coins.String(), tc.name)
+		suite.Require().Equal(tc.expRemainder.String(), remainder.String(), tc.name)
+	}
+}
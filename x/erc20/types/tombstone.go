@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// NewTokenPairTombstone returns an instance of TokenPairTombstone for the
+// given token pair
+func NewTokenPairTombstone(pair TokenPair, height int64, reason string, escrow, supply sdk.Int) TokenPairTombstone {
+	return TokenPairTombstone{
+		Erc20Address:  pair.Erc20Address,
+		Denom:         pair.Denom,
+		ContractOwner: pair.ContractOwner,
+		Height:        height,
+		Reason:        reason,
+		Escrow:        escrow,
+		Supply:        supply,
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (t TokenPairTombstone) GetERC20Contract() common.Address {
+	return common.HexToAddress(t.Erc20Address)
+}
+
+// UnrecoveredEscrow returns the escrow of the tombstone that hasn't been
+// distributed yet
+func (t TokenPairTombstone) UnrecoveredEscrow() sdk.Int {
+	if t.Recovered {
+		return sdk.ZeroInt()
+	}
+	return t.Escrow
+}
+
+// Validate performs a stateless validation of a TokenPairTombstone
+func (t TokenPairTombstone) Validate() error {
+	if err := ethermint.ValidateAddress(t.Erc20Address); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(t.Denom); err != nil {
+		return err
+	}
+
+	if t.ContractOwner == OWNER_UNSPECIFIED {
+		return fmt.Errorf("tombstone of token pair %s has no owner", t.Erc20Address)
+	}
+
+	if t.Height < 0 {
+		return fmt.Errorf("tombstone height cannot be negative: %d", t.Height)
+	}
+
+	if t.Escrow.IsNil() || t.Escrow.IsNegative() {
+		return fmt.Errorf("tombstone escrow cannot be nil or negative: %v", t.Escrow)
+	}
+
+	if t.Supply.IsNil() || t.Supply.IsNegative() {
+		return fmt.Errorf("tombstone supply cannot be nil or negative: %v", t.Supply)
+	}
+
+	return nil
+}
+
+// GetAccAddress returns the address of the account receiving the share
+func (s EscrowRecoveryShare) GetAccAddress() (sdk.AccAddress, error) {
+	return parseReceiver(s.Address)
+}
+
+// validateEscrowRecoveryShares checks that the shares have valid and unique
+// addresses and positive amounts
+func validateEscrowRecoveryShares(shares []EscrowRecoveryShare) error {
+	seen := make(map[string]bool)
+
+	for _, share := range shares {
+		addr, err := share.GetAccAddress()
+		if err != nil {
+			return err
+		}
+
+		if seen[addr.String()] {
+			return fmt.Errorf("duplicated escrow recovery share for %s", share.Address)
+		}
+
+		if share.Amount.IsNil() || !share.Amount.IsPositive() {
+			return fmt.Errorf("escrow recovery share of %s must be positive: %v", share.Address, share.Amount)
+		}
+
+		seen[addr.String()] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tharsis/ethermint/tests"
+)
+
+type TombstoneTestSuite struct {
+	suite.Suite
+}
+
+func TestTombstoneSuite(t *testing.T) {
+	suite.Run(t, new(TombstoneTestSuite))
+}
+
+func (suite *TombstoneTestSuite) TestTokenPairTombstoneNew() {
+	addr := tests.GenerateAddress()
+	pair := NewTokenPair(addr, "test", true, OWNER_MODULE)
+	tombstone := NewTokenPairTombstone(pair, 10, "destroyed", sdk.NewInt(100), sdk.NewInt(200))
+
+	suite.Require().Equal(addr, tombstone.GetERC20Contract())
+	suite.Require().Equal(pair.Denom, tombstone.Denom)
+	suite.Require().Equal(OWNER_MODULE, tombstone.ContractOwner)
+	suite.Require().Equal(sdk.NewInt(100), tombstone.UnrecoveredEscrow())
+	suite.Require().NoError(tombstone.Validate())
+
+	tombstone.Recovered = true
+	suite.Require().True(tombstone.UnrecoveredEscrow().IsZero())
+}
+
+func (suite *TombstoneTestSuite) TestTokenPairTombstone() {
+	addr := tests.GenerateAddress().String()
+
+	testCases := []struct {
+		msg        string
+		tombstone  TokenPairTombstone
+		expectPass bool
+	}{
+		{msg: "invalid address", tombstone: TokenPairTombstone{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", OWNER_MODULE, 1, "", sdk.ZeroInt(), sdk.ZeroInt(), false}, expectPass: false},
+		{msg: "invalid denom", tombstone: TokenPairTombstone{addr, "-", OWNER_MODULE, 1, "", sdk.ZeroInt(), sdk.ZeroInt(), false}, expectPass: false},
+		{msg: "unspecified owner", tombstone: TokenPairTombstone{addr, "test", OWNER_UNSPECIFIED, 1, "", sdk.ZeroInt(), sdk.ZeroInt(), false}, expectPass: false},
+		{msg: "negative height", tombstone: TokenPairTombstone{addr, "test", OWNER_MODULE, -1, "", sdk.ZeroInt(), sdk.ZeroInt(), false}, expectPass: false},
+		{msg: "nil escrow", tombstone: TokenPairTombstone{addr, "test", OWNER_MODULE, 1, "", sdk.Int{}, sdk.ZeroInt(), false}, expectPass: false},
+		{msg: "negative escrow", tombstone: TokenPairTombstone{addr, "test", OWNER_MODULE, 1, "", sdk.NewInt(-1), sdk.ZeroInt(), false}, expectPass: false},
+		{msg: "negative supply", tombstone: TokenPairTombstone{addr, "test", OWNER_MODULE, 1, "", sdk.ZeroInt(), sdk.NewInt(-1), false}, expectPass: false},
+		{msg: "pass", tombstone: TokenPairTombstone{addr, "test", OWNER_MODULE, 1, "destroyed", sdk.NewInt(100), sdk.NewInt(100), false}, expectPass: true},
+		{msg: "pass - external owner without escrow", tombstone: TokenPairTombstone{addr, "test", OWNER_EXTERNAL, 1, "destroyed", sdk.ZeroInt(), sdk.NewInt(100), false}, expectPass: true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.tombstone.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+// NewERC20Transfer returns an instance of ERC20Transfer
+func NewERC20Transfer(portID, channelID string, sequence uint64, erc20Address common.Address) ERC20Transfer {
+	return ERC20Transfer{
+		PortId:       portID,
+		ChannelId:    channelID,
+		Sequence:     sequence,
+		Erc20Address: erc20Address.String(),
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (t ERC20Transfer) GetERC20Contract() common.Address {
+	return common.HexToAddress(t.Erc20Address)
+}
+
+// Validate performs a stateless validation of an ERC20Transfer
+func (t ERC20Transfer) Validate() error {
+	if err := host.PortIdentifierValidator(t.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(t.ChannelId); err != nil {
+		return err
+	}
+
+	if t.Sequence == 0 {
+		return fmt.Errorf("packet sequence cannot be 0")
+	}
+
+	return ethermint.ValidateAddress(t.Erc20Address)
+}
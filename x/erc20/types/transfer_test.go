@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tharsis/ethermint/tests"
+)
+
+type ERC20TransferTestSuite struct {
+	suite.Suite
+}
+
+func TestERC20TransferSuite(t *testing.T) {
+	suite.Run(t, new(ERC20TransferTestSuite))
+}
+
+func (suite *ERC20TransferTestSuite) TestERC20TransferNew() {
+	addr := tests.GenerateAddress()
+	transfer := NewERC20Transfer(transfertypes.PortID, "channel-0", 1, addr)
+
+	suite.Require().Equal(addr, transfer.GetERC20Contract())
+	suite.Require().NoError(transfer.Validate())
+}
+
+func (suite *ERC20TransferTestSuite) TestERC20Transfer() {
+	testCases := []struct {
+		msg        string
+		transfer   ERC20Transfer
+		expectPass bool
+	}{
+		{msg: "invalid port", transfer: ERC20Transfer{"", "channel-0", 1, tests.GenerateAddress().String()}, expectPass: false},
+		{msg: "invalid channel", transfer: ERC20Transfer{transfertypes.PortID, "", 1, tests.GenerateAddress().String()}, expectPass: false},
+		{msg: "zero sequence", transfer: ERC20Transfer{transfertypes.PortID, "channel-0", 0, tests.GenerateAddress().String()}, expectPass: false},
+		{msg: "invalid address", transfer: ERC20Transfer{transfertypes.PortID, "channel-0", 1, "0x5dCA2483280D9727c80b5518faC4556617fb19ZZ"}, expectPass: false},
+		{msg: "pass", transfer: ERC20Transfer{transfertypes.PortID, "channel-0", 1, tests.GenerateAddress().String()}, expectPass: true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.transfer.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
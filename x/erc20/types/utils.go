@@ -5,8 +5,16 @@ import (
 	"strings"
 
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
+// CreateERC20Salt returns the CREATE2 salt used to deploy the ERC20 contract of
+// a Cosmos coin, derived from its base denomination.
+func CreateERC20Salt(denom string) common.Hash {
+	return crypto.Keccak256Hash([]byte(denom))
+}
+
 // SanitizeERC20Name enforces snake_case and removes all "coin" and "token"
 // strings from the ERC20 name.
 func SanitizeERC20Name(name string) string {
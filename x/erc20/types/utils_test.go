@@ -6,6 +6,23 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestCreateERC20Salt(t *testing.T) {
+	testCases := []struct {
+		name    string
+		denom   string
+		expSalt string
+	}{
+		{"empty denom", "", "0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+		{"base denom", "aevmos", "0x3412d8cf48701062ba2193424e8669d7c1571e513fe7eb7255d0bf6ecc609c55"},
+	}
+
+	for _, tc := range testCases {
+		salt := CreateERC20Salt(tc.denom)
+		require.Equal(t, tc.expSalt, salt.Hex(), tc.name)
+	}
+	require.NotEqual(t, CreateERC20Salt("acoin"), CreateERC20Salt("bcoin"))
+}
+
 func TestSanitizeERC20Name(t *testing.T) {
 	testCases := []struct {
 		name         string